@@ -0,0 +1,44 @@
+using Google.Protobuf;
+
+namespace Spaceli.Pgv
+{
+    /// <summary>
+    /// The affixes of the bytes rules.
+    /// </summary>
+    public static class Bytes
+    {
+        public static bool HasPrefix(ByteString b, byte[] prefix)
+        {
+            return b.Length >= prefix.Length && At(b, prefix, 0);
+        }
+
+        public static bool HasSuffix(ByteString b, byte[] suffix)
+        {
+            return b.Length >= suffix.Length && At(b, suffix, b.Length - suffix.Length);
+        }
+
+        public static bool Contains(ByteString b, byte[] sub)
+        {
+            for (var i = 0; i + sub.Length <= b.Length; i++)
+            {
+                if (At(b, sub, i))
+                {
+                    return true;
+                }
+            }
+            return false;
+        }
+
+        private static bool At(ByteString b, byte[] sub, int offset)
+        {
+            for (var i = 0; i < sub.Length; i++)
+            {
+                if (b[offset + i] != sub[i])
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+    }
+}
@@ -0,0 +1,26 @@
+using System.Collections.Generic;
+
+namespace Spaceli.Pgv
+{
+    /// <summary>
+    /// The checks of the repeated rules.
+    /// </summary>
+    public static class Collections
+    {
+        /// <summary>
+        /// Returns true if no two items are equal, comparing bytes by content.
+        /// </summary>
+        public static bool Unique<T>(IEnumerable<T> items)
+        {
+            var seen = new HashSet<T>();
+            foreach (var item in items)
+            {
+                if (!seen.Add(item))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+    }
+}
@@ -0,0 +1,190 @@
+using System;
+using System.Text;
+using System.Text.RegularExpressions;
+
+namespace Spaceli.Pgv
+{
+    /// <summary>
+    /// The well-known formats and measures of the string rules.
+    /// </summary>
+    public static class Strings
+    {
+        private static readonly Regex HostnameLabel = new Regex("^[a-z0-9]([a-z0-9-]*[a-z0-9])?$", RegexOptions.CultureInvariant);
+        private static readonly Regex EmailLocalPart = new Regex(@"^[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+(\.[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+)*$", RegexOptions.CultureInvariant);
+        private static readonly Regex AngledEmail = new Regex("^[^<]*<([^>]*)>$", RegexOptions.CultureInvariant);
+        private static readonly Regex Ipv4 = new Regex(@"^(25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[1-9]?[0-9])(\.(25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[1-9]?[0-9])){3}$", RegexOptions.CultureInvariant);
+        private static readonly Regex Ipv6Group = new Regex("^[0-9a-fA-F]{1,4}$", RegexOptions.CultureInvariant);
+        private static readonly Regex UriScheme = new Regex("^[a-zA-Z][a-zA-Z0-9+.-]*:", RegexOptions.CultureInvariant);
+        private static readonly Regex UriRef = new Regex(@"^([^\s%]|%[0-9a-fA-F]{2})*$", RegexOptions.CultureInvariant);
+        private static readonly Regex Uuid = new Regex("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$", RegexOptions.CultureInvariant);
+        private static readonly Regex StrictHeaderName = new Regex("^:?[0-9a-zA-Z!#$%&'*+\\-.^_|~`]+$", RegexOptions.CultureInvariant);
+        private static readonly Regex StrictHeaderValue = new Regex("^[^\u0000-\u0008\u000A-\u001F\u007F]*$", RegexOptions.CultureInvariant);
+        private static readonly Regex LooseHeaderName = new Regex("^[^\u0000\u000A\u000D]+$", RegexOptions.CultureInvariant);
+        private static readonly Regex LooseHeaderValue = new Regex("^[^\u0000\u000A\u000D]*$", RegexOptions.CultureInvariant);
+
+        /// <summary>
+        /// Returns the number of code points of <paramref name="s"/>, which is what the <c>len</c> rules of strings
+        /// count.
+        /// </summary>
+        public static int RuneCount(string s)
+        {
+            var n = 0;
+            for (var i = 0; i < s.Length; i++)
+            {
+                if (char.IsHighSurrogate(s[i]) && i + 1 < s.Length && char.IsLowSurrogate(s[i + 1]))
+                {
+                    i++;
+                }
+                n++;
+            }
+            return n;
+        }
+
+        /// <summary>
+        /// Returns the length of <paramref name="s"/> encoded as UTF-8.
+        /// </summary>
+        public static int ByteLength(string s)
+        {
+            return Encoding.UTF8.GetByteCount(s);
+        }
+
+        public static bool IsHostname(string host)
+        {
+            if (host.Length > 253)
+            {
+                return false;
+            }
+            var s = (host.EndsWith(".", StringComparison.Ordinal) ? host.Substring(0, host.Length - 1) : host).ToLowerInvariant();
+            foreach (var part in s.Split('.'))
+            {
+                if (part.Length == 0 || part.Length > 63 || !HostnameLabel.IsMatch(part))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+
+        /// <summary>
+        /// Validates an RFC 5322 address, optionally with a display name, e.g. <c>Jane &lt;jane@example.com&gt;</c>.
+        /// </summary>
+        public static bool IsEmail(string addr)
+        {
+            var angled = AngledEmail.Match(addr);
+            var s = angled.Success ? angled.Groups[1].Value : addr.Trim();
+            if (s.Length > 254)
+            {
+                return false;
+            }
+            var at = s.LastIndexOf('@');
+            if (at < 0)
+            {
+                return false;
+            }
+            var local = s.Substring(0, at);
+            return local.Length <= 64 && EmailLocalPart.IsMatch(local) && IsHostname(s.Substring(at + 1));
+        }
+
+        public static bool IsIpv4(string s)
+        {
+            return Ipv4.IsMatch(s);
+        }
+
+        public static bool IsIpv6(string s)
+        {
+            var halves = s.Split(new[] { "::" }, StringSplitOptions.None);
+            if (halves.Length > 2)
+            {
+                return false;
+            }
+            var groups = halves.Length == 2 ? Concat(Groups(halves[0]), Groups(halves[1])) : Groups(halves[0]);
+
+            var count = 0;
+            for (var i = 0; i < groups.Length; i++)
+            {
+                if (i == groups.Length - 1 && groups[i].Contains("."))
+                {
+                    if (!IsIpv4(groups[i]))
+                    {
+                        return false;
+                    }
+                    count += 2;
+                }
+                else if (Ipv6Group.IsMatch(groups[i]))
+                {
+                    count++;
+                }
+                else
+                {
+                    return false;
+                }
+            }
+            return halves.Length == 2 ? count < 8 : count == 8;
+        }
+
+        public static bool IsIp(string s)
+        {
+            return IsIpv4(s) || IsIpv6(s);
+        }
+
+        /// <summary>
+        /// Validates either an IP address or a hostname.
+        /// </summary>
+        public static bool IsAddress(string s)
+        {
+            return IsIp(s) || IsHostname(s);
+        }
+
+        /// <summary>
+        /// Validates an absolute URI as defined by RFC 3986.
+        /// </summary>
+        public static bool IsUri(string s)
+        {
+            return UriScheme.IsMatch(s) && UriRef.IsMatch(s);
+        }
+
+        /// <summary>
+        /// Validates an absolute or relative URI reference as defined by RFC 3986.
+        /// </summary>
+        public static bool IsUriRef(string s)
+        {
+            return UriRef.IsMatch(s);
+        }
+
+        public static bool IsUuid(string s)
+        {
+            return Uuid.IsMatch(s);
+        }
+
+        /// <summary>
+        /// Validates an HTTP header name as defined by RFC 7230, or with <paramref name="strict"/> unset, only
+        /// disallows <c>\r\n\0</c>.
+        /// </summary>
+        public static bool IsHttpHeaderName(string s, bool strict)
+        {
+            return strict ? StrictHeaderName.IsMatch(s) : LooseHeaderName.IsMatch(s);
+        }
+
+        /// <summary>
+        /// Validates an HTTP header value as defined by RFC 7230, or with <paramref name="strict"/> unset, only
+        /// disallows <c>\r\n\0</c>.
+        /// </summary>
+        public static bool IsHttpHeaderValue(string s, bool strict)
+        {
+            return strict ? StrictHeaderValue.IsMatch(s) : LooseHeaderValue.IsMatch(s);
+        }
+
+        private static string[] Groups(string half)
+        {
+            return half == "" ? new string[0] : half.Split(':');
+        }
+
+        private static string[] Concat(string[] a, string[] b)
+        {
+            var all = new string[a.Length + b.Length];
+            a.CopyTo(all, 0);
+            b.CopyTo(all, a.Length);
+            return all;
+        }
+    }
+}
@@ -0,0 +1,63 @@
+using System;
+using Google.Protobuf.WellKnownTypes;
+
+namespace Spaceli.Pgv
+{
+    /// <summary>
+    /// Measures google.protobuf.Duration and google.protobuf.Timestamp in nanoseconds. They are decimals, which unlike
+    /// longs hold the whole range of both.
+    /// </summary>
+    public static class Times
+    {
+        private const decimal NanosPerSecond = 1000000000M;
+
+        private static readonly DateTime Epoch = new DateTime(1970, 1, 1, 0, 0, 0, DateTimeKind.Utc);
+
+        public static decimal Nanos(Duration d)
+        {
+            return d.Seconds * NanosPerSecond + d.Nanos;
+        }
+
+        /// <summary>
+        /// Returns the time since the epoch of <paramref name="ts"/>.
+        /// </summary>
+        public static decimal Nanos(Timestamp ts)
+        {
+            return ts.Seconds * NanosPerSecond + ts.Nanos;
+        }
+
+        public static decimal NowNanos()
+        {
+            return (DateTime.UtcNow - Epoch).Ticks * 100M;
+        }
+
+        /// <summary>
+        /// Returns true if <paramref name="d"/> is within the range google.protobuf.Duration documents.
+        /// </summary>
+        public static bool IsValid(Duration d)
+        {
+            if (d.Seconds < Duration.MinSeconds || d.Seconds > Duration.MaxSeconds || d.Nanos <= -Duration.NanosecondsPerSecond || d.Nanos >= Duration.NanosecondsPerSecond)
+            {
+                return false;
+            }
+            return !((d.Seconds > 0 && d.Nanos < 0) || (d.Seconds < 0 && d.Nanos > 0));
+        }
+
+        /// <summary>
+        /// Returns true if <paramref name="ts"/> is within the range google.protobuf.Timestamp documents, years 1 to
+        /// 9999.
+        /// </summary>
+        public static bool IsValid(Timestamp ts)
+        {
+            return ts.Seconds >= -62135596800L && ts.Seconds <= 253402300799L && ts.Nanos >= 0 && ts.Nanos < 1000000000;
+        }
+
+        /// <summary>
+        /// Returns true if <paramref name="ts"/> is at most <paramref name="within"/> nanoseconds away from now.
+        /// </summary>
+        public static bool IsWithinNow(Timestamp ts, decimal within)
+        {
+            return Math.Abs(Nanos(ts) - NowNanos()) <= within;
+        }
+    }
+}
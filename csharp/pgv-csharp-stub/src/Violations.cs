@@ -0,0 +1,187 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+
+namespace Spaceli.Pgv
+{
+    /// <summary>
+    /// A message with validation rules. protoc-gen-validate implements it with <c>lang=csharp</c> in a partial class
+    /// next to the one protoc generates, along with <c>Validate()</c> and <c>ValidateAll()</c> methods.
+    /// </summary>
+    public interface IValidatable
+    {
+        /// <summary>
+        /// Reports the violations of the rules of this message to <paramref name="v"/>.
+        /// </summary>
+        void Check(Violations v);
+    }
+
+    /// <summary>
+    /// A single failed rule: the error built for it, the path of the offending field and the name of the rule.
+    /// </summary>
+    public sealed class Violation
+    {
+        public Violation(string fieldPath, string rule, Exception error)
+        {
+            FieldPath = fieldPath;
+            Rule = rule;
+            Error = error;
+        }
+
+        /// <summary>The path of the offending field, e.g. <c>address.lines[2]</c> or <c>labels[env]</c>.</summary>
+        public string FieldPath { get; }
+
+        /// <summary>The name of the failed rule, e.g. <c>string.min_len</c>.</summary>
+        public string Rule { get; }
+
+        /// <summary>The error the factory method of the <c>validate.Error</c> of the rule returned.</summary>
+        public Exception Error { get; }
+
+        public override string ToString()
+        {
+            return (FieldPath == "" ? "" : FieldPath + ": ") + Rule + ": " + Error.Message;
+        }
+    }
+
+    /// <summary>
+    /// Gathers the violations found while validating a message. A fail-fast instance throws the error of the first
+    /// failed rule, any other instance records it and carries on with the next rule.
+    /// </summary>
+    public sealed class Violations
+    {
+        private readonly List<string> path = new List<string>();
+        private readonly List<Violation> found = new List<Violation>();
+
+        public Violations(bool failFast)
+        {
+            FailFast = failFast;
+        }
+
+        public bool FailFast { get; }
+
+        /// <summary>
+        /// Returns the violations recorded so far.
+        /// </summary>
+        public IReadOnlyList<Violation> Found
+        {
+            get { return found; }
+        }
+
+        /// <summary>
+        /// Validates <paramref name="m"/>, throwing the error of the first failed rule.
+        /// </summary>
+        public static void Validate(IValidatable m)
+        {
+            m.Check(new Violations(true));
+        }
+
+        /// <summary>
+        /// Validates <paramref name="m"/>, returning every failed rule.
+        /// </summary>
+        public static IReadOnlyList<Violation> ValidateAll(IValidatable m)
+        {
+            var v = new Violations(false);
+            m.Check(v);
+            return v.Found;
+        }
+
+        /// <summary>
+        /// Reports a failed rule of <paramref name="field"/>, an empty field standing for the current path itself.
+        /// The error is only built once the rule failed.
+        /// </summary>
+        public void Fail(string field, string rule, Func<Exception> error)
+        {
+            var e = error();
+            if (FailFast)
+            {
+                throw e;
+            }
+            found.Add(new Violation(PathTo(field), rule, e));
+        }
+
+        /// <summary>
+        /// Runs <paramref name="body"/> with <paramref name="field"/> appended to the current path, used when
+        /// descending into embedded messages, repeated items and map entries.
+        /// </summary>
+        public void Within(string field, Action body)
+        {
+            if (field == "")
+            {
+                body();
+                return;
+            }
+            path.Add(PathTo(field));
+            try
+            {
+                body();
+            }
+            finally
+            {
+                path.RemoveAt(path.Count - 1);
+            }
+        }
+
+        /// <summary>
+        /// Runs <paramref name="body"/> for every item of the repeated <paramref name="field"/>.
+        /// </summary>
+        public void Each<T>(string field, IList<T> items, Action<T> body)
+        {
+            Within(field, () =>
+            {
+                for (var i = 0; i < items.Count; i++)
+                {
+                    var item = items[i];
+                    Within("[" + i.ToString(CultureInfo.InvariantCulture) + "]", () => body(item));
+                }
+            });
+        }
+
+        /// <summary>
+        /// Runs <paramref name="body"/> for every entry of the map <paramref name="field"/>.
+        /// </summary>
+        public void Entries<TKey, TValue>(string field, IDictionary<TKey, TValue> map, Action<TKey, TValue> body)
+        {
+            Within(field, () =>
+            {
+                foreach (var entry in map)
+                {
+                    Within("[" + KeyString(entry.Key) + "]", () => body(entry.Key, entry.Value));
+                }
+            });
+        }
+
+        /// <summary>
+        /// Validates the embedded message <paramref name="field"/>, if it is set.
+        /// </summary>
+        public void Nested(string field, IValidatable value)
+        {
+            if (value != null)
+            {
+                Within(field, () => value.Check(this));
+            }
+        }
+
+        private string PathTo(string field)
+        {
+            var parent = path.Count == 0 ? "" : path[path.Count - 1];
+            if (field == "")
+            {
+                return parent;
+            }
+            if (parent == "" || field.StartsWith("[", StringComparison.Ordinal))
+            {
+                return parent + field;
+            }
+            return parent + "." + field;
+        }
+
+        private static string KeyString(object key)
+        {
+            if (key is bool)
+            {
+                return (bool)key ? "true" : "false";
+            }
+            return Convert.ToString(key, CultureInfo.InvariantCulture);
+        }
+    }
+}
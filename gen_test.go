@@ -1,25 +1,215 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"flag"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/bufbuild/protocompile"
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"github.com/curl-li/protoc-gen-validate/module"
 )
 
+var update = flag.Bool("update", false, "regenerate the golden files in testdata/golden")
+
+const (
+	fixturesDir = "testdata/fixtures"
+	goldenDir   = "testdata/golden"
+
+	// paramsPrefix starts the first line of a fixture rendered with other
+	// parameters than defaultParams.
+	paramsPrefix  = "// params: "
+	defaultParams = "lang=java"
+)
+
+// TestGenerator renders every fixture of testdata/fixtures and compares the
+// output with the golden files of testdata/golden/<fixture>. Run it with
+// -update to regenerate them.
 func TestGenerator(t *testing.T) {
-	f, err := os.Open("./t.out")
+	fixtures, err := filepath.Glob(filepath.Join(fixturesDir, "*.proto"))
 	if err != nil {
-		panic(err)
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatalf("no fixtures in %s", fixturesDir)
 	}
+
+	for _, fixture := range fixtures {
+		name := filepath.Base(fixture)
+		t.Run(strings.TrimSuffix(name, ".proto"), func(t *testing.T) {
+			dir := filepath.Join(goldenDir, strings.TrimSuffix(name, ".proto"))
+			got := render(t, name)
+			if *update {
+				writeGolden(t, dir, got)
+				return
+			}
+
+			want := readGolden(t, dir)
+			for _, file := range sortedKeys(got) {
+				w, ok := want[file]
+				if !ok {
+					t.Errorf("%s: no golden file, run the tests with -update", file)
+					continue
+				}
+				if line, g, w := firstDiff(got[file], w); line > 0 {
+					t.Errorf("%s:%d differs from the golden file\n got: %s\nwant: %s", file, line, g, w)
+				}
+			}
+			for _, file := range sortedKeys(want) {
+				if _, ok := got[file]; !ok {
+					t.Errorf("%s: golden file is no longer generated", file)
+				}
+			}
+		})
+	}
+}
+
+// render compiles the fixture name without protoc and runs the validator
+// module on it, returning the generated files by name.
+func render(t *testing.T, name string) map[string]string {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join(fixturesDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := defaultParams
+	if first, _, _ := strings.Cut(string(src), "\n"); strings.HasPrefix(first, paramsPrefix) {
+		params = strings.TrimSpace(strings.TrimPrefix(first, paramsPrefix))
+	}
+
+	c := protocompile.Compiler{
+		Resolver:       protocompile.WithStandardImports(&protocompile.SourceResolver{ImportPaths: []string{fixturesDir, "."}}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	res, err := c.Compile(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{name},
+		Parameter:      proto.String(params),
+		ProtoFile:      withImports(res[0]),
+	}
+	in, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
 	optional := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 	pgs.
-		Init(pgs.DebugEnv("DEBUG_PGV"), pgs.SupportedFeatures(&optional), pgs.ProtocInput(f), pgs.DebugMode()).
+		Init(pgs.DebugEnv("DEBUG_PGV"), pgs.SupportedFeatures(&optional), pgs.ProtocInput(bytes.NewReader(in)), pgs.ProtocOutput(&out)).
 		RegisterModule(module.Validator()).
 		RegisterPostProcessor(pgsgo.GoFmt()).
 		Render()
+
+	var resp pluginpb.CodeGeneratorResponse
+	if err = proto.Unmarshal(out.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+	files := make(map[string]string, len(resp.GetFile()))
+	for _, f := range resp.GetFile() {
+		files[f.GetName()] = f.GetContent()
+	}
+	return files
+}
+
+// withImports lists fd after its transitive imports, as protoc does.
+func withImports(fd protoreflect.FileDescriptor) []*descriptorpb.FileDescriptorProto {
+	var out []*descriptorpb.FileDescriptorProto
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		out = append(out, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(fd)
+	return out
+}
+
+func readGolden(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func writeGolden(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// firstDiff returns the first line, counting from 1, on which got and want
+// differ with both versions of it, or 0 if they are the same.
+func firstDiff(got, want string) (line int, g, w string) {
+	if got == want {
+		return 0, "", ""
+	}
+	gs, ws := bufio.NewScanner(strings.NewReader(got)), bufio.NewScanner(strings.NewReader(want))
+	for line = 1; ; line++ {
+		gok, wok := gs.Scan(), ws.Scan()
+		if gs.Text() != ws.Text() || gok != wok || !gok {
+			return line, gs.Text(), ws.Text()
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
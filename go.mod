@@ -3,13 +3,15 @@ module github.com/curl-li/protoc-gen-validate
 go 1.18
 
 require (
+	github.com/bufbuild/protocompile v0.6.0
 	github.com/iancoleman/strcase v0.2.0
 	github.com/lyft/protoc-gen-star v0.6.1
-	google.golang.org/protobuf v1.28.1
+	google.golang.org/protobuf v1.31.0
 )
 
 require (
 	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/spf13/afero v1.3.3 // indirect
+	golang.org/x/sync v0.3.0 // indirect
 	golang.org/x/text v0.3.0 // indirect
 )
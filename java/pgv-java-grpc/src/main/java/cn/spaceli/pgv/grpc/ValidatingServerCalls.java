@@ -0,0 +1,111 @@
+package cn.spaceli.pgv.grpc;
+
+import cn.spaceli.pgv.Validator;
+import cn.spaceli.pgv.Violation;
+import io.grpc.ForwardingServerCall;
+import io.grpc.ForwardingServerCallListener;
+import io.grpc.Metadata;
+import io.grpc.ServerCall;
+import io.grpc.ServerCallHandler;
+import io.grpc.Status;
+import io.grpc.StatusRuntimeException;
+import java.util.List;
+
+/**
+ * {@code ValidatingServerCalls} validates the messages of a server call. The interceptors generated with
+ * {@code java_grpc=true} start the calls of each method of their service through it.
+ */
+public final class ValidatingServerCalls {
+    private ValidatingServerCalls() {
+    }
+
+    /**
+     * Starts {@code call}, validating every request with {@code request} and every response with {@code response},
+     * checking the rules of {@code groups} along with the rules of no group.
+     * An invalid request closes the call with {@code INVALID_ARGUMENT} before it reaches the service, an invalid
+     * response closes it with {@code INTERNAL} instead of being sent.
+     * @param request the validator of the requests, or {@code null} not to validate them
+     * @param response the validator of the responses, or {@code null} not to validate them
+     * @param groups the validation groups to validate
+     */
+    public static <ReqT, RespT> ServerCall.Listener<ReqT> intercept(ServerCall<ReqT, RespT> call, Metadata headers,
+            ServerCallHandler<ReqT, RespT> next, Validator<ReqT> request, Validator<RespT> response,
+            String... groups) {
+        ValidatingServerCall<ReqT, RespT> validating = new ValidatingServerCall<>(call, response, groups);
+        ServerCall.Listener<ReqT> listener = next.startCall(validating, headers);
+        return new ForwardingServerCallListener.SimpleForwardingServerCallListener<ReqT>(listener) {
+            @Override
+            public void onMessage(ReqT message) {
+                if (validating.isClosed()) {
+                    return;
+                }
+                if (request != null) {
+                    List<Violation> violations = request.validateAll(message, groups);
+                    if (!violations.isEmpty()) {
+                        validating.close(Status.Code.INVALID_ARGUMENT, violations);
+                        return;
+                    }
+                }
+                super.onMessage(message);
+            }
+
+            @Override
+            public void onHalfClose() {
+                // the service never saw the invalid request, nor should it see its end
+                if (!validating.isClosed()) {
+                    super.onHalfClose();
+                }
+            }
+        };
+    }
+
+    /**
+     * {@code ValidatingServerCall} validates the responses sent on a call, if it has their validator, and ignores
+     * what the service sends once the call is closed for a violation.
+     */
+    private static final class ValidatingServerCall<ReqT, RespT>
+            extends ForwardingServerCall.SimpleForwardingServerCall<ReqT, RespT> {
+        private final Validator<RespT> response;
+        private final String[] groups;
+        private volatile boolean closed;
+
+        ValidatingServerCall(ServerCall<ReqT, RespT> call, Validator<RespT> response, String[] groups) {
+            super(call);
+            this.response = response;
+            this.groups = groups;
+        }
+
+        boolean isClosed() {
+            return closed;
+        }
+
+        void close(Status.Code code, List<Violation> violations) {
+            StatusRuntimeException ex = ValidationStatus.asRuntimeException(code, violations);
+            close(ex.getStatus(), ex.getTrailers());
+        }
+
+        @Override
+        public void sendMessage(RespT message) {
+            if (closed) {
+                return;
+            }
+            if (response != null) {
+                List<Violation> violations = response.validateAll(message, groups);
+                if (!violations.isEmpty()) {
+                    close(Status.Code.INTERNAL, violations);
+                    return;
+                }
+            }
+            super.sendMessage(message);
+        }
+
+        @Override
+        public void close(Status status, Metadata trailers) {
+            if (closed) {
+                return;
+            }
+            closed = true;
+            super.close(status, trailers);
+        }
+    }
+}
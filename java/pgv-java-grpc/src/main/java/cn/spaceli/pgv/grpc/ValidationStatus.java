@@ -0,0 +1,51 @@
+package cn.spaceli.pgv.grpc;
+
+import cn.spaceli.pgv.Violation;
+import com.google.protobuf.Any;
+import com.google.rpc.BadRequest;
+import com.google.rpc.Status;
+import io.grpc.StatusRuntimeException;
+import io.grpc.protobuf.StatusProto;
+import java.util.List;
+import java.util.stream.Collectors;
+
+/**
+ * {@code ValidationStatus} turns {@link Violation}s into a gRPC status with a {@link BadRequest} detail holding a
+ * field violation for each of them.
+ */
+public final class ValidationStatus {
+    private ValidationStatus() {
+    }
+
+    /**
+     * Returns the status with {@code code} describing {@code violations}.
+     */
+    public static Status toStatus(io.grpc.Status.Code code, List<Violation> violations) {
+        BadRequest.Builder badRequest = BadRequest.newBuilder();
+        for (Violation violation : violations) {
+            badRequest.addFieldViolations(BadRequest.FieldViolation.newBuilder()
+                .setField(violation.getFieldPath())
+                .setDescription(describe(violation)));
+        }
+        String message = violations.stream()
+            .map(v -> v.getFieldPath().isEmpty() ? describe(v) : v.getFieldPath() + ": " + describe(v))
+            .collect(Collectors.joining("; "));
+        return Status.newBuilder()
+            .setCode(code.value())
+            .setMessage(message)
+            .addDetails(Any.pack(badRequest.build()))
+            .build();
+    }
+
+    /**
+     * Returns the exception of the status with {@code code} describing {@code violations}.
+     */
+    public static StatusRuntimeException asRuntimeException(io.grpc.Status.Code code, List<Violation> violations) {
+        return StatusProto.toStatusRuntimeException(toStatus(code, violations));
+    }
+
+    private static String describe(Violation violation) {
+        String message = violation.getException().getMessage();
+        return message != null ? message : violation.getRule();
+    }
+}
@@ -0,0 +1,181 @@
+package cn.spaceli.pgv.grpc;
+
+import cn.spaceli.pgv.StringValidation;
+import cn.spaceli.pgv.Validator;
+import cn.spaceli.pgv.ViolationCollector;
+import com.google.rpc.BadRequest;
+import io.grpc.Metadata;
+import io.grpc.MethodDescriptor;
+import io.grpc.ServerCall;
+import io.grpc.ServerCallHandler;
+import io.grpc.Status;
+import io.grpc.protobuf.StatusProto;
+import org.junit.Test;
+
+import java.util.ArrayList;
+import java.util.List;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.tuple;
+
+public class ValidatingServerCallsTest {
+    /**
+     * Requires names of at least two characters.
+     */
+    private static final Validator<String> NAME = new Validator<String>() {
+        @Override
+        public void assertValid(String proto) {
+            validateAll(proto, ViolationCollector.FAIL_FAST);
+        }
+
+        @Override
+        public void validateAll(String proto, ViolationCollector violations) {
+            violations.check("name", "string.min_len", () ->
+                    StringValidation.minLength(new IllegalArgumentException("name too short"), proto, 2));
+        }
+    };
+
+    /**
+     * {@code RecordingServerCall} records what the interceptor sends to the client.
+     */
+    private static class RecordingServerCall extends ServerCall<String, String> {
+        final List<String> sent = new ArrayList<>();
+        final List<Status> closed = new ArrayList<>();
+        Metadata trailers;
+
+        @Override
+        public void request(int numMessages) {
+        }
+
+        @Override
+        public void sendHeaders(Metadata headers) {
+        }
+
+        @Override
+        public void sendMessage(String message) {
+            sent.add(message);
+        }
+
+        @Override
+        public void close(Status status, Metadata trailers) {
+            closed.add(status);
+            this.trailers = trailers;
+        }
+
+        @Override
+        public boolean isCancelled() {
+            return false;
+        }
+
+        @Override
+        public MethodDescriptor<String, String> getMethodDescriptor() {
+            return null;
+        }
+    }
+
+    /**
+     * {@code EchoService} answers every request with {@code reply} and records the requests it receives.
+     */
+    private static class EchoService implements ServerCallHandler<String, String> {
+        final List<String> received = new ArrayList<>();
+        final String reply;
+        ServerCall<String, String> call;
+        boolean halfClosed;
+
+        EchoService(String reply) {
+            this.reply = reply;
+        }
+
+        @Override
+        public ServerCall.Listener<String> startCall(ServerCall<String, String> call, Metadata headers) {
+            this.call = call;
+            return new ServerCall.Listener<String>() {
+                @Override
+                public void onMessage(String message) {
+                    received.add(message);
+                    call.sendMessage(reply);
+                }
+
+                @Override
+                public void onHalfClose() {
+                    halfClosed = true;
+                    call.close(Status.OK, new Metadata());
+                }
+            };
+        }
+    }
+
+    private static BadRequest badRequestOf(RecordingServerCall call) throws Exception {
+        com.google.rpc.Status status = StatusProto.fromStatusAndTrailers(call.closed.get(0), call.trailers);
+        assertThat(status.getDetailsList()).hasSize(1);
+        return status.getDetails(0).unpack(BadRequest.class);
+    }
+
+    @Test
+    public void validMessagesPassThrough() {
+        RecordingServerCall call = new RecordingServerCall();
+        EchoService service = new EchoService("ok");
+
+        ServerCall.Listener<String> listener = ValidatingServerCalls.intercept(call, new Metadata(), service, NAME, NAME);
+        listener.onMessage("bob");
+        listener.onHalfClose();
+
+        assertThat(service.received).containsExactly("bob");
+        assertThat(service.halfClosed).isTrue();
+        assertThat(call.sent).containsExactly("ok");
+        assertThat(call.closed).extracting(Status::getCode).containsExactly(Status.Code.OK);
+    }
+
+    @Test
+    public void invalidRequestClosesWithInvalidArgument() throws Exception {
+        RecordingServerCall call = new RecordingServerCall();
+        EchoService service = new EchoService("ok");
+
+        ServerCall.Listener<String> listener = ValidatingServerCalls.intercept(call, new Metadata(), service, NAME, null);
+        listener.onMessage("b");
+        listener.onHalfClose();
+
+        assertThat(service.received).isEmpty();
+        assertThat(service.halfClosed).isFalse();
+        assertThat(call.sent).isEmpty();
+        assertThat(call.closed).extracting(Status::getCode).containsExactly(Status.Code.INVALID_ARGUMENT);
+        assertThat(call.closed.get(0).getDescription()).isEqualTo("name: name too short");
+        assertThat(badRequestOf(call).getFieldViolationsList())
+                .extracting(BadRequest.FieldViolation::getField, BadRequest.FieldViolation::getDescription)
+                .containsExactly(tuple("name", "name too short"));
+    }
+
+    @Test
+    public void invalidResponseClosesWithInternal() throws Exception {
+        RecordingServerCall call = new RecordingServerCall();
+        EchoService service = new EchoService("x");
+
+        ServerCall.Listener<String> listener = ValidatingServerCalls.intercept(call, new Metadata(), service, NAME, NAME);
+        listener.onMessage("bob");
+
+        assertThat(service.received).containsExactly("bob");
+        assertThat(call.sent).isEmpty();
+        assertThat(call.closed).extracting(Status::getCode).containsExactly(Status.Code.INTERNAL);
+        assertThat(badRequestOf(call).getFieldViolationsList())
+                .extracting(BadRequest.FieldViolation::getField)
+                .containsExactly("name");
+    }
+
+    @Test
+    public void nothingIsSentAfterClose() {
+        RecordingServerCall call = new RecordingServerCall();
+        EchoService service = new EchoService("x");
+
+        ServerCall.Listener<String> listener = ValidatingServerCalls.intercept(call, new Metadata(), service, NAME, NAME);
+        listener.onMessage("bob");
+        listener.onMessage("alice");
+        listener.onHalfClose();
+        service.call.sendMessage("late");
+        service.call.close(Status.OK, new Metadata());
+
+        assertThat(service.received).containsExactly("bob");
+        assertThat(service.halfClosed).isFalse();
+        assertThat(call.sent).isEmpty();
+        assertThat(call.closed).extracting(Status::getCode).containsExactly(Status.Code.INTERNAL);
+    }
+}
@@ -0,0 +1,108 @@
+package cn.spaceli.pgv.jakarta;
+
+import cn.spaceli.pgv.ReflectiveValidatorIndex;
+import cn.spaceli.pgv.Validator;
+import cn.spaceli.pgv.ValidatorImpl;
+import cn.spaceli.pgv.ValidatorIndex;
+import cn.spaceli.pgv.Violation;
+import jakarta.validation.ConstraintValidator;
+import jakarta.validation.ConstraintValidatorContext;
+import java.lang.annotation.Annotation;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * {@code ProtoConstraintValidator} runs a generated PGV validator as a Jakarta Bean Validation constraint. Every
+ * {@link Violation} becomes a {@code ConstraintViolation} whose property path follows the field path of the
+ * violation, e.g. {@code address.lines[2]}. The constraint classes generated with {@code java_bean_validation=true}
+ * extend it for each message.
+ * @param <A> The constraint annotation
+ * @param <T> The type to validate
+ */
+public abstract class ProtoConstraintValidator<A extends Annotation, T> implements ConstraintValidator<A, T> {
+    private static final ValidatorIndex INDEX = new ReflectiveValidatorIndex();
+
+    private final Validator<T> validator;
+
+    protected ProtoConstraintValidator(ValidatorImpl<T> impl) {
+        this.validator = impl.bind(INDEX);
+    }
+
+    /**
+     * Validates {@code proto}, reporting a constraint violation for every failed rule. {@code null} is valid, as in
+     * the built-in constraints.
+     */
+    @Override
+    public boolean isValid(T proto, ConstraintValidatorContext context) {
+        if (proto == null) {
+            return true;
+        }
+        List<Violation> violations = validator.validateAll(proto);
+        if (violations.isEmpty()) {
+            return true;
+        }
+
+        context.disableDefaultConstraintViolation();
+        for (Violation violation : violations) {
+            ConstraintValidatorContext.ConstraintViolationBuilder builder =
+                context.buildConstraintViolationWithTemplate(escape(messageOf(violation)));
+            List<String> nodes = propertyNodes(violation.getFieldPath());
+            if (nodes.isEmpty()) {
+                builder.addConstraintViolation();
+                continue;
+            }
+            ConstraintValidatorContext.ConstraintViolationBuilder.NodeBuilderCustomizableContext node =
+                builder.addPropertyNode(nodes.get(0));
+            for (String name : nodes.subList(1, nodes.size())) {
+                node = node.addPropertyNode(name);
+            }
+            node.addConstraintViolation();
+        }
+        return false;
+    }
+
+    private static String messageOf(Violation violation) {
+        String message = violation.getException().getMessage();
+        return message != null ? message : violation.getRule();
+    }
+
+    /**
+     * Escapes the characters message interpolation would otherwise expand.
+     */
+    private static String escape(String message) {
+        StringBuilder out = new StringBuilder(message.length());
+        for (char c : message.toCharArray()) {
+            if (c == '\\' || c == '{' || c == '}' || c == '$') {
+                out.append('\\');
+            }
+            out.append(c);
+        }
+        return out.toString();
+    }
+
+    /**
+     * Splits a field path at the dots outside of brackets, so {@code labels[a.b].value} is {@code labels[a.b]} and
+     * {@code value}.
+     */
+    static List<String> propertyNodes(String fieldPath) {
+        List<String> nodes = new ArrayList<>();
+        if (fieldPath == null || fieldPath.isEmpty()) {
+            return nodes;
+        }
+        int depth = 0;
+        int start = 0;
+        for (int i = 0; i < fieldPath.length(); i++) {
+            char c = fieldPath.charAt(i);
+            if (c == '[') {
+                depth++;
+            } else if (c == ']') {
+                depth--;
+            } else if (c == '.' && depth == 0) {
+                nodes.add(fieldPath.substring(start, i));
+                start = i + 1;
+            }
+        }
+        nodes.add(fieldPath.substring(start));
+        return nodes;
+    }
+}
@@ -0,0 +1,107 @@
+package cn.spaceli.pgv.jakarta;
+
+import cn.spaceli.pgv.StringValidation;
+import cn.spaceli.pgv.ValidatorImpl;
+import cn.spaceli.pgv.ValidatorIndex;
+import cn.spaceli.pgv.ViolationCollector;
+import jakarta.validation.ConstraintValidatorContext;
+import org.junit.Test;
+
+import java.lang.annotation.Annotation;
+import java.lang.reflect.Proxy;
+import java.util.ArrayList;
+import java.util.List;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+public class ProtoConstraintValidatorTest {
+    /**
+     * Validates a name, failing with rules on the name itself, a nested field and a field with a dotted map key.
+     */
+    private static class NameConstraintValidator extends ProtoConstraintValidator<Annotation, String> {
+        NameConstraintValidator() {
+            super(new ValidatorImpl<String>() {
+                @Override
+                public void assertValid(String proto, ValidatorIndex index) {
+                    validateAll(proto, index, ViolationCollector.FAIL_FAST);
+                }
+
+                @Override
+                public void validateAll(String proto, ValidatorIndex index, ViolationCollector violations) {
+                    violations.check("name", "string.min_len", () ->
+                            StringValidation.minLength(new IllegalArgumentException("at least {min} ${chars}"), proto, 1));
+                    violations.within("address", () ->
+                            violations.check("lines[2]", "string.max_len", () ->
+                                    StringValidation.maxLength(new IllegalArgumentException(), proto, 3)));
+                    violations.check("labels[a.b].value", "string.max_len", () ->
+                            StringValidation.maxLength(new IllegalArgumentException("too long"), proto, 5));
+                }
+            });
+        }
+    }
+
+    /**
+     * Returns a {@code ConstraintValidatorContext} logging the calls made on it, and on the builders it returns, to
+     * {@code calls}.
+     */
+    private static ConstraintValidatorContext recordingContext(List<String> calls) {
+        return (ConstraintValidatorContext) recording(ConstraintValidatorContext.class, calls);
+    }
+
+    private static Object recording(Class<?> type, List<String> calls) {
+        return Proxy.newProxyInstance(type.getClassLoader(), new Class<?>[]{type}, (proxy, method, args) -> {
+            calls.add(args == null ? method.getName() : method.getName() + ":" + args[0]);
+            return method.getReturnType().isInterface() ? recording(method.getReturnType(), calls) : null;
+        });
+    }
+
+    @Test
+    public void nullAndValidProtosAreValid() {
+        List<String> calls = new ArrayList<>();
+        NameConstraintValidator validator = new NameConstraintValidator();
+
+        assertThat(validator.isValid(null, recordingContext(calls))).isTrue();
+        assertThat(validator.isValid("abc", recordingContext(calls))).isTrue();
+        assertThat(calls).isEmpty();
+    }
+
+    @Test
+    public void reportsEveryViolationAtItsPath() {
+        List<String> calls = new ArrayList<>();
+        NameConstraintValidator validator = new NameConstraintValidator();
+
+        assertThat(validator.isValid("", recordingContext(calls))).isFalse();
+        assertThat(calls).containsExactly(
+                "disableDefaultConstraintViolation",
+                "buildConstraintViolationWithTemplate:at least \\{min\\} \\$\\{chars\\}",
+                "addPropertyNode:name",
+                "addConstraintViolation");
+    }
+
+    @Test
+    public void splitsNestedPathsIntoPropertyNodes() {
+        List<String> calls = new ArrayList<>();
+        NameConstraintValidator validator = new NameConstraintValidator();
+
+        assertThat(validator.isValid("abcdef", recordingContext(calls))).isFalse();
+        assertThat(calls).containsExactly(
+                "disableDefaultConstraintViolation",
+                "buildConstraintViolationWithTemplate:string.max_len",
+                "addPropertyNode:address",
+                "addPropertyNode:lines[2]",
+                "addConstraintViolation",
+                "buildConstraintViolationWithTemplate:too long",
+                "addPropertyNode:labels[a.b]",
+                "addPropertyNode:value",
+                "addConstraintViolation");
+    }
+
+    @Test
+    public void propertyNodesSplitOutsideBrackets() {
+        assertThat(ProtoConstraintValidator.propertyNodes(null)).isEmpty();
+        assertThat(ProtoConstraintValidator.propertyNodes("")).isEmpty();
+        assertThat(ProtoConstraintValidator.propertyNodes("name")).containsExactly("name");
+        assertThat(ProtoConstraintValidator.propertyNodes("address.lines[2]")).containsExactly("address", "lines[2]");
+        assertThat(ProtoConstraintValidator.propertyNodes("labels[a.b].value")).containsExactly("labels[a.b]", "value");
+    }
+}
@@ -32,8 +32,20 @@ public final class ExplicitValidatorIndex implements ValidatorIndex {
 
     @SuppressWarnings("unchecked")
     public <T> Validator<T> validatorFor(Class clazz) {
-        return VALIDATOR_INDEX.computeIfAbsent(clazz, c ->
-                proto -> VALIDATOR_IMPL_INDEX.getOrDefault(c, (p, i) -> fallbackIndex.validatorFor(c))
-                        .assertValid(proto, ExplicitValidatorIndex.this));
+        return VALIDATOR_INDEX.computeIfAbsent(clazz, c -> new Validator() {
+            @Override
+            public void assertValid(Object proto) {
+                implFor(c).assertValid(proto, ExplicitValidatorIndex.this);
+            }
+
+            @Override
+            public void validateAll(Object proto, ViolationCollector violations) {
+                implFor(c).validateAll(proto, ExplicitValidatorIndex.this, violations);
+            }
+        });
+    }
+
+    private ValidatorImpl implFor(Class clazz) {
+        return VALIDATOR_IMPL_INDEX.getOrDefault(clazz, (p, i) -> fallbackIndex.validatorFor(clazz));
     }
 }
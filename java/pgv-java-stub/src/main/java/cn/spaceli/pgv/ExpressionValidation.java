@@ -0,0 +1,85 @@
+package cn.spaceli.pgv;
+
+import com.google.protobuf.Duration;
+import com.google.protobuf.Timestamp;
+import com.google.protobuf.util.Durations;
+import com.google.protobuf.util.Timestamps;
+import com.google.re2j.Pattern;
+
+import java.util.Map;
+import java.util.concurrent.ConcurrentHashMap;
+
+/**
+ * {@code ExpressionValidation} implements PGV validation for message-level expression rules, and the functions and
+ * operators of expressions Java lacks.
+ */
+@SuppressWarnings("WeakerAccess")
+public final class ExpressionValidation {
+    private static final Map<String, Pattern> PATTERNS = new ConcurrentHashMap<>();
+
+    private ExpressionValidation() {
+        // Intentionally left blank.
+    }
+
+    public static void holds(RuntimeException ex, boolean value) {
+        if (!value) {
+            throw ex;
+        }
+    }
+
+    /**
+     * Returns the number of code points of {@code value}.
+     */
+    public static long size(String value) {
+        return value.codePointCount(0, value.length());
+    }
+
+    /**
+     * Returns true if {@code pattern} matches any substring of {@code value}, as CEL's {@code matches} does.
+     */
+    public static boolean matches(String value, String pattern) {
+        return PATTERNS.computeIfAbsent(pattern, Pattern::compile).matcher(value).find();
+    }
+
+    /**
+     * Converts the uint {@code value}, held in a long, to a double.
+     */
+    public static double unsignedToDouble(long value) {
+        if (value >= 0) {
+            return value;
+        }
+        return ((value >>> 1) | (value & 1)) * 2.0;
+    }
+
+    public static int compare(Timestamp x, Timestamp y) {
+        return Timestamps.compare(x, y);
+    }
+
+    public static int compare(Duration x, Duration y) {
+        return Durations.compare(x, y);
+    }
+
+    public static Timestamp add(Timestamp x, Duration y) {
+        return Timestamps.add(x, y);
+    }
+
+    public static Timestamp subtract(Timestamp x, Duration y) {
+        return Timestamps.subtract(x, y);
+    }
+
+    public static Duration subtract(Timestamp x, Timestamp y) {
+        return Timestamps.between(y, x);
+    }
+
+    public static Duration add(Duration x, Duration y) {
+        return Durations.add(x, y);
+    }
+
+    public static Duration subtract(Duration x, Duration y) {
+        return Durations.subtract(x, y);
+    }
+
+    public static Duration negate(Duration value) {
+        return Durations.subtract(Durations.ZERO, value);
+    }
+}
@@ -0,0 +1,27 @@
+package cn.spaceli.pgv;
+
+import java.util.function.Function;
+
+/**
+ * {@code LazyException} stands in for a rule's error when validators are generated with {@code error_mode=lazy}.
+ * Validation helpers throw it like any other preconfigured exception; {@link ViolationCollector} then replaces it
+ * with a fresh exception built from the offending value, so every failure gets its own instance and stack trace.
+ */
+public final class LazyException extends RuntimeException {
+    private final Function<Object, RuntimeException> factory;
+
+    /**
+     * @param factory builds the real exception from the offending value.
+     */
+    public LazyException(Function<Object, RuntimeException> factory) {
+        super("lazy validation error", null, false, false);
+        this.factory = factory;
+    }
+
+    /**
+     * Builds the real exception for {@code value}.
+     */
+    public RuntimeException create(Object value) {
+        return factory.apply(value);
+    }
+}
@@ -36,4 +36,26 @@ public final class MapValidation {
            validator.accept(val);
        }
     }
+
+    /**
+     * Applies {@code validator} to every key of {@code value}, tracking each key under {@code field}.
+     */
+    public static <K, V> void validateKeys(ViolationCollector violations, String field, Map<K, V> value, MapValidator<K> validator) {
+        violations.within(field, () -> {
+            for (K key : value.keySet()) {
+                violations.within("[" + key + "]", () -> validator.accept(key));
+            }
+        });
+    }
+
+    /**
+     * Applies {@code validator} to every value of {@code value}, tracking each value under its key in {@code field}.
+     */
+    public static <K, V> void validateValues(ViolationCollector violations, String field, Map<K, V> value, MapValidator<V> validator) {
+        violations.within(field, () -> {
+            for (Map.Entry<K, V> entry : value.entrySet()) {
+                violations.within("[" + entry.getKey() + "]", () -> validator.accept(entry.getValue()));
+            }
+        });
+    }
 }
@@ -48,6 +48,6 @@ public final class ReflectiveValidatorIndex implements ValidatorIndex {
         Class validatorClass = clazz.getClassLoader().loadClass(validatorClassName);
         ValidatorImpl impl = (ValidatorImpl) validatorClass.getDeclaredMethod("validatorFor", Class.class).invoke(null, clazz);
 
-        return proto -> impl.assertValid(proto, ReflectiveValidatorIndex.this);
+        return impl.bind(ReflectiveValidatorIndex.this);
     }
 }
@@ -43,4 +43,16 @@ public final class RepeatedValidation {
             consumer.accept(value);
         }
     }
+
+    /**
+     * Applies {@code consumer} to every item of {@code values}, tracking the index of each item under {@code field}.
+     */
+    public static <T> void forEach(ViolationCollector violations, String field, List<T> values, ValidationConsumer<T> consumer) {
+        violations.within(field, () -> {
+            for (int i = 0; i < values.size(); i++) {
+                T value = values.get(i);
+                violations.within("[" + i + "]", () -> consumer.accept(value));
+            }
+        });
+    }
 }
@@ -1,5 +1,8 @@
 package cn.spaceli.pgv;
 
+import com.google.protobuf.FieldMask;
+import java.util.List;
+
 /**
  * {@code Validator} asserts the validity of a protobuf object.
  * @param <T> The type to validate
@@ -29,6 +32,81 @@ public interface Validator<T> {
         }
     }
 
+    /**
+     * Checks every validation rule on a protobuf object, recording each failure in {@code violations} instead of
+     * stopping at the first one.
+     *
+     * @param proto the protobuf object to validate.
+     * @param violations the collector receiving the violations.
+     */
+    default void validateAll(T proto, ViolationCollector violations) {
+        violations.check("", "", () -> assertValid(proto));
+    }
+
+    /**
+     * Asserts the validation rules of {@code groups}, and the rules of no group, on a protobuf object.
+     *
+     * @param proto the protobuf object to validate.
+     * @param groups the validation groups to validate.
+     * @throws RuntimeException with the first validation error encountered.
+     */
+    default void assertValid(T proto, String... groups) throws RuntimeException {
+        validateAll(proto, ViolationCollector.failFast(groups));
+    }
+
+    /**
+     * Asserts the validation rules of the fields {@code mask} covers on a protobuf object, the rules of
+     * {@code groups} along with the rules of no group. An empty mask covers every field.
+     *
+     * @param proto the protobuf object to validate.
+     * @param mask the fields to validate, e.g. the update mask of a partial update.
+     * @param groups the validation groups to validate.
+     * @throws RuntimeException with the first validation error encountered.
+     */
+    default void assertValid(T proto, FieldMask mask, String... groups) throws RuntimeException {
+        validateAll(proto, ViolationCollector.failFast(mask, groups));
+    }
+
+    /**
+     * Checks every validation rule on a protobuf object.
+     *
+     * @param proto the protobuf object to validate.
+     * @return every violation found, empty if {@code proto} is valid.
+     */
+    default List<Violation> validateAll(T proto) {
+        ViolationCollector violations = new ViolationCollector();
+        validateAll(proto, violations);
+        return violations.getViolations();
+    }
+
+    /**
+     * Checks every validation rule of {@code groups}, and every rule of no group, on a protobuf object.
+     *
+     * @param proto the protobuf object to validate.
+     * @param groups the validation groups to validate.
+     * @return every violation found, empty if {@code proto} is valid.
+     */
+    default List<Violation> validateAll(T proto, String... groups) {
+        ViolationCollector violations = new ViolationCollector(groups);
+        validateAll(proto, violations);
+        return violations.getViolations();
+    }
+
+    /**
+     * Checks every validation rule of the fields {@code mask} covers on a protobuf object, the rules of
+     * {@code groups} along with the rules of no group. An empty mask covers every field.
+     *
+     * @param proto the protobuf object to validate.
+     * @param mask the fields to validate, e.g. the update mask of a partial update.
+     * @param groups the validation groups to validate.
+     * @return every violation found, empty if {@code proto} is valid.
+     */
+    default List<Violation> validateAll(T proto, FieldMask mask, String... groups) {
+        ViolationCollector violations = new ViolationCollector(mask, groups);
+        validateAll(proto, violations);
+        return violations.getViolations();
+    }
+
     Validator ALWAYS_VALID = (proto) -> {
         // Do nothing. Always valid.
     };
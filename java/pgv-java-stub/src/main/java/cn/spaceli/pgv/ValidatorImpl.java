@@ -14,6 +14,37 @@ public interface ValidatorImpl<T> {
      */
     void assertValid(T proto, ValidatorIndex index) throws RuntimeException;
 
+    /**
+     * Checks every validation rule on a protobuf object, recording each failure in {@code violations}. Generated
+     * validators override this to report the path and rule of every violation; the default records the first
+     * error of {@link #assertValid(Object, ValidatorIndex)} against the object itself.
+     *
+     * @param proto the protobuf object to validate.
+     * @param index the index used to validate embedded messages.
+     * @param violations the collector receiving the violations.
+     */
+    default void validateAll(T proto, ValidatorIndex index, ViolationCollector violations) {
+        violations.check("", "", () -> assertValid(proto, index));
+    }
+
+    /**
+     * Binds this implementation to {@code index}, producing a {@link Validator}.
+     */
+    default Validator<T> bind(ValidatorIndex index) {
+        ValidatorImpl<T> impl = this;
+        return new Validator<T>() {
+            @Override
+            public void assertValid(T proto) {
+                impl.assertValid(proto, index);
+            }
+
+            @Override
+            public void validateAll(T proto, ViolationCollector violations) {
+                impl.validateAll(proto, index, violations);
+            }
+        };
+    }
+
     ValidatorImpl ALWAYS_VALID = (proto, index) -> {
         // Do nothing. Always valid.
     };
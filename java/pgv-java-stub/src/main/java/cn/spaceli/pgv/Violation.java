@@ -0,0 +1,43 @@
+package cn.spaceli.pgv;
+
+/**
+ * {@code Violation} records a single failed validation rule: the exception the rule was configured to throw, the
+ * path of the offending field and the name of the rule.
+ */
+public final class Violation {
+    private final String fieldPath;
+    private final String rule;
+    private final RuntimeException exception;
+
+    public Violation(String fieldPath, String rule, RuntimeException exception) {
+        this.fieldPath = fieldPath;
+        this.rule = rule;
+        this.exception = exception;
+    }
+
+    /**
+     * Returns the path of the offending field, e.g. {@code address.lines[2]} or {@code labels[env]}.
+     */
+    public String getFieldPath() {
+        return fieldPath;
+    }
+
+    /**
+     * Returns the name of the failed rule, e.g. {@code string.min_len}.
+     */
+    public String getRule() {
+        return rule;
+    }
+
+    /**
+     * Returns the exception the failed rule threw.
+     */
+    public RuntimeException getException() {
+        return exception;
+    }
+
+    @Override
+    public String toString() {
+        return fieldPath + ": " + rule + ": " + exception;
+    }
+}
@@ -0,0 +1,298 @@
+package cn.spaceli.pgv;
+
+import com.google.protobuf.FieldMask;
+import java.util.ArrayDeque;
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.Deque;
+import java.util.HashSet;
+import java.util.List;
+import java.util.Set;
+
+/**
+ * {@code ViolationCollector} gathers the {@link Violation}s found while validating a protobuf object. Generated
+ * validators run every rule through {@link #check(String, String, Object, Check)}; the {@link #FAIL_FAST} collector rethrows
+ * the first exception, any other collector records it and carries on with the next rule. Rules in validation groups
+ * are only checked if the collector validates one of their groups, see {@link #inGroups(String...)}, and the rules of
+ * fields outside of a field mask are skipped, see {@link #withMask(FieldMask, String, Check)}.
+ */
+public final class ViolationCollector {
+    /**
+     * A collector that records nothing and rethrows the first validation error encountered.
+     */
+    public static final ViolationCollector FAIL_FAST = new ViolationCollector(true);
+    // FAIL_FAST is shared by every thread, its masks are kept per thread
+    private static final ThreadLocal<Deque<Mask>> FAIL_FAST_MASKS = ThreadLocal.withInitial(ArrayDeque::new);
+
+    private final boolean failFast;
+    private final Set<String> groups;
+    private final Deque<String> path = new ArrayDeque<>();
+    // the masks of the messages being validated, empty if every field is
+    private final Deque<Mask> masks = new ArrayDeque<>();
+    private final List<Violation> violations = new ArrayList<>();
+
+    public ViolationCollector() {
+        this(false);
+    }
+
+    /**
+     * Creates a collector validating the rules of {@code groups} along with the rules of no group.
+     */
+    public ViolationCollector(String... groups) {
+        this(false, groups);
+    }
+
+    /**
+     * Creates a collector validating the fields {@code mask} covers, with the rules of {@code groups} along with the
+     * rules of no group. An empty mask covers every field.
+     */
+    public ViolationCollector(FieldMask mask, String... groups) {
+        this(false, groups);
+        pushMask(mask, "");
+    }
+
+    private ViolationCollector(boolean failFast, String... groups) {
+        this.failFast = failFast;
+        this.groups = new HashSet<>(Arrays.asList(groups));
+    }
+
+    /**
+     * Returns a collector that rethrows the first validation error encountered, validating the rules of
+     * {@code groups} along with the rules of no group.
+     */
+    public static ViolationCollector failFast(String... groups) {
+        return groups.length == 0 ? FAIL_FAST : new ViolationCollector(true, groups);
+    }
+
+    /**
+     * Returns a collector that rethrows the first validation error encountered, validating the fields {@code mask}
+     * covers with the rules of {@code groups} along with the rules of no group.
+     */
+    public static ViolationCollector failFast(FieldMask mask, String... groups) {
+        if (mask == null || mask.getPathsCount() == 0) {
+            return failFast(groups);
+        }
+        ViolationCollector violations = new ViolationCollector(true, groups);
+        violations.pushMask(mask, "");
+        return violations;
+    }
+
+    @FunctionalInterface
+    public interface Check {
+        void run() throws RuntimeException;
+    }
+
+    /**
+     * Runs a single rule check against {@code field} of the object currently being validated.
+     * @param field the name of the field, or an empty string for the current path itself
+     * @param rule the name of the rule being checked
+     * @param check the check to run
+     */
+    public void check(String field, String rule, Check check) {
+        check(field, rule, null, check);
+    }
+
+    /**
+     * Runs a single rule check against {@code field} of the object currently being validated. A
+     * {@link LazyException} thrown by the check is replaced by the exception it builds from {@code value}.
+     * @param field the name of the field, or an empty string for the current path itself
+     * @param rule the name of the rule being checked
+     * @param value the value being checked
+     * @param check the check to run
+     */
+    public void check(String field, String rule, Object value, Check check) {
+        if (!masks().isEmpty() && !masks().peekLast().covers(field)) {
+            return;
+        }
+        RuntimeException error;
+        try {
+            check.run();
+            return;
+        } catch (LazyException ex) {
+            error = ex.create(value);
+        } catch (RuntimeException ex) {
+            error = ex;
+        }
+
+        if (failFast) {
+            throw error;
+        }
+        violations.add(new Violation(pathTo(field), rule, error));
+    }
+
+    /**
+     * Runs {@code body} with {@code field} appended to the current path, used when descending into embedded
+     * messages, repeated items and map entries.
+     */
+    public void within(String field, Check body) {
+        if (!masks().isEmpty() && !field.isEmpty() && !field.startsWith("[")) {
+            Mask inner = masks().peekLast().descend(field);
+            if (inner.coversNothing()) {
+                return;
+            }
+            masks().addLast(inner);
+            try {
+                withinPath(field, body);
+            } finally {
+                masks().removeLast();
+            }
+            return;
+        }
+        withinPath(field, body);
+    }
+
+    /**
+     * Runs {@code body} validating only the fields of {@code target} that {@code mask} covers, the paths of
+     * {@code mask} being relative to {@code target}, a field of the message being validated. The rules of
+     * {@code target} itself apply unless the enclosing mask excludes it; an empty mask covers every field.
+     */
+    public void withMask(FieldMask mask, String target, Check body) {
+        if (mask == null || mask.getPathsCount() == 0) {
+            body.run();
+            return;
+        }
+        pushMask(mask, target + ".");
+        try {
+            body.run();
+        } finally {
+            masks().removeLast();
+        }
+    }
+
+    private void pushMask(FieldMask mask, String prefix) {
+        if (mask == null || mask.getPathsCount() == 0) {
+            return;
+        }
+        Set<String> paths = new HashSet<>();
+        for (String p : mask.getPathsList()) {
+            paths.add(prefix + p);
+        }
+        Mask enclosing = masks().isEmpty() ? Mask.ALL : masks().peekLast();
+        masks().addLast(enclosing.and(new Mask(paths)));
+    }
+
+    private Deque<Mask> masks() {
+        return this == FAIL_FAST ? FAIL_FAST_MASKS.get() : masks;
+    }
+
+    private void withinPath(String field, Check body) {
+        if (failFast || field.isEmpty()) {
+            body.run();
+            return;
+        }
+
+        path.addLast(pathTo(field));
+        try {
+            body.run();
+        } finally {
+            path.removeLast();
+        }
+    }
+
+    /**
+     * Returns {@code true} if a rule of {@code ruleGroups} applies, that is if this collector validates one of them.
+     */
+    public boolean inGroups(String... ruleGroups) {
+        for (String group : ruleGroups) {
+            if (groups.contains(group)) {
+                return true;
+            }
+        }
+        return false;
+    }
+
+    /**
+     * Returns {@code true} if this collector rethrows instead of collecting.
+     */
+    public boolean isFailFast() {
+        return failFast;
+    }
+
+    /**
+     * Returns the violations collected so far.
+     */
+    public List<Violation> getViolations() {
+        return Collections.unmodifiableList(violations);
+    }
+
+    private String pathTo(String field) {
+        String parent = path.isEmpty() ? "" : path.peekLast();
+        if (field.isEmpty()) {
+            return parent;
+        }
+        if (parent.isEmpty() || field.startsWith("[")) {
+            return parent + field;
+        }
+        return parent + "." + field;
+    }
+
+    /**
+     * {@code Mask} is the part of a field mask below the message being validated: the paths of the fields it
+     * covers, relative to that message.
+     */
+    private static final class Mask {
+        static final Mask ALL = new Mask(null);
+
+        // null if every field is covered
+        private final Set<String> paths;
+        // the mask every covered field must be covered by as well, null if none
+        private final Mask other;
+
+        Mask(Set<String> paths) {
+            this(paths, null);
+        }
+
+        private Mask(Set<String> paths, Mask other) {
+            this.paths = paths;
+            this.other = other;
+        }
+
+        Mask and(Mask mask) {
+            if (paths == null) {
+                return mask;
+            }
+            return new Mask(mask.paths, mask.other == null ? this : mask.other.and(this));
+        }
+
+        /**
+         * Returns {@code true} if the rules of {@code field} apply: the field is covered, is below a covered field or
+         * leads to one. An empty field is the message itself, covered only if all of it is.
+         */
+        boolean covers(String field) {
+            if (other != null && !other.covers(field)) {
+                return false;
+            }
+            if (paths == null) {
+                return true;
+            }
+            if (field.isEmpty()) {
+                return false;
+            }
+            for (String p : paths) {
+                if (p.equals(field) || p.startsWith(field + ".")) {
+                    return true;
+                }
+            }
+            return false;
+        }
+
+        Mask descend(String field) {
+            Mask inner = other == null ? null : other.descend(field);
+            if (paths == null || paths.contains(field)) {
+                return inner == null ? ALL : inner;
+            }
+            Set<String> below = new HashSet<>();
+            for (String p : paths) {
+                if (p.startsWith(field + ".")) {
+                    below.add(p.substring(field.length() + 1));
+                }
+            }
+            return new Mask(below, inner);
+        }
+
+        boolean coversNothing() {
+            return (paths != null && paths.isEmpty()) || (other != null && other.coversNothing());
+        }
+    }
+}
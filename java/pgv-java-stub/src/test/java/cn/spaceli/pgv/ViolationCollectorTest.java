@@ -0,0 +1,111 @@
+package cn.spaceli.pgv;
+
+import com.google.protobuf.FieldMask;
+import org.junit.Test;
+
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.List;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.assertThatThrownBy;
+
+public class ViolationCollectorTest {
+    @Test
+    public void failFastRethrows() throws RuntimeException {
+        TestException ex = new TestException(2, "name too short");
+        ViolationCollector violations = ViolationCollector.FAIL_FAST;
+
+        assertThatThrownBy(() -> violations.check("name", "string.min_len", () -> StringValidation.minLength(ex, "", 1))).isEqualTo(ex);
+        assertThat(violations.getViolations()).isEmpty();
+    }
+
+    @Test
+    public void collectsEveryViolation() throws RuntimeException {
+        TestException nameEx = new TestException(2, "name too short");
+        TestException tagEx = new TestException(3, "tag too short");
+        TestException valueEx = new TestException(4, "value too big");
+        ViolationCollector violations = new ViolationCollector();
+
+        violations.check("name", "string.min_len", () -> StringValidation.minLength(nameEx, "", 1));
+        violations.within("address", () -> {
+            RepeatedValidation.forEach(violations, "lines", Arrays.asList("ok", "x"), item ->
+                    violations.check("", "string.min_len", () -> StringValidation.minLength(tagEx, item, 2)));
+            MapValidation.validateValues(violations, "attrs", Collections.singletonMap("env", 10), value ->
+                    violations.check("", "int32.lt", () -> ComparativeValidation.lessThan(valueEx, value, 5, Integer::compare)));
+        });
+
+        List<Violation> found = violations.getViolations();
+        assertThat(found).extracting(Violation::getFieldPath).containsExactly("name", "address.lines[1]", "address.attrs[env]");
+        assertThat(found).extracting(Violation::getRule).containsExactly("string.min_len", "string.min_len", "int32.lt");
+        assertThat(found).extracting(Violation::getException).containsExactly(nameEx, tagEx, valueEx);
+    }
+
+    @Test
+    public void validatorCollectsFromAssertValid() throws RuntimeException {
+        TestException ex = new TestException(2, "invalid");
+        Validator<String> validator = proto -> StringValidation.minLength(ex, proto, 1);
+
+        assertThat(validator.validateAll("ok")).isEmpty();
+        assertThat(validator.validateAll("")).extracting(Violation::getException).containsExactly(ex);
+    }
+
+    @Test
+    public void lazyExceptionIsBuiltPerFailure() throws RuntimeException {
+        LazyException lazy = new LazyException(value -> new TestException(2, "bad name: " + value));
+
+        assertThatThrownBy(() -> ViolationCollector.FAIL_FAST.check("name", "string.min_len", "", () -> StringValidation.minLength(lazy, "", 1)))
+                .isInstanceOf(TestException.class)
+                .hasMessage("bad name: ");
+
+        ViolationCollector violations = new ViolationCollector();
+        violations.check("name", "string.min_len", "a", () -> StringValidation.minLength(lazy, "a", 2));
+        violations.check("nick", "string.min_len", "b", () -> StringValidation.minLength(lazy, "b", 2));
+        assertThat(violations.getViolations()).extracting(v -> v.getException().getMessage())
+                .containsExactly("bad name: a", "bad name: b");
+    }
+
+    @Test
+    public void checksGroupsItValidates() throws RuntimeException {
+        assertThat(new ViolationCollector().inGroups("create")).isFalse();
+        assertThat(new ViolationCollector("create").inGroups("create", "update")).isTrue();
+        assertThat(new ViolationCollector("update").inGroups("create")).isFalse();
+        assertThat(ViolationCollector.failFast()).isSameAs(ViolationCollector.FAIL_FAST);
+
+        TestException ex = new TestException(2, "name required");
+        ViolationCollector create = ViolationCollector.failFast("create");
+        assertThat(create.isFailFast()).isTrue();
+        assertThatThrownBy(() -> {
+            if (create.inGroups("create")) create.check("name", "string.min_len", () -> StringValidation.minLength(ex, "", 1));
+        }).isEqualTo(ex);
+    }
+
+    @Test
+    public void skipsFieldsOutsideTheMask() throws RuntimeException {
+        TestException ex = new TestException(2, "too short");
+        FieldMask mask = FieldMask.newBuilder().addPaths("title").addPaths("author.name").build();
+        ViolationCollector violations = new ViolationCollector();
+
+        violations.check("etag", "string.min_len", () -> StringValidation.minLength(ex, "", 1));
+        violations.withMask(mask, "book", () -> {
+            violations.check("book", "message.required", () -> {});
+            violations.within("book", () -> {
+                violations.check("title", "string.min_len", () -> StringValidation.minLength(ex, "", 1));
+                violations.check("pages", "int32.gt", () -> ComparativeValidation.greaterThan(ex, 0, 0, Integer::compare));
+                violations.within("author", () -> {
+                    violations.check("name", "string.min_len", () -> StringValidation.minLength(ex, "", 1));
+                    violations.check("email", "string.min_len", () -> StringValidation.minLength(ex, "", 1));
+                });
+                violations.within("publisher", () -> violations.check("name", "string.min_len", () -> StringValidation.minLength(ex, "", 1)));
+            });
+        });
+
+        assertThat(violations.getViolations()).extracting(Violation::getFieldPath)
+                .containsExactly("etag", "book.title", "book.author.name");
+
+        ViolationCollector masked = new ViolationCollector(FieldMask.newBuilder().addPaths("book").build());
+        masked.check("etag", "string.min_len", () -> StringValidation.minLength(ex, "", 1));
+        masked.within("book", () -> masked.check("title", "string.min_len", () -> StringValidation.minLength(ex, "", 1)));
+        assertThat(masked.getViolations()).extracting(Violation::getFieldPath).containsExactly("book.title");
+    }
+}
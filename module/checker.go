@@ -3,14 +3,17 @@ package module
 import (
 	"reflect"
 	"regexp"
+	"strings"
 	"time"
 	"unicode/utf8"
 
 	pgs "github.com/lyft/protoc-gen-star"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
 	"github.com/curl-li/protoc-gen-validate/validate"
 )
 
@@ -40,12 +43,12 @@ type Repeatable interface {
 }
 
 func (m *Module) CheckRules(msg pgs.Message) {
-	m.Push("msg: " + msg.Name().String())
-	defer m.Pop()
+	m.pushAt(strings.TrimPrefix(msg.FullyQualifiedName(), "."), declPath(msg))
+	defer m.pop()
 
 	var disabled bool
 	_, err := msg.Extension(validate.E_Disabled, &disabled)
-	m.CheckErr(err, "unable to read validation extension from message")
+	m.checkErr(err, "unable to read validation extension from message")
 
 	if disabled {
 		m.Debug("validation disabled, skipping checks")
@@ -53,11 +56,14 @@ func (m *Module) CheckRules(msg pgs.Message) {
 	}
 
 	for _, f := range msg.Fields() {
-		m.Push(f.Name().String())
+		m.pushAt(f.Name().String(), optionPath(f, fieldOptions, validate.E_Rules))
 
 		var rules validate.FieldRules
 		_, err = f.Extension(validate.E_Rules, &rules)
-		m.CheckErr(err, "unable to read validation rules from field")
+		if !m.checkErr(err, "unable to read validation rules from field") {
+			m.pop()
+			continue
+		}
 
 		if rules.GetMessage() != nil {
 			m.MustType(f.Type(), pgs.MessageT, pgs.UnknownWKT)
@@ -65,11 +71,270 @@ func (m *Module) CheckRules(msg pgs.Message) {
 		}
 
 		m.CheckFieldRules(f.Type(), &rules, false)
+		m.CheckErrorArgs(f.Name().String(), &rules)
+		m.CheckWhens(f, &rules)
+		m.CheckRequired(f, &rules)
+		m.CheckGroups(msg.File(), &rules)
+		if m.severities != nil {
+			m.LintField(f, &rules)
+		}
+
+		m.pop()
+	}
+
+	for _, oo := range msg.OneOfs() {
+		m.pushAt(oo.Name().String(), optionPath(oo, oneofOptions, validate.E_Oneof))
+
+		rule, err := shared.OneOfRule(oo)
+		if m.checkErr(err, "unable to read validation rules from oneof") {
+			m.CheckErrorArgs(oo.Name().String(), rule)
+			m.CheckGroups(msg.File(), rule)
+			if m.severities != nil {
+				m.LintOneOf(rule)
+			}
+		}
+
+		m.pop()
+	}
+
+	m.CheckExprRules(msg)
+	m.CheckFieldMask(msg)
+}
+
+// CheckFieldMask asserts that the field mask rule of msg names a
+// google.protobuf.FieldMask field and a singular message field of msg.
+func (m *Module) CheckFieldMask(msg pgs.Message) {
+	rule, err := shared.FieldMaskRule(msg)
+	if !m.checkErr(err, "unable to read field mask rule from message") || rule == nil {
+		return
+	}
+
+	m.pushAt("field_mask", optionPath(msg, messageOptions, validate.E_FieldMask))
+	defer m.pop()
+
+	lang := m.Parameters().Str(langParam)
+	m.assert(extendedRules(lang), "field masks are not supported by `lang` ", lang)
+
+	mask := fieldNamed(msg, rule.GetField())
+	if mask == nil {
+		m.failf("unknown field %s", rule.GetField())
+	} else {
+		m.assert(singularMessage(mask) && mask.Type().Embed().FullyQualifiedName() == ".google.protobuf.FieldMask",
+			"field ", rule.GetField(), " is not a google.protobuf.FieldMask")
+	}
+
+	target := fieldNamed(msg, rule.GetTarget())
+	if target == nil {
+		m.failf("unknown target %s", rule.GetTarget())
+		return
+	}
+	m.assert(singularMessage(target) && !target.InRealOneOf(),
+		"target ", rule.GetTarget(), " is not a message field outside of a oneof")
+	m.assert(target != mask, "target ", rule.GetTarget(), " is the field mask itself")
+}
+
+func fieldNamed(msg pgs.Message, name string) pgs.Field {
+	for _, f := range msg.Fields() {
+		if f.Name().String() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func singularMessage(f pgs.Field) bool {
+	return f.Type().IsEmbed() && !f.Type().IsRepeated() && !f.Type().IsMap()
+}
+
+// CheckMethodRules asserts that the rules of the methods of svc can be
+// honored: strict methods validate messages that have rules.
+func (m *Module) CheckMethodRules(svc pgs.Service) {
+	m.pushAt(strings.TrimPrefix(svc.FullyQualifiedName(), "."), declPath(svc))
+	defer m.pop()
+
+	for _, method := range svc.Methods() {
+		m.pushAt(method.Name().String(), optionPath(method, methodOptions, validate.E_Method))
 
-		m.Pop()
+		rules, err := shared.MethodRules(method)
+		if !m.checkErr(err, "unable to read validation rules from method") {
+			m.pop()
+			continue
+		}
+
+		if len(rules.GetGroups()) > 0 {
+			m.checkGroupRefs(rules.GetGroups(), method.Input().File(), method.Output().File())
+		}
+		if rules.GetStrict() {
+			if !rules.GetSkip() {
+				m.assert(hasRules(method.Input()), "strict method has request ",
+					method.Input().FullyQualifiedName(), " without validation rules")
+			}
+			if rules.GetValidateResponse() {
+				m.assert(hasRules(method.Output()), "strict method has response ",
+					method.Output().FullyQualifiedName(), " without validation rules")
+			}
+		}
+
+		m.pop()
+	}
+}
+
+// CheckDeclaredGroups asserts that the validation groups declared by f are
+// named and unique.
+func (m *Module) CheckDeclaredGroups(f pgs.File) {
+	groups, err := shared.DeclaredGroups(f)
+	if !m.checkErr(err, "unable to read validation groups from file") {
+		return
+	}
+
+	groupsNum := int32(validate.E_Groups.TypeDescriptor().Number())
+	seen := map[string]bool{}
+	for i, g := range groups {
+		m.pushAt("", []int32{fileOptions, groupsNum, int32(i)})
+		m.assert(g != "", "validation group must have a name")
+		m.assert(!seen[g], "validation group ", g, " declared twice")
+		m.pop()
+		seen[g] = true
 	}
 }
 
+// CheckGroups asserts that the rules reachable from rules belong to groups
+// declared by f, the file of the message they validate.
+func (m *Module) CheckGroups(f pgs.File, rules proto.Message) {
+	shared.WalkGroups(rules, func(_ protoreflect.Message, groups []string) {
+		m.checkGroupRefs(groups, f)
+	})
+}
+
+// checkGroupRefs asserts that each of groups is declared by one of files, and
+// that the validators of the language honor groups.
+func (m *Module) checkGroupRefs(groups []string, files ...pgs.File) {
+	lang := m.Parameters().Str(langParam)
+	m.assert(extendedRules(lang), "validation groups are not supported by `lang` ", lang)
+
+	declared := map[string]bool{}
+	for _, f := range files {
+		fileGroups, err := shared.DeclaredGroups(f)
+		m.checkErr(err, "unable to read validation groups from file")
+		for _, g := range fileGroups {
+			declared[g] = true
+		}
+	}
+	for _, g := range groups {
+		m.assert(declared[g], "validation group ", g, " is not declared")
+	}
+}
+
+// extendedRules reports whether the validators of lang honor validation
+// groups, field masks, expression rules and when conditions. JSON Schema
+// leaves out the rules it has no keyword for.
+func extendedRules(lang string) bool {
+	switch lang {
+	case "java", "kotlin", "manifest", "jsonschema", "openapi", lintLang:
+		return true
+	}
+	return false
+}
+
+// hasRules returns true if validators are generated for msg, from a file
+// importing PGV, and validate it.
+func hasRules(msg pgs.Message) bool {
+	if disabled, err := shared.Disabled(msg); err != nil || disabled {
+		return false
+	}
+	if ignored, err := shared.Ignored(msg); err != nil || ignored {
+		return false
+	}
+	for _, dep := range msg.File().Descriptor().GetDependency() {
+		if strings.HasSuffix(dep, "validate.proto") {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckExprRules asserts that the expression rules of msg are well typed bool
+// expressions over its fields.
+func (m *Module) CheckExprRules(msg pgs.Message) {
+	rules, err := shared.ExprRules(msg)
+	if !m.checkErr(err, "unable to read expression rules from message") {
+		return
+	}
+
+	m.pushAtRepeated("expr", optionPath(msg, messageOptions, validate.E_Expr))
+	defer m.pop()
+	lang := m.Parameters().Str(langParam)
+	for i, r := range rules {
+		m.atRule(i)
+
+		m.assert(extendedRules(lang), "expression rules are not supported by `lang` ", lang)
+		m.assert(r.Error != nil, "cannot have nil error on rule")
+		name := msg.Name().String()
+		if r.Field != nil {
+			name = r.GetField()
+			found := false
+			for _, f := range msg.Fields() {
+				found = found || f.Name().String() == name
+			}
+			m.assert(found, "expression rule reports unknown field ", name)
+		}
+
+		_, err := shared.CheckExpr(msg, r.GetExpression())
+		m.checkErr(err, "invalid expression `", r.GetExpression(), "`")
+		m.CheckErrorArgs(name, r)
+		m.CheckGroups(msg.File(), r)
+	}
+}
+
+// CheckErrorArgs asserts that the args of every error declared in rules are
+// well formed and refer to bounds of their own rule.
+func (m *Module) CheckErrorArgs(name string, rules proto.Message) {
+	lang := m.Parameters().Str(langParam)
+	errorMode := m.Parameters().Str(errorModeParam)
+	shared.WalkErrors(rules, func(rule protoreflect.Message, e *validate.Error) {
+		args, err := shared.ErrorArgs(name, rule, e)
+		m.checkErr(err, "malformed error args")
+		for _, a := range args {
+			if a.Kind == shared.ValueArg {
+				m.assert(valueArgs(lang, errorMode),
+					"$value is only known to errors built with error_mode=lazy by `lang` ", lang)
+			}
+		}
+	})
+}
+
+// valueArgs returns true if the errors generated for lang can take the
+// validated value, which Java and Kotlin only know with error_mode=lazy.
+func valueArgs(lang, errorMode string) bool {
+	switch lang {
+	case "java", "kotlin":
+		return errorMode == "lazy"
+	}
+	return true
+}
+
+// CheckWhens asserts that the when conditions of rules compare sibling fields
+// of f to values of their type.
+func (m *Module) CheckWhens(f pgs.Field, rules proto.Message) {
+	lang := m.Parameters().Str(langParam)
+	shared.WalkWhens(rules, func(_ protoreflect.Message, w *validate.When) {
+		m.assert(extendedRules(lang), "when conditions are not supported by `lang` ", lang)
+		_, err := shared.WhenExpr(f.Message(), w)
+		m.checkErr(err, "invalid condition")
+	})
+}
+
+// CheckRequired asserts that the `required` scalar rules of f are declared on
+// a field with explicit presence.
+func (m *Module) CheckRequired(f pgs.Field, rules *validate.FieldRules) {
+	if !shared.ScalarRequired(rules) {
+		return
+	}
+	m.assert(!f.Type().IsEmbed(), "`required` should not be used on wrapper fields, use the message `required` rule")
+	m.assert(!f.InRealOneOf(), "`required` should not be used on oneof fields, use the oneof `required` option")
+	m.assert(f.HasPresence(), "`required` is only applicable to fields with presence, declare the field `optional`")
+}
+
 func (m *Module) CheckFieldRules(typ FieldType, rules *validate.FieldRules, inject bool) {
 	if rules == nil {
 		return
@@ -135,7 +400,7 @@ func (m *Module) CheckFieldRules(typ FieldType, rules *validate.FieldRules, inje
 		m.CheckTimestampRules(typ, r.Timestamp, inject)
 	case nil: // noop
 	default:
-		m.Failf("unknown rule type (%T)", rules.Type)
+		m.failf("unknown rule type (%T)", rules.Type)
 	}
 }
 
@@ -146,11 +411,11 @@ func (m *Module) MustType(typ FieldType, pt pgs.ProtoType, wrapper pgs.WellKnown
 	}
 
 	if typ, ok := typ.(Repeatable); ok {
-		m.Assert(!typ.IsRepeated(),
+		m.assert(!typ.IsRepeated(),
 			"repeated rule should be used for repeated fields")
 	}
 
-	m.Assert(typ.ProtoType() == pt,
+	m.assert(typ.ProtoType() == pt,
 		" expected rules for ",
 		typ.ProtoType().Proto(),
 		" but got ",
@@ -163,56 +428,58 @@ func (m *Module) CheckFloat(rules *validate.FloatRules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *float32
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -221,56 +488,58 @@ func (m *Module) CheckDouble(rules *validate.DoubleRules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *float64
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -279,56 +548,58 @@ func (m *Module) CheckInt32(rules *validate.Int32Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *int32
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -337,56 +608,58 @@ func (m *Module) CheckInt64(rules *validate.Int64Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *int64
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -395,56 +668,58 @@ func (m *Module) CheckUInt32(rules *validate.UInt32Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *uint32
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -453,56 +728,58 @@ func (m *Module) CheckUInt64(rules *validate.UInt64Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *uint64
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -511,56 +788,58 @@ func (m *Module) CheckSInt32(rules *validate.SInt32Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *int32
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -569,56 +848,58 @@ func (m *Module) CheckSInt64(rules *validate.SInt64Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *int64
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -627,56 +908,58 @@ func (m *Module) CheckFixed32(rules *validate.Fixed32Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *uint32
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -685,56 +968,58 @@ func (m *Module) CheckFixed64(rules *validate.Fixed64Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *uint64
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -743,56 +1028,58 @@ func (m *Module) CheckSFixed32(rules *validate.SFixed32Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *int32
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -801,56 +1088,58 @@ func (m *Module) CheckSFixed64(rules *validate.SFixed64Rules, inject bool) {
 		in, notIn            bool
 		ct, lt, lte, gt, gte *int64
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
 			ct = r.Const
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lt` and `const` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lt = r.Lt
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `lte` and `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
 			lte = r.Lte
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gt` and `const` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
 			gt = r.Gt
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			gte = gte
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `gte` and `const` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			gte = r.Gte
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkNums(len(r.In), len(r.NotIn), r.Const, r.Lt, r.Lte, r.Gt, r.Gte)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -860,106 +1149,109 @@ func (m *Module) CheckStringRules(rules *validate.StringRules, inject bool) {
 		length, minLen, maxLen, lenBs, minBs, maxBs          *uint64
 		in, notIn, ct, prefix, suffix, contains, notContains bool
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(!ct, "cannot have multi `const` rules on the same field")
-			m.Assert(length == nil, "cannot have both `const` and `len` rules on the same field")
-			m.Assert(minLen == nil, "cannot have both `const` and `min_len` rules on the same field")
-			m.Assert(maxLen == nil, "cannot have both `const` and `max_len` rules on the same field")
-			m.Assert(lenBs == nil, "cannot have both `const` and `len_bytes` rules on the same field")
-			m.Assert(minBs == nil, "cannot have both `const` and `min_bytes` rules on the same field")
-			m.Assert(maxBs == nil, "cannot have both `const` and `max_bytes` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(!prefix, "cannot have both `const` and `prefix` rules on the same field")
-			m.Assert(!suffix, "cannot have both `const` and `suffix` rules on the same field")
-			m.Assert(!contains, "cannot have both `const` and `contains` rules on the same field")
-			m.Assert(!notContains, "cannot have both `const` and `not_contains` rules on the same field")
-			m.Assert(!pattern, "cannot have both `const` and `pattern` rules on the same field")
-			m.Assert(!wk, "cannot have both `const` and `well_known` rules on the same field")
+			m.assert(!ct, "cannot have multi `const` rules on the same field")
+			m.assert(length == nil, "cannot have both `const` and `len` rules on the same field")
+			m.assert(minLen == nil, "cannot have both `const` and `min_len` rules on the same field")
+			m.assert(maxLen == nil, "cannot have both `const` and `max_len` rules on the same field")
+			m.assert(lenBs == nil, "cannot have both `const` and `len_bytes` rules on the same field")
+			m.assert(minBs == nil, "cannot have both `const` and `min_bytes` rules on the same field")
+			m.assert(maxBs == nil, "cannot have both `const` and `max_bytes` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(!prefix, "cannot have both `const` and `prefix` rules on the same field")
+			m.assert(!suffix, "cannot have both `const` and `suffix` rules on the same field")
+			m.assert(!contains, "cannot have both `const` and `contains` rules on the same field")
+			m.assert(!notContains, "cannot have both `const` and `not_contains` rules on the same field")
+			m.assert(!pattern, "cannot have both `const` and `pattern` rules on the same field")
+			m.assert(!wk, "cannot have both `const` and `well_known` rules on the same field")
+			ct = true
 		}
 		if r.Len != nil {
-			m.Assert(length == nil, "cannot have multi `len` rules on the same field")
-			m.Assert(!ct, "cannot have both `len` and `const` rules on the same field")
-			m.Assert(minLen == nil, "cannot have both `len` and `min_len` rules on the same field")
-			m.Assert(maxLen == nil, "cannot have both `len` and `max_len` rules on the same field")
+			m.assert(length == nil, "cannot have multi `len` rules on the same field")
+			m.assert(!ct, "cannot have both `len` and `const` rules on the same field")
+			m.assert(minLen == nil, "cannot have both `len` and `min_len` rules on the same field")
+			m.assert(maxLen == nil, "cannot have both `len` and `max_len` rules on the same field")
 			length = r.Len
 		}
 		if r.MinLen != nil {
-			m.Assert(minLen == nil, "cannot have multi `min_len` rules on the same field")
-			m.Assert(!ct, "cannot have both `min_len` and `const` rules on the same field")
-			m.Assert(length == nil, "cannot have both `len` and `min_len` rules on the same field")
+			m.assert(minLen == nil, "cannot have multi `min_len` rules on the same field")
+			m.assert(!ct, "cannot have both `min_len` and `const` rules on the same field")
+			m.assert(length == nil, "cannot have both `len` and `min_len` rules on the same field")
 			minLen = r.MinLen
 		}
 		if r.MaxLen != nil {
-			m.Assert(maxLen == nil, "cannot have multi `max_len` rules on the same field")
-			m.Assert(!ct, "cannot have both `max_len` and `const` rules on the same field")
-			m.Assert(length == nil, "cannot have both `len` and `max_len` rules on the same field")
+			m.assert(maxLen == nil, "cannot have multi `max_len` rules on the same field")
+			m.assert(!ct, "cannot have both `max_len` and `const` rules on the same field")
+			m.assert(length == nil, "cannot have both `len` and `max_len` rules on the same field")
 			maxLen = r.MaxLen
 		}
 		if r.LenBytes != nil {
-			m.Assert(lenBs == nil, "cannot have multi `len_bytes` rules on the same field")
-			m.Assert(!ct, "cannot have both `len_bytes` and `const` rules on the same field")
-			m.Assert(minBs == nil, "cannot have both `len_bytes` and `min_bytes` rules on the same field")
-			m.Assert(maxBs == nil, "cannot have both `len_bytes` and `max_bytes` rules on the same field")
+			m.assert(lenBs == nil, "cannot have multi `len_bytes` rules on the same field")
+			m.assert(!ct, "cannot have both `len_bytes` and `const` rules on the same field")
+			m.assert(minBs == nil, "cannot have both `len_bytes` and `min_bytes` rules on the same field")
+			m.assert(maxBs == nil, "cannot have both `len_bytes` and `max_bytes` rules on the same field")
 			lenBs = r.LenBytes
 		}
 		if r.MinBytes != nil {
-			m.Assert(minBs == nil, "cannot have multi `min_bytes` rules on the same field")
-			m.Assert(!ct, "cannot have both `min_bytes` and `const` rules on the same field")
-			m.Assert(lenBs == nil, "cannot have both `len_bytes` and `min_bytes` rules on the same field")
+			m.assert(minBs == nil, "cannot have multi `min_bytes` rules on the same field")
+			m.assert(!ct, "cannot have both `min_bytes` and `const` rules on the same field")
+			m.assert(lenBs == nil, "cannot have both `len_bytes` and `min_bytes` rules on the same field")
 			minBs = r.MinBytes
 		}
-		if r.MaxLen != nil {
-			m.Assert(maxBs == nil, "cannot have multi `max_bytes` rules on the same field")
-			m.Assert(!ct, "cannot have both `max_bytes` and `const` rules on the same field")
-			m.Assert(lenBs == nil, "cannot have both `len_bytes` and `max_bytes` rules on the same field")
+		if r.MaxBytes != nil {
+			m.assert(maxBs == nil, "cannot have multi `max_bytes` rules on the same field")
+			m.assert(!ct, "cannot have both `max_bytes` and `const` rules on the same field")
+			m.assert(lenBs == nil, "cannot have both `len_bytes` and `max_bytes` rules on the same field")
 			maxBs = r.MaxBytes
 		}
 		if r.Pattern != nil {
-			m.Assert(!pattern, "cannot have multi `pattern` rules on the same field")
-			m.Assert(!ct, "cannot have both `pattern` and `const` rules on the same field")
-			m.Assert(!wk, "cannot have both `pattern` and `well_known` rules on the same field")
+			m.assert(!pattern, "cannot have multi `pattern` rules on the same field")
+			m.assert(!ct, "cannot have both `pattern` and `const` rules on the same field")
+			m.assert(!wk, "cannot have both `pattern` and `well_known` rules on the same field")
 			pattern = true
 		}
 		if r.Prefix != nil {
-			m.Assert(!prefix, "cannot have multi `prefix` rules on the same field")
+			m.assert(!prefix, "cannot have multi `prefix` rules on the same field")
 			prefix = true
 		}
 		if r.Suffix != nil {
-			m.Assert(!suffix, "cannot have multi `suffix` rules on the same field")
+			m.assert(!suffix, "cannot have multi `suffix` rules on the same field")
 			suffix = true
 		}
 		if r.Contains != nil {
-			m.Assert(!contains, "cannot have multi `contains` rules on the same field")
+			m.assert(!contains, "cannot have multi `contains` rules on the same field")
 			contains = true
 		}
 		if r.NotContains != nil {
-			m.Assert(!notContains, "cannot have multi `not_contains` rules on the same field")
+			m.assert(!notContains, "cannot have multi `not_contains` rules on the same field")
 			notContains = true
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(!ct, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(!ct, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(!ct, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(!ct, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.WellKnown != nil {
-			m.Assert(!wk, "cannot have multi `well_known` rules on the same field")
-			m.Assert(!ct, "cannot have both `well_known` and `const` rules on the same field")
-			m.Assert(!pattern, "cannot have both `pattern` and `well_known` rules on the same field")
+			m.assert(!wk, "cannot have multi `well_known` rules on the same field")
+			m.assert(!ct, "cannot have both `well_known` and `const` rules on the same field")
+			m.assert(!pattern, "cannot have both `pattern` and `well_known` rules on the same field")
 			wk = true
 		}
 
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.CheckString(r)
 	}
+	m.atRule(-1)
 
 	m.checkLen(length, minLen, maxLen)
 	m.checkLen(lenBs, minBs, maxBs)
@@ -978,22 +1270,22 @@ func (m *Module) CheckString(r *validate.StringRule) {
 
 	if r.MaxLen != nil {
 		max := int(r.GetMaxLen())
-		m.Assert(utf8.RuneCountInString(r.GetPrefix()) <= max, "`prefix` length exceeds the `max_len`")
-		m.Assert(utf8.RuneCountInString(r.GetSuffix()) <= max, "`suffix` length exceeds the `max_len`")
-		m.Assert(utf8.RuneCountInString(r.GetContains()) <= max, "`contains` length exceeds the `max_len`")
+		m.assert(utf8.RuneCountInString(r.GetPrefix()) <= max, "`prefix` length exceeds the `max_len`")
+		m.assert(utf8.RuneCountInString(r.GetSuffix()) <= max, "`suffix` length exceeds the `max_len`")
+		m.assert(utf8.RuneCountInString(r.GetContains()) <= max, "`contains` length exceeds the `max_len`")
 
-		m.Assert(
+		m.assert(
 			r.MaxBytes == nil || r.GetMaxBytes() >= r.GetMaxLen(),
 			"`max_len` cannot exceed `max_bytes`")
 	}
 
 	if r.MaxBytes != nil {
 		max := int(r.GetMaxBytes())
-		m.Assert(len(r.GetPrefix()) <= max, "`prefix` length exceeds the `max_bytes`")
-		m.Assert(len(r.GetSuffix()) <= max, "`suffix` length exceeds the `max_bytes`")
-		m.Assert(len(r.GetContains()) <= max, "`contains` length exceeds the `max_bytes`")
+		m.assert(len(r.GetPrefix()) <= max, "`prefix` length exceeds the `max_bytes`")
+		m.assert(len(r.GetSuffix()) <= max, "`suffix` length exceeds the `max_bytes`")
+		m.assert(len(r.GetContains()) <= max, "`contains` length exceeds the `max_bytes`")
 	}
-	m.Assert(len(r.Error.GetMethod()) > 0, "method to create error instance can not be empty")
+	m.assert(len(r.Error.GetMethod()) > 0, "method to create error instance can not be empty")
 }
 
 func (m *Module) CheckBytesRules(rules *validate.BytesRules, inject bool) {
@@ -1002,79 +1294,82 @@ func (m *Module) CheckBytesRules(rules *validate.BytesRules, inject bool) {
 		length, minLen, maxLen                  *uint64
 		in, notIn, ct, prefix, suffix, contains bool
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(!ct, "cannot have multi `const` rules on the same field")
-			m.Assert(length == nil, "cannot have both `const` and `len` rules on the same field")
-			m.Assert(minLen == nil, "cannot have both `const` and `min_len` rules on the same field")
-			m.Assert(maxLen == nil, "cannot have both `const` and `max_len` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(!prefix, "cannot have both `const` and `prefix` rules on the same field")
-			m.Assert(!suffix, "cannot have both `const` and `suffix` rules on the same field")
-			m.Assert(!contains, "cannot have both `const` and `contains` rules on the same field")
-			m.Assert(!pattern, "cannot have both `const` and `pattern` rules on the same field")
-			m.Assert(!wk, "cannot have both `const` and `well_known` rules on the same field")
+			m.assert(!ct, "cannot have multi `const` rules on the same field")
+			m.assert(length == nil, "cannot have both `const` and `len` rules on the same field")
+			m.assert(minLen == nil, "cannot have both `const` and `min_len` rules on the same field")
+			m.assert(maxLen == nil, "cannot have both `const` and `max_len` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(!prefix, "cannot have both `const` and `prefix` rules on the same field")
+			m.assert(!suffix, "cannot have both `const` and `suffix` rules on the same field")
+			m.assert(!contains, "cannot have both `const` and `contains` rules on the same field")
+			m.assert(!pattern, "cannot have both `const` and `pattern` rules on the same field")
+			m.assert(!wk, "cannot have both `const` and `well_known` rules on the same field")
+			ct = true
 		}
 		if r.Len != nil {
-			m.Assert(length == nil, "cannot have multi `len` rules on the same field")
-			m.Assert(!ct, "cannot have both `len` and `const` rules on the same field")
-			m.Assert(minLen == nil, "cannot have both `len` and `min_len` rules on the same field")
-			m.Assert(maxLen == nil, "cannot have both `len` and `max_len` rules on the same field")
+			m.assert(length == nil, "cannot have multi `len` rules on the same field")
+			m.assert(!ct, "cannot have both `len` and `const` rules on the same field")
+			m.assert(minLen == nil, "cannot have both `len` and `min_len` rules on the same field")
+			m.assert(maxLen == nil, "cannot have both `len` and `max_len` rules on the same field")
 			length = r.Len
 		}
 		if r.MinLen != nil {
-			m.Assert(minLen == nil, "cannot have multi `min_len` rules on the same field")
-			m.Assert(!ct, "cannot have both `min_len` and `const` rules on the same field")
-			m.Assert(length == nil, "cannot have both `len` and `min_len` rules on the same field")
+			m.assert(minLen == nil, "cannot have multi `min_len` rules on the same field")
+			m.assert(!ct, "cannot have both `min_len` and `const` rules on the same field")
+			m.assert(length == nil, "cannot have both `len` and `min_len` rules on the same field")
 			minLen = r.MinLen
 		}
 		if r.MaxLen != nil {
-			m.Assert(maxLen == nil, "cannot have multi `max_len` rules on the same field")
-			m.Assert(!ct, "cannot have both `max_len` and `const` rules on the same field")
-			m.Assert(length == nil, "cannot have both `len` and `max_len` rules on the same field")
+			m.assert(maxLen == nil, "cannot have multi `max_len` rules on the same field")
+			m.assert(!ct, "cannot have both `max_len` and `const` rules on the same field")
+			m.assert(length == nil, "cannot have both `len` and `max_len` rules on the same field")
 			maxLen = r.MaxLen
 		}
 		if r.Pattern != nil {
-			m.Assert(!pattern, "cannot have multi `pattern` rules on the same field")
-			m.Assert(!ct, "cannot have both `pattern` and `const` rules on the same field")
-			m.Assert(!wk, "cannot have both `pattern` and `well_known` rules on the same field")
+			m.assert(!pattern, "cannot have multi `pattern` rules on the same field")
+			m.assert(!ct, "cannot have both `pattern` and `const` rules on the same field")
+			m.assert(!wk, "cannot have both `pattern` and `well_known` rules on the same field")
 			pattern = true
 		}
 		if r.Prefix != nil {
-			m.Assert(!prefix, "cannot have multi `prefix` rules on the same field")
+			m.assert(!prefix, "cannot have multi `prefix` rules on the same field")
 			prefix = true
 		}
 		if r.Suffix != nil {
-			m.Assert(!suffix, "cannot have multi `suffix` rules on the same field")
+			m.assert(!suffix, "cannot have multi `suffix` rules on the same field")
 			suffix = true
 		}
 		if r.Contains != nil {
-			m.Assert(!contains, "cannot have multi `contains` rules on the same field")
+			m.assert(!contains, "cannot have multi `contains` rules on the same field")
 			contains = true
 		}
 		if len(r.In) > 0 {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(!ct, "cannot have both `in` and `const` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(!ct, "cannot have both `in` and `const` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if len(r.NotIn) > 0 {
-			m.Assert(!notIn, "cannot have multi `in` rules on the same field")
-			m.Assert(!ct, "cannot have both `not_in` and `const` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(!ct, "cannot have both `not_in` and `const` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.WellKnown != nil {
-			m.Assert(!wk, "cannot have multi `well_known` rules on the same field")
-			m.Assert(!ct, "cannot have both `well_known` and `const` rules on the same field")
-			m.Assert(!pattern, "cannot have both `pattern` and `well_known` rules on the same field")
+			m.assert(!wk, "cannot have multi `well_known` rules on the same field")
+			m.assert(!ct, "cannot have both `well_known` and `const` rules on the same field")
+			m.assert(!pattern, "cannot have both `pattern` and `well_known` rules on the same field")
 			wk = true
 		}
 
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.CheckBytes(r)
 	}
+	m.atRule(-1)
 
 	m.checkLen(length, minLen, maxLen)
 	m.checkMinMax(minLen, maxLen)
@@ -1087,14 +1382,14 @@ func (m *Module) CheckBytes(r *validate.BytesRule) {
 
 	if r.MaxLen != nil {
 		max := int(r.GetMaxLen())
-		m.Assert(len(r.GetPrefix()) <= max, "`prefix` length exceeds the `max_len`")
-		m.Assert(len(r.GetSuffix()) <= max, "`suffix` length exceeds the `max_len`")
-		m.Assert(len(r.GetContains()) <= max, "`contains` length exceeds the `max_len`")
+		m.assert(len(r.GetPrefix()) <= max, "`prefix` length exceeds the `max_len`")
+		m.assert(len(r.GetSuffix()) <= max, "`suffix` length exceeds the `max_len`")
+		m.assert(len(r.GetContains()) <= max, "`contains` length exceeds the `max_len`")
 	}
 }
 
 func (m *Module) CheckEnum(ft FieldType, r *validate.EnumRules, inject bool) {
-	m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+	m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 	m.checkIns(len(r.In), len(r.NotIn))
 
 	if r.GetDefinedOnly() && len(r.In) > 0 {
@@ -1103,7 +1398,8 @@ func (m *Module) CheckEnum(ft FieldType, r *validate.EnumRules, inject bool) {
 		})
 
 		if !ok {
-			m.Failf("unexpected field type (%T)", ft)
+			m.failf("unexpected field type (%T)", ft)
+			return
 		}
 
 		defined := typ.Enum().Values()
@@ -1115,61 +1411,67 @@ func (m *Module) CheckEnum(ft FieldType, r *validate.EnumRules, inject bool) {
 
 		for _, in := range r.In {
 			if _, ok = vals[in]; !ok {
-				m.Failf("undefined `in` value (%d) conflicts with `defined_only` rule")
+				m.failf("undefined `in` value (%d) conflicts with `defined_only` rule", in)
 			}
 		}
 	}
 }
 
 func (m *Module) CheckMessage(f pgs.Field, rules *validate.FieldRules, inject bool) {
-	m.Assert(f.Type().IsEmbed(), "field is not embedded but got message rules")
+	m.assert(f.Type().IsEmbed(), "field is not embedded but got message rules")
 	emb := f.Type().Embed()
 	if emb != nil && emb.IsWellKnown() {
 		switch emb.WellKnownType() {
 		case pgs.AnyWKT:
-			m.Failf("Any rules should be used for Any fields")
+			m.failf("Any rules should be used for Any fields")
 		case pgs.DurationWKT:
-			m.Failf("Duration rules should be used for Duration fields")
+			m.failf("Duration rules should be used for Duration fields")
 		case pgs.TimestampWKT:
-			m.Failf("Timestamp rules should be used for Timestamp fields")
+			m.failf("Timestamp rules should be used for Timestamp fields")
 		}
 	}
 
 	if rules.Type != nil && rules.GetMessage().GetSkip() {
-		m.Failf("Skip should not be used with WKT scalar rules")
+		m.failf("Skip should not be used with WKT scalar rules")
 	}
 
 	if rules.GetMessage().GetRequired() {
-		m.Assert(inject || rules.GetMessage().GetError() != nil, "error should be defined when message is required")
+		m.assert(inject || rules.GetMessage().GetError() != nil, "error should be defined when message is required")
 	}
 }
 
 func (m *Module) CheckRepeatedRules(ft FieldType, rules *validate.RepeatedRules, inject bool) {
-	typ := m.mustFieldType(ft)
-
-	m.Assert(typ.IsRepeated(), "field is not repeated but got repeated rules")
+	typ, ok := ft.(pgs.FieldType)
+	if !ok || !typ.IsRepeated() {
+		m.failf("field is not repeated but got repeated rules")
+		return
+	}
 	var (
 		unique, itemsRule  bool
 		minItems, maxItems *uint64
 	)
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.MinItems != nil {
-			m.Assert(minItems == nil, "cannot have multi `min_items` rules on the same field")
+			m.assert(minItems == nil, "cannot have multi `min_items` rules on the same field")
 			minItems = r.MinItems
 		}
 		if r.MaxItems != nil {
-			m.Assert(maxItems == nil, "cannot have multi `max_items` rules on the same field")
+			m.assert(maxItems == nil, "cannot have multi `max_items` rules on the same field")
 			maxItems = r.MaxItems
 		}
 		if r.Unique != nil {
-			m.Assert(!unique, "cannot have multi `unique` rules on the same field")
+			m.assert(!unique, "cannot have multi `unique` rules on the same field")
+			unique = true
 		}
 		if r.Items != nil {
-			m.Assert(!itemsRule, "cannot have multi `items` rules on the same field")
+			m.assert(!itemsRule, "cannot have multi `items` rules on the same field")
+			itemsRule = true
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.CheckRepeated(typ, r)
 	}
+	m.atRule(-1)
 	m.checkMinMax(minItems, maxItems)
 }
 
@@ -1178,19 +1480,23 @@ func (m *Module) CheckRepeated(typ pgs.FieldType, r *validate.RepeatedRule) {
 	m.checkMinMax(r.MinItems, r.MaxItems)
 
 	if r.GetUnique() {
-		m.Assert(
+		m.assert(
 			!typ.Element().IsEmbed(),
 			"unique rule is only applicable for scalar types")
 	}
 
-	m.Push("items")
+	m.push("items")
+	m.assert(!shared.ScalarRequired(r.Items), "`required` is not applicable to items")
 	m.CheckFieldRules(typ.Element(), r.Items, true)
-	m.Pop()
+	m.pop()
 }
 
 func (m *Module) CheckMapRules(ft FieldType, rules *validate.MapRules, inject bool) {
-	typ := m.mustFieldType(ft)
-	m.Assert(typ.IsMap(), "field is not a map but got map rules")
+	typ, ok := ft.(pgs.FieldType)
+	if !ok || !typ.IsMap() {
+		m.failf("field is not a map but got map rules")
+		return
+	}
 
 	var (
 		noSparse             bool
@@ -1198,30 +1504,32 @@ func (m *Module) CheckMapRules(ft FieldType, rules *validate.MapRules, inject bo
 		minPairs, maxPairs   *uint64
 	)
 
-	for _, r := range rules.Rules {
+	for i, r := range rules.Rules {
+		m.atRule(i)
 		if r.MinPairs != nil {
-			m.Assert(minPairs == nil, "cannot have multi `min_pairs` rules on the same field")
+			m.assert(minPairs == nil, "cannot have multi `min_pairs` rules on the same field")
 			minPairs = r.MinPairs
 		}
 		if r.MaxPairs != nil {
-			m.Assert(maxPairs == nil, "cannot have multi `max_pairs` rules on the same field")
+			m.assert(maxPairs == nil, "cannot have multi `max_pairs` rules on the same field")
 			maxPairs = r.MaxPairs
 		}
 		if r.NoSparse != nil {
-			m.Assert(!noSparse, "cannot have multi `no_sparse` rules on the same field")
+			m.assert(!noSparse, "cannot have multi `no_sparse` rules on the same field")
 			noSparse = true
 		}
 		if r.Keys != nil {
-			m.Assert(!keysRule, "cannot have multi `keys` rules on the same field")
+			m.assert(!keysRule, "cannot have multi `keys` rules on the same field")
 			keysRule = true
 		}
 		if r.Values != nil {
-			m.Assert(!valuesRule, "cannot have multi `values` rules on the same field")
+			m.assert(!valuesRule, "cannot have multi `values` rules on the same field")
 			valuesRule = true
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.CheckMap(typ, r)
 	}
+	m.atRule(-1)
 
 	m.checkMinMax(minPairs, maxPairs)
 }
@@ -1230,40 +1538,45 @@ func (m *Module) CheckMap(typ pgs.FieldType, r *validate.MapRule) {
 	m.checkMinMax(r.MinPairs, r.MaxPairs)
 
 	if r.GetNoSparse() {
-		m.Assert(
+		m.assert(
 			typ.Element().IsEmbed(),
 			"no_sparse rule is only applicable for embedded message types",
 		)
 	}
 
-	m.Push("keys")
+	m.push("keys")
+	m.assert(!shared.ScalarRequired(r.Keys), "`required` is not applicable to keys")
 	m.CheckFieldRules(typ.Key(), r.Keys, true)
-	m.Pop()
+	m.pop()
 
-	m.Push("values")
+	m.push("values")
+	m.assert(!shared.ScalarRequired(r.Values), "`required` is not applicable to values")
 	m.CheckFieldRules(typ.Element(), r.Values, true)
-	m.Pop()
+	m.pop()
 }
 
 func (m *Module) CheckAnyRules(ft FieldType, rules *validate.AnyRules, inject bool) {
 	var in, notIn, required bool
-	for _, r := range rules.GetRules() {
+	for i, r := range rules.GetRules() {
+		m.atRule(i)
 		if r.In != nil {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
 			in = true
 		}
 		if r.NotIn != nil {
-			m.Assert(!notIn, "cannot have multi `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Required != nil {
-			m.Assert(!required, "cannot have multi `required` rules on the same field")
+			m.assert(!required, "cannot have multi `required` rules on the same field")
+			required = true
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.checkIns(len(r.In), len(r.NotIn))
 	}
+	m.atRule(-1)
 }
 
 func (m *Module) CheckDurationRules(ft FieldType, rules *validate.DurationRules, inject bool) {
@@ -1271,60 +1584,62 @@ func (m *Module) CheckDurationRules(ft FieldType, rules *validate.DurationRules,
 		lt, lte, gt, gte, ct *time.Duration
 		required, in, notIn  bool
 	)
-	for _, r := range rules.GetRules() {
+	for i, r := range rules.GetRules() {
+		m.atRule(i)
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
-			m.Assert(!in, "cannot have both `const` and `in` rules on the same field")
-			m.Assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(!in, "cannot have both `const` and `in` rules on the same field")
+			m.assert(!notIn, "cannot have both `const` and `not_in` rules on the same field")
 			ct = m.checkDur(r.GetConst())
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `lt` rules on the same field")
 			lt = m.checkDur(r.GetLt())
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `lte` rules on the same field")
 			lte = m.checkDur(r.GetLte())
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `gt` rules on the same field")
 			gt = m.checkDur(r.GetGt())
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `gte` rules on the same field")
 			gte = m.checkDur(r.GetGte())
 		}
 		if r.In != nil {
-			m.Assert(!in, "cannot have multi `in` rules on the same field")
-			m.Assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `in` rules on the same field")
+			m.assert(!in, "cannot have multi `in` rules on the same field")
+			m.assert(!notIn, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `in` rules on the same field")
 			in = true
 		}
 		if r.NotIn != nil {
-			m.Assert(!notIn, "cannot have multi `not_in` rules on the same field")
-			m.Assert(!in, "cannot have both `in` and `not_in` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `not_in` rules on the same field")
+			m.assert(!notIn, "cannot have multi `not_in` rules on the same field")
+			m.assert(!in, "cannot have both `in` and `not_in` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `not_in` rules on the same field")
 			notIn = true
 		}
 		if r.Required != nil {
-			m.Assert(!required, "cannot have multi `required` rules on the same field")
+			m.assert(!required, "cannot have multi `required` rules on the same field")
 			required = true
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.CheckDuration(ft, r)
 	}
+	m.atRule(-1)
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -1339,12 +1654,12 @@ func (m *Module) CheckDuration(ft FieldType, r *validate.DurationRule) {
 		m.checkDur(r.GetGte()))
 
 	for _, v := range r.GetIn() {
-		m.Assert(v != nil, "cannot have nil values in `in`")
+		m.assert(v != nil, "cannot have nil values in `in`")
 		m.checkDur(v)
 	}
 
 	for _, v := range r.GetNotIn() {
-		m.Assert(v != nil, "cannot have nil values in `not_in`")
+		m.assert(v != nil, "cannot have nil values in `not_in`")
 		m.checkDur(v)
 	}
 }
@@ -1355,72 +1670,75 @@ func (m *Module) CheckTimestampRules(ft FieldType, rules *validate.TimestampRule
 		lt, lte, gt, gte, ct   *int64
 		required, ltNow, gtNow bool
 	)
-	for _, r := range rules.GetRules() {
+	for i, r := range rules.GetRules() {
+		m.atRule(i)
 		if r.Required != nil {
-			m.Assert(!required, "cannot have multi `required` rules on the same field")
+			m.assert(!required, "cannot have multi `required` rules on the same field")
+			required = true
 		}
 		if r.Const != nil {
-			m.Assert(ct == nil, "cannot have multi `const` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
-			m.Assert(!ltNow, "cannot have both `const` and `lt_now` rules on the same field")
-			m.Assert(!gtNow, "cannot have both `const` and `gt_now` rules on the same field")
-			m.Assert(withIn == nil, "cannot have both `const` and `within` rules on the same field")
+			m.assert(ct == nil, "cannot have multi `const` rules on the same field")
+			m.assert(lt == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(!ltNow, "cannot have both `const` and `lt_now` rules on the same field")
+			m.assert(!gtNow, "cannot have both `const` and `gt_now` rules on the same field")
+			m.assert(withIn == nil, "cannot have both `const` and `within` rules on the same field")
 			ct = m.checkTS(r.GetConst())
 		}
 		if r.Lt != nil {
-			m.Assert(lt == nil, "cannot have multi `lt` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
-			m.Assert(!ltNow, "cannot have both `lt` and `lt_now` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `lt` rules on the same field")
+			m.assert(lt == nil, "cannot have multi `lt` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(!ltNow, "cannot have both `lt` and `lt_now` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `lt` rules on the same field")
 			lt = m.checkTS(r.GetLt())
 		}
 		if r.Lte != nil {
-			m.Assert(lte == nil, "cannot have multi `lte` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
-			m.Assert(!ltNow, "cannot have both `lte` and `lt_now` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `lte` rules on the same field")
+			m.assert(lte == nil, "cannot have multi `lte` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lte` rules on the same field")
+			m.assert(!ltNow, "cannot have both `lte` and `lt_now` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `lte` rules on the same field")
 			lte = m.checkTS(r.GetLte())
 		}
 		if r.Gt != nil {
-			m.Assert(gt == nil, "cannot have multi `gt` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
-			m.Assert(!gtNow, "cannot have both `gt` and `gt_now` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `gt` rules on the same field")
+			m.assert(gt == nil, "cannot have multi `gt` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(!gtNow, "cannot have both `gt` and `gt_now` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `gt` rules on the same field")
 			gt = m.checkTS(r.GetGt())
 		}
 		if r.Gte != nil {
-			m.Assert(gte == nil, "cannot have multi `gte` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
-			m.Assert(!gtNow, "cannot have both `gte` and `gt_now` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `gte` rules on the same field")
+			m.assert(gte == nil, "cannot have multi `gte` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gte` rules on the same field")
+			m.assert(!gtNow, "cannot have both `gte` and `gt_now` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `gte` rules on the same field")
 			gte = m.checkTS(r.GetGte())
 		}
 		if r.LtNow != nil {
-			m.Assert(!ltNow, "cannot have multi `lt_now` rules on the same field")
-			m.Assert(lt == nil, "cannot have both `lt` and `lt_now` rules on the same field")
-			m.Assert(lte == nil, "cannot have both `lte` and `lt_now` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `lt_now` rules on the same field")
+			m.assert(!ltNow, "cannot have multi `lt_now` rules on the same field")
+			m.assert(lt == nil, "cannot have both `lt` and `lt_now` rules on the same field")
+			m.assert(lte == nil, "cannot have both `lte` and `lt_now` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `lt_now` rules on the same field")
 			ltNow = true
 		}
 		if r.GtNow != nil {
-			m.Assert(!gtNow, "cannot have multi `gt_now` rules on the same field")
-			m.Assert(gt == nil, "cannot have both `gt` and `gt_now` rules on the same field")
-			m.Assert(gte == nil, "cannot have both `gte` and `gt_now` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `gt_now` rules on the same field")
+			m.assert(!gtNow, "cannot have multi `gt_now` rules on the same field")
+			m.assert(gt == nil, "cannot have both `gt` and `gt_now` rules on the same field")
+			m.assert(gte == nil, "cannot have both `gte` and `gt_now` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `gt_now` rules on the same field")
 			gtNow = true
 		}
 		if r.Within != nil {
-			m.Assert(withIn == nil, "cannot have multi `within` rules on the same field")
-			m.Assert(ct == nil, "cannot have both `const` and `within` rules on the same field")
+			m.assert(withIn == nil, "cannot have multi `within` rules on the same field")
+			m.assert(ct == nil, "cannot have both `const` and `within` rules on the same field")
 			withIn = m.checkDur(r.GetWithin())
 		}
-		m.Assert(inject || r.Error != nil, "cannot have nil error on rule")
+		m.assert(inject || r.Error != nil, "cannot have nil error on rule")
 		m.CheckTimestamp(ft, r)
 	}
-	m.Assert(withIn == nil || (ltNow || gtNow), "within` rule cannot be used with absolute `lt/gt` rules")
+	m.atRule(-1)
+	m.assert(withIn == nil || (ltNow || gtNow), "within` rule cannot be used with absolute `lt/gt` rules")
 	m.checkNums(0, 0, ct, lt, lte, gt, gte)
 }
 
@@ -1432,33 +1750,24 @@ func (m *Module) CheckTimestamp(ft FieldType, r *validate.TimestampRule) {
 		m.checkTS(r.GetGt()),
 		m.checkTS(r.GetGte()))
 
-	m.Assert(
+	m.assert(
 		(r.LtNow == nil && r.GtNow == nil) || (r.Lt == nil && r.Lte == nil && r.Gt == nil && r.Gte == nil),
 		"`now` rules cannot be mixed with absolute `lt/gt` rules")
 
-	m.Assert(
+	m.assert(
 		r.Within == nil || (r.Lt == nil && r.Lte == nil && r.Gt == nil && r.Gte == nil),
 		"`within` rule cannot be used with absolute `lt/gt` rules")
 
-	m.Assert(
+	m.assert(
 		r.LtNow == nil || r.GtNow == nil,
 		"both `now` rules cannot be used together")
 
 	dur := m.checkDur(r.Within)
-	m.Assert(
+	m.assert(
 		dur == nil || *dur > 0,
 		"`within` rule must be positive and non-zero")
 }
 
-func (m *Module) mustFieldType(ft FieldType) pgs.FieldType {
-	typ, ok := ft.(pgs.FieldType)
-	if !ok {
-		m.Failf("unexpected field type (%T)", ft)
-	}
-
-	return typ
-}
-
 func (m *Module) checkNums(in, notIn int, ci, lti, ltei, gti, gtei interface{}) {
 	m.checkIns(in, notIn)
 
@@ -1466,7 +1775,7 @@ func (m *Module) checkNums(in, notIn int, ci, lti, ltei, gti, gtei interface{})
 	lt, lte := reflect.ValueOf(lti), reflect.ValueOf(ltei)
 	gt, gte := reflect.ValueOf(gti), reflect.ValueOf(gtei)
 
-	m.Assert(
+	m.assert(
 		c.IsNil() ||
 			in == 0 && notIn == 0 &&
 				lt.IsNil() && lte.IsNil() &&
@@ -1474,38 +1783,38 @@ func (m *Module) checkNums(in, notIn int, ci, lti, ltei, gti, gtei interface{})
 		"`const` can be the only rule on a field",
 	)
 
-	m.Assert(
+	m.assert(
 		in == 0 ||
 			lt.IsNil() && lte.IsNil() &&
 				gt.IsNil() && gte.IsNil(),
 		"cannot have both `in` and range constraint rules on the same field",
 	)
 
-	m.Assert(
+	m.assert(
 		lt.IsNil() || lte.IsNil(),
 		"cannot have both `lt` and `lte` rules on the same field",
 	)
 
-	m.Assert(
+	m.assert(
 		gt.IsNil() || gte.IsNil(),
 		"cannot have both `gt` and `gte` rules on the same field",
 	)
 
 	if !lt.IsNil() {
-		m.Assert(gt.IsNil() || !reflect.DeepEqual(lti, gti),
+		m.assert(gt.IsNil() || !reflect.DeepEqual(lti, gti),
 			"cannot have equal `gt` and `lt` rules on the same field")
-		m.Assert(gte.IsNil() || !reflect.DeepEqual(lti, gtei),
+		m.assert(gte.IsNil() || !reflect.DeepEqual(lti, gtei),
 			"cannot have equal `gte` and `lt` rules on the same field")
 	} else if !lte.IsNil() {
-		m.Assert(gt.IsNil() || !reflect.DeepEqual(ltei, gti),
+		m.assert(gt.IsNil() || !reflect.DeepEqual(ltei, gti),
 			"cannot have equal `gt` and `lte` rules on the same field")
-		m.Assert(gte.IsNil() || !reflect.DeepEqual(ltei, gtei),
+		m.assert(gte.IsNil() || !reflect.DeepEqual(ltei, gtei),
 			"use `const` instead of equal `lte` and `gte` rules")
 	}
 }
 
 func (m *Module) checkIns(in, notIn int) {
-	m.Assert(
+	m.assert(
 		in == 0 || notIn == 0,
 		"cannot have both `in` and `not_in` rules on the same field")
 }
@@ -1515,7 +1824,7 @@ func (m *Module) checkMinMax(min, max *uint64) {
 		return
 	}
 
-	m.Assert(
+	m.assert(
 		*min <= *max,
 		"`min` value is greater than `max` value")
 }
@@ -1525,18 +1834,18 @@ func (m *Module) checkLen(len, min, max *uint64) {
 		return
 	}
 
-	m.Assert(
+	m.assert(
 		min == nil,
 		"cannot have both `len` and `min_len` rules on the same field")
 
-	m.Assert(
+	m.assert(
 		max == nil,
 		"cannot have both `len` and `max_len` rules on the same field")
 }
 
 func (m *Module) checkWellKnownRegex(wk validate.KnownRegex, r *validate.StringRule) {
 	if wk != 0 {
-		m.Assert(r.Pattern == nil, "regex `well_known_regex` and regex `pattern` are incompatible")
+		m.assert(r.Pattern == nil, "regex `well_known_regex` and regex `pattern` are incompatible")
 		var non_strict = r.Strict != nil && *r.Strict == false
 		if (wk.String() == "HTTP_HEADER_NAME" || wk.String() == "HTTP_HEADER_VALUE") && non_strict {
 			// Use non-strict header validation.
@@ -1549,9 +1858,9 @@ func (m *Module) checkWellKnownRegex(wk validate.KnownRegex, r *validate.StringR
 
 func (m *Module) checkPattern(p *string, in int) {
 	if p != nil {
-		m.Assert(in == 0, "regex `pattern` and `in` rules are incompatible")
+		m.assert(in == 0, "regex `pattern` and `in` rules are incompatible")
 		_, err := regexp.Compile(*p)
-		m.CheckErr(err, "unable to parse regex `pattern`")
+		m.checkErr(err, "unable to parse regex `pattern`")
 	}
 }
 
@@ -1561,7 +1870,7 @@ func (m *Module) checkDur(d *durationpb.Duration) *time.Duration {
 	}
 
 	dur, err := d.AsDuration(), d.CheckValid()
-	m.CheckErr(err, "could not resolve duration")
+	m.checkErr(err, "could not resolve duration")
 	return &dur
 }
 
@@ -1571,6 +1880,6 @@ func (m *Module) checkTS(ts *timestamppb.Timestamp) *int64 {
 	}
 
 	t, err := ts.AsTime(), ts.CheckValid()
-	m.CheckErr(err, "could not resolve timestamp")
+	m.checkErr(err, "could not resolve timestamp")
 	return proto.Int64(t.UnixNano())
 }
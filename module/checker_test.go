@@ -0,0 +1,590 @@
+package module
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// checkerCase declares the fields of message M in case.proto, with the
+// diagnostic the checker must report about them, whose text starts with the
+// text of want. %e stands for a valid error.
+type checkerCase struct {
+	name   string
+	fields string
+	// opts and extra are added to case.proto before and after M.
+	opts, extra string
+	params      pgs.Parameters
+	want        Diagnostic
+}
+
+var checkerCases = []checkerCase{
+	// numbers, each type has its own checks
+	{name: "int32 multi const", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{const: 1, %e}, {const: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `const` rules on the same field"}},
+	{name: "int32 const and lt", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{const: 1, %e}, {lt: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `lt` and `const` rules on the same field"}},
+	{name: "int32 lt and const", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{lt: 2, %e}, {const: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `lt` rules on the same field"}},
+	{name: "int32 const and in", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{const: 1, %e}, {in: [2], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `in` and `const` rules on the same field"}},
+	{name: "int32 const and not_in", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{not_in: [2], %e}, {const: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `not_in` rules on the same field"}},
+	{name: "int32 const with range in one rule", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{const: 1, gt: 0, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`const` can be the only rule on a field"}},
+	{name: "int32 multi in", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{in: [1], %e}, {in: [2], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `in` rules on the same field"}},
+	{name: "int32 multi not_in", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{not_in: [1], %e}, {not_in: [2], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `not_in` rules on the same field"}},
+	{name: "int32 in and not_in", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{in: [1], %e}, {not_in: [2], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+	{name: "int32 in and not_in in one rule", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{in: [1], not_in: [2], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+	{name: "int32 in and range", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{in: [1], lt: 5, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have both `in` and range constraint rules on the same field"}},
+	{name: "int32 multi lt", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{lt: 1, %e}, {lt: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `lt` rules on the same field"}},
+	{name: "int32 multi lte", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{lte: 1, %e}, {lte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `lte` rules on the same field"}},
+	{name: "int32 multi gt", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gt: 1, %e}, {gt: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `gt` rules on the same field"}},
+	{name: "int32 multi gte", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gte: 1, %e}, {gte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `gte` rules on the same field"}},
+	{name: "int32 lt and lte", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{lt: 1, %e}, {lte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `lt` and `lte` rules on the same field"}},
+	{name: "int32 lt and lte in one rule", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{lt: 1, lte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have both `lt` and `lte` rules on the same field"}},
+	{name: "int32 gt and gte", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gte: 1, %e}, {gt: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `gt` and `gte` rules on the same field"}},
+	{name: "int32 gt and gte in one rule", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gt: 1, gte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have both `gt` and `gte` rules on the same field"}},
+	{name: "int32 equal gt and lt", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gt: 1, lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have equal `gt` and `lt` rules on the same field"}},
+	{name: "int32 equal gte and lt", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "int32 equal gt and lte", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gt: 1, lte: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have equal `gt` and `lte` rules on the same field"}},
+	{name: "int32 equal gte and lte", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gte: 1, lte: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "use `const` instead of equal `lte` and `gte` rules"}},
+	{name: "int32 without error", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{gt: 1, %e}, {lt: 5}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have nil error on rule"}},
+	{name: "int32 rules on a string", fields: `string f = 1 [(validate.rules).int32 = {rules: [{gt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: " expected rules for TYPE_STRING but got TYPE_INT32"}},
+	{name: "int32 rules on a repeated field", fields: `repeated int32 f = 1 [(validate.rules).int32 = {rules: [{gt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "repeated rule should be used for repeated fields"}},
+	{name: "int32 rules on a wrapper", fields: `google.protobuf.Int32Value f = 1 [(validate.rules).int32 = {rules: [{const: 1, %e}, {const: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `const` rules on the same field"}},
+	{name: "float const and lt", fields: `float f = 1 [(validate.rules).float = {rules: [{const: 1, %e}, {lt: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `lt` and `const` rules on the same field"}},
+	{name: "double equal gt and lt", fields: `double f = 1 [(validate.rules).double = {rules: [{gt: 1.5, lt: 1.5, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have equal `gt` and `lt` rules on the same field"}},
+	{name: "int64 multi gte", fields: `int64 f = 1 [(validate.rules).int64 = {rules: [{gte: 1, %e}, {gte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `gte` rules on the same field"}},
+	{name: "uint32 in and not_in", fields: `uint32 f = 1 [(validate.rules).uint32 = {rules: [{in: [1], %e}, {not_in: [2], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+	{name: "uint64 lt and lte", fields: `uint64 f = 1 [(validate.rules).uint64 = {rules: [{lte: 1, %e}, {lt: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `lt` and `lte` rules on the same field"}},
+	{name: "sint32 multi const", fields: `sint32 f = 1 [(validate.rules).sint32 = {rules: [{const: 1, %e}, {const: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `const` rules on the same field"}},
+	{name: "sint64 gt and gte", fields: `sint64 f = 1 [(validate.rules).sint64 = {rules: [{gt: 1, %e}, {gte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `gt` and `gte` rules on the same field"}},
+	{name: "fixed32 const and gt", fields: `fixed32 f = 1 [(validate.rules).fixed32 = {rules: [{gt: 1, %e}, {const: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `gt` rules on the same field"}},
+	{name: "fixed64 multi lt", fields: `fixed64 f = 1 [(validate.rules).fixed64 = {rules: [{lt: 1, %e}, {lt: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `lt` rules on the same field"}},
+	{name: "sfixed32 multi in", fields: `sfixed32 f = 1 [(validate.rules).sfixed32 = {rules: [{in: [1], %e}, {in: [2], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `in` rules on the same field"}},
+	{name: "sfixed64 const and lte", fields: `sfixed64 f = 1 [(validate.rules).sfixed64 = {rules: [{const: 1, %e}, {lte: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `lte` and `const` rules on the same field"}},
+	// gte bounds are recorded across rules for every type
+	{name: "float equal gte and lt", fields: `float f = 1 [(validate.rules).float = {rules: [{gte: 1.5, %e}, {lt: 1.5, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "double equal gte and lt", fields: `double f = 1 [(validate.rules).double = {rules: [{gte: 1.5, %e}, {lt: 1.5, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "int64 equal gte and lt", fields: `int64 f = 1 [(validate.rules).int64 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "uint32 equal gte and lt", fields: `uint32 f = 1 [(validate.rules).uint32 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "uint64 equal gte and lt", fields: `uint64 f = 1 [(validate.rules).uint64 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "sint32 equal gte and lt", fields: `sint32 f = 1 [(validate.rules).sint32 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "sint64 equal gte and lt", fields: `sint64 f = 1 [(validate.rules).sint64 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "fixed32 equal gte and lt", fields: `fixed32 f = 1 [(validate.rules).fixed32 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "fixed64 equal gte and lt", fields: `fixed64 f = 1 [(validate.rules).fixed64 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "sfixed32 equal gte and lt", fields: `sfixed32 f = 1 [(validate.rules).sfixed32 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+	{name: "sfixed64 equal gte and lt", fields: `sfixed64 f = 1 [(validate.rules).sfixed64 = {rules: [{gte: 1, %e}, {lt: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gte` and `lt` rules on the same field"}},
+
+	// strings
+	{name: "string multi const", fields: `string f = 1 [(validate.rules).string = {rules: [{const: "a", %e}, {const: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `const` rules on the same field"}},
+	{name: "string const and len", fields: `string f = 1 [(validate.rules).string = {rules: [{len: 1, %e}, {const: "a", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `len` rules on the same field"}},
+	{name: "string const and min_len", fields: `string f = 1 [(validate.rules).string = {rules: [{const: "a", %e}, {min_len: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `min_len` and `const` rules on the same field"}},
+	{name: "string const and max_bytes", fields: `string f = 1 [(validate.rules).string = {rules: [{max_bytes: 4, %e}, {const: "a", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `max_bytes` rules on the same field"}},
+	{name: "string const and prefix", fields: `string f = 1 [(validate.rules).string = {rules: [{prefix: "a", %e}, {const: "a", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `prefix` rules on the same field"}},
+	{name: "string const and not_contains", fields: `string f = 1 [(validate.rules).string = {rules: [{not_contains: "a", %e}, {const: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `not_contains` rules on the same field"}},
+	{name: "string const and pattern", fields: `string f = 1 [(validate.rules).string = {rules: [{const: "a", %e}, {pattern: "a", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `pattern` and `const` rules on the same field"}},
+	{name: "string const and well_known", fields: `string f = 1 [(validate.rules).string = {rules: [{email: true, %e}, {const: "a", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `well_known` rules on the same field"}},
+	{name: "string multi len", fields: `string f = 1 [(validate.rules).string = {rules: [{len: 1, %e}, {len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `len` rules on the same field"}},
+	{name: "string len and min_len", fields: `string f = 1 [(validate.rules).string = {rules: [{len: 1, %e}, {min_len: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `len` and `min_len` rules on the same field"}},
+	{name: "string len and max_len in one rule", fields: `string f = 1 [(validate.rules).string = {rules: [{len: 1, max_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "cannot have both `len` and `max_len` rules on the same field"}},
+	{name: "string multi min_len", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, %e}, {min_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `min_len` rules on the same field"}},
+	{name: "string multi max_len", fields: `string f = 1 [(validate.rules).string = {rules: [{max_len: 1, %e}, {max_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `max_len` rules on the same field"}},
+	{name: "string min_len over max_len", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 5, %e}, {max_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "`min` value is greater than `max` value"}},
+	{name: "string len_bytes and min_bytes", fields: `string f = 1 [(validate.rules).string = {rules: [{min_bytes: 1, %e}, {len_bytes: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `len_bytes` and `min_bytes` rules on the same field"}},
+	{name: "string multi max_bytes", fields: `string f = 1 [(validate.rules).string = {rules: [{max_bytes: 1, %e}, {max_bytes: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `max_bytes` rules on the same field"}},
+	{name: "string min_bytes over max_bytes", fields: `string f = 1 [(validate.rules).string = {rules: [{min_bytes: 5, max_bytes: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`min` value is greater than `max` value"}},
+	{name: "string max_len over max_bytes", fields: `string f = 1 [(validate.rules).string = {rules: [{max_len: 5, max_bytes: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`max_len` cannot exceed `max_bytes`"}},
+	{name: "string prefix over max_len", fields: `string f = 1 [(validate.rules).string = {rules: [{prefix: "abc", max_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`prefix` length exceeds the `max_len`"}},
+	{name: "string suffix over max_len", fields: `string f = 1 [(validate.rules).string = {rules: [{suffix: "abc", max_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`suffix` length exceeds the `max_len`"}},
+	{name: "string contains over max_bytes", fields: `string f = 1 [(validate.rules).string = {rules: [{contains: "abc", max_bytes: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`contains` length exceeds the `max_bytes`"}},
+	{name: "string multi pattern", fields: `string f = 1 [(validate.rules).string = {rules: [{pattern: "a", %e}, {pattern: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `pattern` rules on the same field"}},
+	{name: "string pattern and well_known", fields: `string f = 1 [(validate.rules).string = {rules: [{pattern: "a", %e}, {uuid: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `pattern` and `well_known` rules on the same field"}},
+	{name: "string multi well_known", fields: `string f = 1 [(validate.rules).string = {rules: [{email: true, %e}, {uri: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `well_known` rules on the same field"}},
+	{name: "string well_known_regex and pattern", fields: `string f = 1 [(validate.rules).string = {rules: [{well_known_regex: HTTP_HEADER_NAME, pattern: "a", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "regex `well_known_regex` and regex `pattern` are incompatible"}},
+	{name: "string pattern and in", fields: `string f = 1 [(validate.rules).string = {rules: [{pattern: "a", in: ["a"], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "regex `pattern` and `in` rules are incompatible"}},
+	{name: "string invalid pattern", fields: `string f = 1 [(validate.rules).string = {rules: [{pattern: "(", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "unable to parse regex `pattern`: error parsing regexp: missing closing ): `(`"}},
+	{name: "string multi prefix", fields: `string f = 1 [(validate.rules).string = {rules: [{prefix: "a", %e}, {prefix: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `prefix` rules on the same field"}},
+	{name: "string multi suffix", fields: `string f = 1 [(validate.rules).string = {rules: [{suffix: "a", %e}, {suffix: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `suffix` rules on the same field"}},
+	{name: "string multi contains", fields: `string f = 1 [(validate.rules).string = {rules: [{contains: "a", %e}, {contains: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `contains` rules on the same field"}},
+	{name: "string multi not_contains", fields: `string f = 1 [(validate.rules).string = {rules: [{not_contains: "a", %e}, {not_contains: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `not_contains` rules on the same field"}},
+	{name: "string in and not_in", fields: `string f = 1 [(validate.rules).string = {rules: [{not_in: ["a"], %e}, {in: ["b"], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+	{name: "string empty error method", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, error: {method: ""}}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "method to create error instance can not be empty"}},
+
+	// bytes
+	{name: "bytes multi const", fields: `bytes f = 1 [(validate.rules).bytes = {rules: [{const: "a", %e}, {const: "b", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `const` rules on the same field"}},
+	{name: "bytes const and len", fields: `bytes f = 1 [(validate.rules).bytes = {rules: [{const: "a", %e}, {len: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `len` and `const` rules on the same field"}},
+	{name: "bytes len and max_len", fields: `bytes f = 1 [(validate.rules).bytes = {rules: [{max_len: 2, %e}, {len: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `len` and `max_len` rules on the same field"}},
+	{name: "bytes min_len over max_len", fields: `bytes f = 1 [(validate.rules).bytes = {rules: [{min_len: 3, max_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`min` value is greater than `max` value"}},
+	{name: "bytes prefix over max_len", fields: `bytes f = 1 [(validate.rules).bytes = {rules: [{prefix: "abc", max_len: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`prefix` length exceeds the `max_len`"}},
+	{name: "bytes multi well_known", fields: `bytes f = 1 [(validate.rules).bytes = {rules: [{ip: true, %e}, {ipv4: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `well_known` rules on the same field"}},
+	{name: "bytes in and not_in", fields: `bytes f = 1 [(validate.rules).bytes = {rules: [{in: ["a"], %e}, {not_in: ["b"], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+
+	// enums
+	{name: "enum in and not_in", fields: `E f = 1 [(validate.rules).enum = {in: [1], not_in: [0], %e}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+	{name: "enum undefined in", fields: `E f = 1 [(validate.rules).enum = {defined_only: true, in: [7], %e}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "undefined `in` value (7) conflicts with `defined_only` rule"}},
+	{name: "enum without error", fields: `E f = 1 [(validate.rules).enum = {defined_only: true}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have nil error on rule"}},
+
+	// messages
+	{name: "message required without error", fields: `M f = 1 [(validate.rules).message = {required: true}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "error should be defined when message is required"}},
+	{name: "message rules on a scalar", fields: `int32 f = 1 [(validate.rules).message = {required: true, %e}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "field is not embedded but got message rules"}},
+	{name: "message rules on a duration", fields: `google.protobuf.Duration f = 1 [(validate.rules).message = {required: true, %e}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "Duration rules should be used for Duration fields"}},
+	{name: "message rules on a timestamp", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).message = {required: true, %e}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "Timestamp rules should be used for Timestamp fields"}},
+	{name: "message rules on an any", fields: `google.protobuf.Any f = 1 [(validate.rules).message = {required: true, %e}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "Any rules should be used for Any fields"}},
+	{name: "message skip with wrapper rules", fields: `google.protobuf.StringValue f = 1 [(validate.rules) = {message: {skip: true}, string: {rules: [{min_len: 1, %e}]}}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "Skip should not be used with WKT scalar rules"}},
+
+	// required scalars
+	{name: "required without presence", fields: `int32 f = 1 [(validate.rules).int32 = {rules: [{required: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "`required` is only applicable to fields with presence, declare the field `optional`"}},
+	{name: "required on a wrapper", fields: `google.protobuf.Int32Value f = 1 [(validate.rules).int32 = {rules: [{required: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "`required` should not be used on wrapper fields, use the message `required` rule"}},
+	{name: "required in a oneof", fields: `oneof o { int32 f = 1 [(validate.rules).int32 = {rules: [{required: true, %e}]}]; }`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "`required` should not be used on oneof fields, use the oneof `required` option"}},
+
+	// repeated
+	{name: "repeated rules on a scalar", fields: `int32 f = 1 [(validate.rules).repeated = {rules: [{min_items: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "field is not repeated but got repeated rules"}},
+	{name: "repeated multi min_items", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{min_items: 1, %e}, {min_items: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `min_items` rules on the same field"}},
+	{name: "repeated multi max_items", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{max_items: 1, %e}, {max_items: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `max_items` rules on the same field"}},
+	{name: "repeated min_items over max_items", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{min_items: 3, %e}, {max_items: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "`min` value is greater than `max` value"}},
+	{name: "repeated multi unique", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{unique: true, %e}, {unique: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `unique` rules on the same field"}},
+	{name: "repeated unique messages", fields: `repeated M f = 1 [(validate.rules).repeated = {rules: [{unique: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "unique rule is only applicable for scalar types"}},
+	{name: "repeated multi items", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{items: {int32: {rules: [{gt: 0}]}}, %e}, {items: {int32: {rules: [{lt: 9}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `items` rules on the same field"}},
+	{name: "repeated required items", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{items: {int32: {rules: [{required: true}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f.items", Rule: -1, Text: "`required` is not applicable to items"}},
+	{name: "repeated conflicting items", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{items: {int32: {rules: [{gt: 1}, {gt: 2}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f.items", Rule: 1, Text: "cannot have multi `gt` rules on the same field"}},
+	{name: "repeated items of another type", fields: `repeated int32 f = 1 [(validate.rules).repeated = {rules: [{items: {string: {rules: [{min_len: 1, %e}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f.items", Rule: -1, Text: " expected rules for TYPE_INT32 but got TYPE_STRING"}},
+
+	// maps
+	{name: "map rules on a scalar", fields: `int32 f = 1 [(validate.rules).map = {rules: [{min_pairs: 1, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "field is not a map but got map rules"}},
+	{name: "map multi min_pairs", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{min_pairs: 1, %e}, {min_pairs: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `min_pairs` rules on the same field"}},
+	{name: "map multi max_pairs", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{max_pairs: 1, %e}, {max_pairs: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `max_pairs` rules on the same field"}},
+	{name: "map min_pairs over max_pairs", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{min_pairs: 3, max_pairs: 2, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`min` value is greater than `max` value"}},
+	{name: "map multi no_sparse", fields: `map<string, M> f = 1 [(validate.rules).map = {rules: [{no_sparse: true, %e}, {no_sparse: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `no_sparse` rules on the same field"}},
+	{name: "map no_sparse scalars", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{no_sparse: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "no_sparse rule is only applicable for embedded message types"}},
+	{name: "map multi keys", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{keys: {string: {rules: [{min_len: 1, %e}]}}, %e}, {keys: {string: {rules: [{max_len: 9, %e}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `keys` rules on the same field"}},
+	{name: "map multi values", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{values: {int32: {rules: [{gt: 1}]}}, %e}, {values: {int32: {rules: [{lt: 9}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `values` rules on the same field"}},
+	{name: "map required keys", fields: `map<int32, int32> f = 1 [(validate.rules).map = {rules: [{keys: {int32: {rules: [{required: true}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f.keys", Rule: -1, Text: "`required` is not applicable to keys"}},
+	{name: "map required values", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{values: {int32: {rules: [{required: true}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f.values", Rule: -1, Text: "`required` is not applicable to values"}},
+	{name: "map conflicting values", fields: `map<string, int32> f = 1 [(validate.rules).map = {rules: [{values: {int32: {rules: [{lt: 1, lte: 2}]}}, %e}]}];`,
+		want: Diagnostic{Field: "f.values", Rule: 0, Text: "cannot have both `lt` and `lte` rules on the same field"}},
+
+	// any
+	{name: "any multi in", fields: `google.protobuf.Any f = 1 [(validate.rules).any = {rules: [{in: ["a"], %e}, {in: ["b"], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `in` rules on the same field"}},
+	{name: "any multi not_in", fields: `google.protobuf.Any f = 1 [(validate.rules).any = {rules: [{not_in: ["a"], %e}, {not_in: ["b"], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `not_in` rules on the same field"}},
+	{name: "any in and not_in", fields: `google.protobuf.Any f = 1 [(validate.rules).any = {rules: [{in: ["a"], %e}, {not_in: ["b"], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+	{name: "any multi required", fields: `google.protobuf.Any f = 1 [(validate.rules).any = {rules: [{required: true, %e}, {required: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `required` rules on the same field"}},
+
+	// durations
+	{name: "duration multi const", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{const: {seconds: 1}, %e}, {const: {seconds: 2}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `const` rules on the same field"}},
+	{name: "duration const and lt", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{lt: {seconds: 1}, %e}, {const: {seconds: 2}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `lt` rules on the same field"}},
+	{name: "duration lt and lte", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{lt: {seconds: 1}, %e}, {lte: {seconds: 2}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `lt` and `lte` rules on the same field"}},
+	{name: "duration gt and gte", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{gt: {seconds: 1}, %e}, {gte: {seconds: 2}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `gt` and `gte` rules on the same field"}},
+	{name: "duration in and not_in", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{in: [{seconds: 1}], %e}, {not_in: [{seconds: 2}], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `in` and `not_in` rules on the same field"}},
+	{name: "duration const and in", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{const: {seconds: 1}, %e}, {in: [{seconds: 2}], %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `in` rules on the same field"}},
+	{name: "duration multi required", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{required: true, %e}, {required: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `required` rules on the same field"}},
+	{name: "duration equal gte and lte", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{gte: {seconds: 1}, lte: {seconds: 1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "use `const` instead of equal `lte` and `gte` rules"}},
+	{name: "duration out of range", fields: `google.protobuf.Duration f = 1 [(validate.rules).duration = {rules: [{lt: {seconds: 1, nanos: -1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "could not resolve duration: "}},
+
+	// timestamps
+	{name: "timestamp multi required", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{required: true, %e}, {required: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `required` rules on the same field"}},
+	{name: "timestamp const and lt_now", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{lt_now: true, %e}, {const: {seconds: 1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `const` and `lt_now` rules on the same field"}},
+	{name: "timestamp lt and lt_now", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{lt: {seconds: 1}, %e}, {lt_now: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `lt` and `lt_now` rules on the same field"}},
+	{name: "timestamp gte and gt_now", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{gt_now: true, %e}, {gte: {seconds: 1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have both `gte` and `gt_now` rules on the same field"}},
+	{name: "timestamp multi lt_now", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{lt_now: true, %e}, {lt_now: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `lt_now` rules on the same field"}},
+	{name: "timestamp now and absolute in one rule", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{lt_now: true, gt: {seconds: 1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`now` rules cannot be mixed with absolute `lt/gt` rules"}},
+	{name: "timestamp both now", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{lt_now: true, gt_now: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "both `now` rules cannot be used together"}},
+	{name: "timestamp within and absolute", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{within: {seconds: 1}, lt: {seconds: 1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`within` rule cannot be used with absolute `lt/gt` rules"}},
+	{name: "timestamp within without now", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{within: {seconds: 1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "within` rule cannot be used with absolute `lt/gt` rules"}},
+	{name: "timestamp multi within", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{within: {seconds: 1}, lt_now: true, %e}, {within: {seconds: 2}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 1, Text: "cannot have multi `within` rules on the same field"}},
+	{name: "timestamp zero within", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{within: {}, lt_now: true, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: 0, Text: "`within` rule must be positive and non-zero"}},
+	{name: "timestamp equal gt and lt", fields: `google.protobuf.Timestamp f = 1 [(validate.rules).timestamp = {rules: [{gt: {seconds: 1}, %e}, {lt: {seconds: 1}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "cannot have equal `gt` and `lt` rules on the same field"}},
+
+	// errors, conditions and expressions
+	{name: "malformed error args", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, error: {method: "e", args: [{placeholder: "$lt"}]}}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "malformed error args: error \"e\" arg 0: placeholder \"$lt\" is not a bound of StringRule"}},
+	{name: "value arg of eager java", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, error: {method: "e", args: [{placeholder: "$value"}]}}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "$value is only known to errors built with error_mode=lazy by `lang` java"}},
+	{name: "value arg of eager kotlin", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, error: {method: "e", args: [{placeholder: "$value"}]}}]}];`, params: pgs.Parameters{"lang": "kotlin", "error_mode": "eager"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "$value is only known to errors built with error_mode=lazy by `lang` kotlin"}},
+	{name: "oneof malformed error args", fields: `oneof o { option (validate.oneof) = {required: true, error: {method: "e", args: [{placeholder: "$x"}]}}; int32 f = 1; }`,
+		want: Diagnostic{Field: "o", Rule: -1, Text: "malformed error args: error \"e\" arg 0: placeholder \"$x\" is not a bound of OneOf"}},
+	{name: "condition on an unknown field", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, when: {field: "g", string: "a"}, %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "invalid condition: when g: undeclared reference to \"g\""}},
+	{name: "condition of go", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, when: {field: "f", string: "a"}, %e}]}];`, params: pgs.Parameters{"lang": "go"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "when conditions are not supported by `lang` go"}},
+	{name: "condition of ts", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, when: {field: "f", string: "a"}, %e}]}];`, params: pgs.Parameters{"lang": "ts"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "when conditions are not supported by `lang` ts"}},
+	{name: "condition of python", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, when: {field: "f", string: "a"}, %e}]}];`, params: pgs.Parameters{"lang": "python"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "when conditions are not supported by `lang` python"}},
+	{name: "condition of cc", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, when: {field: "f", string: "a"}, %e}]}];`, params: pgs.Parameters{"lang": "cc"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "when conditions are not supported by `lang` cc"}},
+	{name: "condition of csharp", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, when: {field: "f", string: "a"}, %e}]}];`, params: pgs.Parameters{"lang": "csharp"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "when conditions are not supported by `lang` csharp"}},
+	{name: "condition of rust", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, when: {field: "f", string: "a"}, %e}]}];`, params: pgs.Parameters{"lang": "rust"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "when conditions are not supported by `lang` rust"}},
+	{name: "expr without error", opts: `option (validate.expr) = {expression: "true"};`,
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "cannot have nil error on rule"}},
+	{name: "expr on an unknown field", opts: `option (validate.expr) = {expression: "true", %e}; option (validate.expr) = {expression: "true", field: "g", %e};`,
+		want: Diagnostic{Field: "expr", Rule: 1, Text: "expression rule reports unknown field g"}},
+	{name: "expr not a bool", fields: `int32 f = 1;`, opts: `option (validate.expr) = {expression: "f + 1", %e};`,
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "invalid expression `f + 1`: 3: expression is a int, not a bool"}},
+	{name: "expr of go", opts: `option (validate.expr) = {expression: "true", %e};`, params: pgs.Parameters{"lang": "go"},
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "expression rules are not supported by `lang` go"}},
+	{name: "expr of ts", opts: `option (validate.expr) = {expression: "true", %e};`, params: pgs.Parameters{"lang": "ts"},
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "expression rules are not supported by `lang` ts"}},
+	{name: "expr of python", opts: `option (validate.expr) = {expression: "true", %e};`, params: pgs.Parameters{"lang": "python"},
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "expression rules are not supported by `lang` python"}},
+	{name: "expr of cc", opts: `option (validate.expr) = {expression: "true", %e};`, params: pgs.Parameters{"lang": "cc"},
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "expression rules are not supported by `lang` cc"}},
+	{name: "expr of csharp", opts: `option (validate.expr) = {expression: "true", %e};`, params: pgs.Parameters{"lang": "csharp"},
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "expression rules are not supported by `lang` csharp"}},
+	{name: "expr of rust", opts: `option (validate.expr) = {expression: "true", %e};`, params: pgs.Parameters{"lang": "rust"},
+		want: Diagnostic{Field: "expr", Rule: 0, Text: "expression rules are not supported by `lang` rust"}},
+
+	// groups
+	{name: "undeclared group", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, groups: "create", %e}]}];`,
+		want: Diagnostic{Field: "f", Rule: -1, Text: "validation group create is not declared"}},
+	{name: "groups of another language", fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, groups: "create", %e}]}];`,
+		extra: `option (validate.groups) = "create";`, params: pgs.Parameters{"lang": "go"},
+		want: Diagnostic{Field: "f", Rule: -1, Text: "validation groups are not supported by `lang` go"}},
+	{name: "group declared twice", extra: `option (validate.groups) = "create"; option (validate.groups) = "create";`,
+		want: Diagnostic{Rule: -1, Text: "validation group create declared twice"}},
+	{name: "unnamed group", extra: `option (validate.groups) = "";`,
+		want: Diagnostic{Rule: -1, Text: "validation group must have a name"}},
+
+	// field masks
+	{name: "field mask of an unknown field", fields: `M m = 1;`, opts: `option (validate.field_mask) = {field: "mask", target: "m"};`,
+		want: Diagnostic{Field: "field_mask", Rule: -1, Text: "unknown field mask"}},
+	{name: "field mask not a FieldMask", fields: `M m = 1; string mask = 2;`, opts: `option (validate.field_mask) = {field: "mask", target: "m"};`,
+		want: Diagnostic{Field: "field_mask", Rule: -1, Text: "field mask is not a google.protobuf.FieldMask"}},
+	{name: "field mask of an unknown target", fields: `google.protobuf.FieldMask mask = 1;`, opts: `option (validate.field_mask) = {field: "mask", target: "m"};`,
+		want: Diagnostic{Field: "field_mask", Rule: -1, Text: "unknown target m"}},
+	{name: "field mask of a repeated target", fields: `repeated M m = 1; google.protobuf.FieldMask mask = 2;`, opts: `option (validate.field_mask) = {field: "mask", target: "m"};`,
+		want: Diagnostic{Field: "field_mask", Rule: -1, Text: "target m is not a message field outside of a oneof"}},
+	{name: "field mask of itself", fields: `google.protobuf.FieldMask mask = 1;`, opts: `option (validate.field_mask) = {field: "mask", target: "mask"};`,
+		want: Diagnostic{Field: "field_mask", Rule: -1, Text: "target mask is the field mask itself"}},
+
+	// services
+	{name: "strict method without request rules", extra: `service S { rpc Get(google.protobuf.Empty) returns (M) { option (validate.method) = {strict: true}; } }`,
+		want: Diagnostic{Message: "acme.S", Field: "Get", Rule: -1, Text: "strict method has request .google.protobuf.Empty without validation rules"}},
+	{name: "strict method without response rules", extra: `service S { rpc Get(M) returns (google.protobuf.Empty) { option (validate.method) = {strict: true, validate_response: true}; } }`,
+		want: Diagnostic{Message: "acme.S", Field: "Get", Rule: -1, Text: "strict method has response .google.protobuf.Empty without validation rules"}},
+}
+
+func TestCheckerDiagnostics(t *testing.T) {
+	for _, tc := range checkerCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := tc.want
+			want.File = "case.proto"
+			if want.Message == "" && want.Field != "" {
+				want.Message = "acme.M"
+			}
+
+			diags := checkCase(t, tc)
+			for _, d := range diags {
+				// TestCheckerLocations covers the locations
+				d.Line, d.Column = 0, 0
+				if strings.HasPrefix(d.Text, want.Text) {
+					d.Text = want.Text
+				}
+				if d == want {
+					return
+				}
+			}
+			t.Errorf("missing diagnostic %q in:", want)
+			for _, d := range diags {
+				t.Errorf("\t%q", d)
+			}
+		})
+	}
+}
+
+func TestCheckerLocations(t *testing.T) {
+	diags := checkCase(t, checkerCase{
+		opts: `option (validate.expr) = {expression: "true", %e}; option (validate.expr) = {expression: "1", %e};`,
+		fields: `string a = 1 [(validate.rules).string = {rules: [{min_len: 5, max_len: 1, %e}]}];
+  int32 b = 2 [(validate.rules).int32 = {rules: [{const: 1, %e}, {const: 2, %e}]}];`,
+		extra: `option (validate.groups) = "create"; option (validate.groups) = "create";`,
+	})
+	SortDiagnostics(diags)
+
+	want := []string{
+		"case.proto:12:70: acme.M.expr (rule 1): invalid expression `1`: 1: expression is a int, not a bool",
+		"case.proto:13:15: acme.M.a (rule 0): `min` value is greater than `max` value",
+		"case.proto:14:16: acme.M.b (rule 1): cannot have multi `const` rules on the same field",
+		"case.proto:16:38: validation group create declared twice",
+	}
+	var got []string
+	for _, d := range diags {
+		got = append(got, d.String())
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got diagnostics:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCheckerLocatesRulesByOption(t *testing.T) {
+	// The index of rule 17 is the field number of `message` in FieldRules,
+	// which must not locate it at the `(validate.rules).message` option.
+	diags := checkCase(t, checkerCase{
+		fields: `string s = 1 [(validate.rules).string = {rules: [` + strings.Repeat(`{pattern: "a", %e}, `, 17) + `{min_len: 5, max_len: 1, %e}]},
+    (validate.rules).message = {skip: false}];`,
+	})
+
+	want := "case.proto:13:15: acme.M.s (rule 17): `min` value is greater than `max` value"
+	for _, d := range diags {
+		if d.Rule == 17 {
+			if d.String() != want {
+				t.Errorf("got diagnostic %q, want %q", d, want)
+			}
+			return
+		}
+	}
+	t.Errorf("missing diagnostic %q", want)
+}
+
+func TestCheckerAcceptsConsistentRules(t *testing.T) {
+	diags := checkCase(t, checkerCase{
+		fields: `
+			int32 a = 1 [(validate.rules).int32 = {rules: [{gt: 0, %e}, {lt: 10, %e}]}];
+			string b = 2 [(validate.rules).string = {rules: [{min_len: 1, %e}, {max_len: 10, %e}, {pattern: "^[a-z]+$", %e}]}];
+			repeated string c = 3 [(validate.rules).repeated = {rules: [{max_items: 3, unique: true, items: {string: {rules: [{min_len: 1, %e}]}}, %e}]}];
+			map<string, M> d = 4 [(validate.rules).map = {rules: [{no_sparse: true, %e}]}];
+			google.protobuf.Timestamp e = 5 [(validate.rules).timestamp = {rules: [{lt_now: true, within: {seconds: 60}, %e}]}];
+			optional int64 g = 6 [(validate.rules).int64 = {rules: [{required: true, %e}]}];`,
+	})
+	for _, d := range diags {
+		t.Errorf("unexpected diagnostic %q", d)
+	}
+}
+
+func TestCheckerAcceptsLazyValueArgs(t *testing.T) {
+	diags := checkCase(t, checkerCase{
+		fields: `string f = 1 [(validate.rules).string = {rules: [{min_len: 1, error: {method: "e", args: [{placeholder: "$value"}]}}]}];`,
+		params: pgs.Parameters{langParam: "java", errorModeParam: "lazy"},
+	})
+	for _, d := range diags {
+		t.Errorf("unexpected diagnostic %q", d)
+	}
+}
+
+// checkCase compiles the case.proto of tc and returns the diagnostics of the
+// checker on it.
+func checkCase(t *testing.T, tc checkerCase) []Diagnostic {
+	t.Helper()
+	m, f := compileCase(t, tc)
+	return m.Check(f)
+}
+
+// compileCase compiles the case.proto of tc, returning it with a module
+// initialized with the parameters of tc.
+func compileCase(t *testing.T, tc checkerCase) (*Module, pgs.File) {
+	t.Helper()
+	src := strings.Join([]string{
+		`syntax = "proto3";`,
+		`package acme;`,
+		`import "validate/validate.proto";`,
+		`import "google/protobuf/any.proto";`,
+		`import "google/protobuf/duration.proto";`,
+		`import "google/protobuf/empty.proto";`,
+		`import "google/protobuf/field_mask.proto";`,
+		`import "google/protobuf/timestamp.proto";`,
+		`import "google/protobuf/wrappers.proto";`,
+		`enum E { E_UNSPECIFIED = 0; E_ONE = 1; }`,
+		`message M {`, tc.opts, tc.fields, `}`,
+		tc.extra,
+	}, "\n")
+	src = strings.ReplaceAll(src, "%e", `error: {method: "e"}`)
+
+	c := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(protocompile.CompositeResolver{
+			&protocompile.SourceResolver{Accessor: protocompile.SourceAccessorFromMap(map[string]string{"case.proto": src})},
+			&protocompile.SourceResolver{ImportPaths: []string{".."}},
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	res, err := c.Compile(context.Background(), "case.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var files []*descriptorpb.FileDescriptorProto
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(res[0])
+
+	// Round trip the request for the options to be parsed as validate types,
+	// rather than the dynamic messages of the compiler.
+	in, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{FileToGenerate: []string{"case.proto"}, ProtoFile: files})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req pluginpb.CodeGeneratorRequest
+	if err = proto.Unmarshal(in, &req); err != nil {
+		t.Fatal(err)
+	}
+	ast := pgs.ProcessCodeGeneratorRequest(pgs.InitMockDebugger(), &req)
+
+	params := tc.params
+	if params == nil {
+		params = pgs.Parameters{langParam: "java"}
+	}
+	m := Validator().(*Module)
+	m.InitContext(pgs.Context(pgs.InitMockDebugger(), params, "."))
+	return m, ast.Targets()["case.proto"]
+}
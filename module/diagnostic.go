@@ -0,0 +1,275 @@
+package module
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The field numbers of the options in the descriptors of a proto file.
+const (
+	fileOptions    = 8
+	messageOptions = 7
+	fieldOptions   = 8
+	oneofOptions   = 2
+	methodOptions  = 4
+)
+
+// Diagnostic is an inconsistency found by the checks of the module in the
+// validation rules of a proto file.
+type Diagnostic struct {
+	// File is the name of the proto file.
+	File string
+	// Line and Column locate the offending option in File, counting from 1,
+	// or its declaration if the option has no location. Both are 0 if File
+	// has no source info.
+	Line, Column int
+	// Message is the fully qualified name of the message, or service, the
+	// rules belong to, empty for rules of the file.
+	Message string
+	// Field is the path in Message to the rules: a field, oneof, method or
+	// expr, followed by nested rules such as items, keys and values.
+	Field string
+	// Rule is the index of the rule in its list of rules, -1 if the
+	// diagnostic is not about a single rule.
+	Rule int
+	// Text describes the inconsistency.
+	Text string
+}
+
+// String formats d the way compilers do, file:line:column: text, with the
+// rules d is about.
+func (d Diagnostic) String() string {
+	if subject := d.subject(); subject != "" {
+		return d.location() + ": " + subject + ": " + d.Text
+	}
+	return d.location() + ": " + d.Text
+}
+
+// location returns file:line:column, or the file alone if d has no location.
+func (d Diagnostic) location() string {
+	if d.Line == 0 {
+		return d.File
+	}
+	return fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column)
+}
+
+// subject returns the rules d is about, Message.Field (rule i), empty for the
+// rules of the file.
+func (d Diagnostic) subject() string {
+	subject := d.Message
+	if d.Field != "" {
+		subject += "." + d.Field
+	}
+	if d.Rule >= 0 {
+		subject += fmt.Sprintf(" (rule %d)", d.Rule)
+	}
+	return strings.TrimSpace(subject)
+}
+
+// before reports whether d comes before o in a file.
+func (d Diagnostic) before(o Diagnostic) bool {
+	if d.File != o.File {
+		return d.File < o.File
+	}
+	if d.Line != o.Line {
+		return d.Line < o.Line
+	}
+	return d.Column < o.Column
+}
+
+// SortDiagnostics sorts diags by file and location.
+func SortDiagnostics(diags []Diagnostic) {
+	sort.SliceStable(diags, func(i, j int) bool { return diags[i].before(diags[j]) })
+}
+
+// scope is an element of the path to the rules being checked, with the index
+// of the rule being checked in its list of rules. path is the path in the
+// file descriptor to the option holding the rules, nil for nested rules and
+// if the file has no source info. repeated is set if the option is repeated,
+// with a location per rule.
+type scope struct {
+	name     string
+	path     []int32
+	repeated bool
+	rule     int
+}
+
+// Check runs the checks of the module on the validation rules of f, returning
+// every inconsistency found.
+func (m *Module) Check(f pgs.File) []Diagnostic {
+	m.file, m.scopes, m.diags, m.findings = f.Name().String(), nil, nil, nil
+	m.locations = f.Descriptor().GetSourceCodeInfo().GetLocation()
+
+	m.CheckDeclaredGroups(f)
+	for _, msg := range f.AllMessages() {
+		m.CheckRules(msg)
+	}
+	for _, svc := range f.Services() {
+		m.CheckMethodRules(svc)
+	}
+	return m.diags
+}
+
+// CheckTargets runs the checks of the module on every target and fails with
+// all the inconsistencies found, one per line, if any.
+func (m *Module) CheckTargets(targets map[string]pgs.File) {
+	var diags []Diagnostic
+	for _, f := range targets {
+		diags = append(diags, m.Check(f)...)
+	}
+	if len(diags) == 0 {
+		return
+	}
+
+	SortDiagnostics(diags)
+	lines := make([]string, len(diags))
+	for i, d := range diags {
+		lines[i] = d.String()
+	}
+	m.Failf("%d errors in validation rules:\n%s", len(diags), strings.Join(lines, "\n"))
+}
+
+func (m *Module) push(name string) {
+	m.pushAt(name, nil)
+}
+
+// pushAt opens the scope of name, whose rules are held by the option at path.
+// A scope without a name only locates the option.
+func (m *Module) pushAt(name string, path []int32) {
+	m.Push(name)
+	m.scopes = append(m.scopes, scope{name: name, path: path, rule: -1})
+}
+
+// pushAtRepeated opens the scope of name, whose rules are each held by an
+// element of the repeated option at path.
+func (m *Module) pushAtRepeated(name string, path []int32) {
+	m.pushAt(name, path)
+	m.scopes[len(m.scopes)-1].repeated = true
+}
+
+func (m *Module) pop() {
+	m.Pop()
+	m.scopes = m.scopes[:len(m.scopes)-1]
+}
+
+// declPath returns the path in the file descriptor to the declaration of e.
+func declPath(e pgs.Entity) []int32 {
+	if e.SourceCodeInfo() == nil {
+		return nil
+	}
+	return append([]int32(nil), e.SourceCodeInfo().Location().GetPath()...)
+}
+
+// optionPath returns the path in the file descriptor to the option ext of e,
+// whose options are the field options of its descriptor.
+func optionPath(e pgs.Entity, options int32, ext protoreflect.ExtensionType) []int32 {
+	path := declPath(e)
+	if path == nil {
+		return nil
+	}
+	return append(path, options, int32(ext.TypeDescriptor().Number()))
+}
+
+// locate returns the line and column of the location of path, or of its
+// closest ancestor with a location. A location below path, such as the one
+// of `(validate.rules).string` for the `(validate.rules)` of a field, stands
+// for path.
+func (m *Module) locate(path []int32) (line, column int) {
+	for n := len(path); n > 0; n-- {
+		for _, loc := range m.locations {
+			if hasPathPrefix(loc.GetPath(), path[:n]) && len(loc.GetSpan()) >= 3 {
+				return int(loc.GetSpan()[0]) + 1, int(loc.GetSpan()[1]) + 1
+			}
+		}
+	}
+	return 0, 0
+}
+
+func hasPathPrefix(path, prefix []int32) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// atRule sets the index of the rule being checked in the current scope, -1
+// once done with its rules.
+func (m *Module) atRule(i int) {
+	m.scopes[len(m.scopes)-1].rule = i
+}
+
+// assert reports a diagnostic made of v if expr is false.
+func (m *Module) assert(expr bool, v ...interface{}) {
+	if !expr {
+		m.report(fmt.Sprint(v...))
+	}
+}
+
+// checkErr reports a diagnostic made of v and err if err is not nil, and
+// returns whether it is.
+func (m *Module) checkErr(err error, v ...interface{}) bool {
+	if err == nil {
+		return true
+	}
+	m.report(fmt.Sprint(v...) + ": " + err.Error())
+	return false
+}
+
+func (m *Module) failf(format string, v ...interface{}) {
+	m.report(fmt.Sprintf(format, v...))
+}
+
+func (m *Module) report(text string) {
+	d := m.diagnostic(text)
+
+	// The checks of a single rule and of all the rules of a field can find
+	// the same inconsistency.
+	for _, prev := range m.diags {
+		if prev.Line == d.Line && prev.Column == d.Column && prev.Message == d.Message &&
+			prev.Field == d.Field && prev.Text == d.Text {
+			return
+		}
+	}
+	m.diags = append(m.diags, d)
+}
+
+// diagnostic returns the diagnostic of text about the current scope.
+func (m *Module) diagnostic(text string) Diagnostic {
+	d := Diagnostic{File: m.file, Rule: -1, Text: text}
+	var names []string
+	var path []int32
+	repeated := false
+	for _, s := range m.scopes {
+		if s.name != "" {
+			names = append(names, s.name)
+		}
+		if s.path != nil {
+			path, repeated = s.path, s.repeated
+		}
+	}
+	if len(names) > 0 {
+		d.Message, d.Field = names[0], strings.Join(names[1:], ".")
+	}
+	if len(m.scopes) > 0 {
+		d.Rule = m.scopes[len(m.scopes)-1].rule
+	}
+
+	// A repeated option, such as expr, has a location per rule. The rules of
+	// other options are fields of their message, not elements of a list.
+	if repeated && d.Rule >= 0 {
+		d.Line, d.Column = m.locate(append(path[:len(path):len(path)], int32(d.Rule)))
+	}
+	if d.Line == 0 {
+		d.Line, d.Column = m.locate(path)
+	}
+	return d
+}
@@ -0,0 +1,250 @@
+package module
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+const (
+	// lintLang checks the validation rules against the lint checks instead of
+	// generating validators, writing the findings to validate-lint.json, or
+	// validate-lint.sarif.
+	lintLang = "lint"
+	// lintParamPrefix starts the parameters setting the severity of a lint
+	// check: lint.<check>=off|note|warning|error.
+	lintParamPrefix = "lint."
+	// lintFormatParam selects the format of the findings: json (default) or
+	// sarif.
+	lintFormatParam = "lint_format"
+	// lintFailParam fails the run, listing the findings, if one of them is an
+	// error, instead of writing them.
+	lintFailParam = "lint_fail"
+)
+
+// Severity is the severity of the findings of a lint check, named after the
+// levels of SARIF.
+type Severity string
+
+const (
+	SeverityOff     Severity = "off"
+	SeverityNote    Severity = "note"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// The lint checks.
+const (
+	// LintRules reports the inconsistent rules the checks of the module find.
+	LintRules = "rules"
+	// LintStringMaxLen reports strings without a bounded length.
+	LintStringMaxLen = "string_max_len"
+	// LintRuleError reports rules without an error, including the rules of
+	// items, keys and values which may rely on the error of their field.
+	LintRuleError = "rule_error"
+	// LintRepeatedMaxItems reports repeated fields and maps without a bounded
+	// number of elements.
+	LintRepeatedMaxItems = "repeated_max_items"
+)
+
+// LintCheck describes a lint check with the severity of its findings unless
+// its lint.<name> parameter sets another one.
+type LintCheck struct {
+	Name        string
+	Description string
+	Severity    Severity
+}
+
+// LintChecks are the lint checks of the lint language.
+var LintChecks = []LintCheck{
+	{LintRules, "Validation rules are consistent and applicable to their fields.", SeverityError},
+	{LintStringMaxLen, "String fields, items, keys and values have a `max_len`, `len`, `max_bytes`, `len_bytes`, `const` or `in` rule.", SeverityWarning},
+	{LintRuleError, "Every rule declares its error, including the rules of items, keys and values and required oneofs.", SeverityWarning},
+	{LintRepeatedMaxItems, "Repeated fields have a `max_items` rule and maps a `max_pairs` rule.", SeverityWarning},
+}
+
+// Finding is a diagnostic of a lint check.
+type Finding struct {
+	Diagnostic
+	Check    string
+	Severity Severity
+}
+
+// String formats f the way compilers do, file:line:column: severity: text,
+// with the rules f is about and its check.
+func (f Finding) String() string {
+	text := f.Text + " [" + f.Check + "]"
+	if subject := f.subject(); subject != "" {
+		text = subject + ": " + text
+	}
+	return fmt.Sprintf("%s: %s: %s", f.location(), f.Severity, text)
+}
+
+// SortFindings sorts findings by file and location.
+func SortFindings(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool { return findings[i].before(findings[j].Diagnostic) })
+}
+
+// LintSeverities returns the severity of each lint check set by the lint.<check>
+// parameters, failing on unknown checks and severities.
+func (m *Module) LintSeverities() map[string]Severity {
+	severities := make(map[string]Severity, len(LintChecks))
+	for _, c := range LintChecks {
+		severities[c.Name] = c.Severity
+	}
+	for key, value := range m.Parameters() {
+		if !strings.HasPrefix(key, lintParamPrefix) {
+			continue
+		}
+		check := strings.TrimPrefix(key, lintParamPrefix)
+		_, ok := severities[check]
+		m.Assert(ok, "unknown lint check `", check, "`")
+		sev := Severity(value)
+		m.Assert(sev == SeverityOff || sev == SeverityNote || sev == SeverityWarning || sev == SeverityError,
+			"`", key, "` parameter must be off, note, warning or error")
+		severities[check] = sev
+	}
+	return severities
+}
+
+// Lint runs the checks of the module and the lint checks on f, returning their
+// findings of the severities set by the parameters.
+func (m *Module) Lint(f pgs.File) []Finding {
+	m.severities = m.LintSeverities()
+	defer func() { m.severities = nil }()
+
+	diags := m.Check(f)
+	findings := make([]Finding, 0, len(diags)+len(m.findings))
+	if sev := m.severities[LintRules]; sev != SeverityOff {
+		for _, d := range diags {
+			findings = append(findings, Finding{Diagnostic: d, Check: LintRules, Severity: sev})
+		}
+	}
+	return append(findings, m.findings...)
+}
+
+// LintTargets lints every target and writes the findings, or fails with them
+// if lint_fail is set and one of them is an error.
+func (m *Module) LintTargets(targets map[string]pgs.File) {
+	format := m.Parameters().StrDefault(lintFormatParam, "json")
+	m.Assert(format == "json" || format == "sarif", "`lint_format` parameter must be json or sarif, default is json")
+	fail, err := m.Parameters().BoolDefault(lintFailParam, false)
+	m.Assert(err == nil, "`lint_fail` parameter must be set true or false, default is false")
+
+	var findings []Finding
+	for _, f := range targets {
+		findings = append(findings, m.Lint(f)...)
+	}
+	SortFindings(findings)
+
+	if fail {
+		var errors int
+		lines := make([]string, len(findings))
+		for i, f := range findings {
+			if f.Severity == SeverityError {
+				errors++
+			}
+			lines[i] = f.String()
+		}
+		if errors > 0 {
+			m.Failf("%d lint errors:\n%s", errors, strings.Join(lines, "\n"))
+		}
+	}
+
+	var report []byte
+	if format == "sarif" {
+		report = sarifReport(findings, m.LintSeverities())
+	} else {
+		report = jsonReport(findings)
+	}
+	m.AddGeneratorFile("validate-lint."+format, string(report))
+}
+
+// lintf reports a finding of check about the current scope, unless check is
+// off.
+func (m *Module) lintf(check string, format string, v ...interface{}) {
+	sev := m.severities[check]
+	if sev == "" || sev == SeverityOff {
+		return
+	}
+	m.findings = append(m.findings, Finding{
+		Diagnostic: m.diagnostic(fmt.Sprintf(format, v...)),
+		Check:      check,
+		Severity:   sev,
+	})
+}
+
+// LintField runs the lint checks on the rules of f.
+func (m *Module) LintField(f pgs.Field, rules *validate.FieldRules) {
+	typ := f.Type()
+	switch {
+	case typ.IsMap():
+		var bounded bool
+		var keys, values []*validate.FieldRules
+		for _, r := range rules.GetMap().GetRules() {
+			bounded = bounded || r.MaxPairs != nil
+			keys, values = append(keys, r.Keys), append(values, r.Values)
+		}
+		if !bounded {
+			m.lintf(LintRepeatedMaxItems, "map has no `max_pairs` rule")
+		}
+		m.lintString("keys", typ.Key(), keys)
+		m.lintString("values", typ.Element(), values)
+	case typ.IsRepeated():
+		var bounded bool
+		var items []*validate.FieldRules
+		for _, r := range rules.GetRepeated().GetRules() {
+			bounded = bounded || r.MaxItems != nil
+			items = append(items, r.Items)
+		}
+		if !bounded {
+			m.lintf(LintRepeatedMaxItems, "repeated field has no `max_items` rule")
+		}
+		m.lintString("items", typ.Element(), items)
+	default:
+		m.lintString("", typ, []*validate.FieldRules{rules})
+	}
+
+	shared.WalkMissingErrors(rules, func(rule protoreflect.Message) {
+		if r, ok := rule.Interface().(*validate.MessageRules); ok && !r.GetRequired() {
+			// only required messages fail with their error
+			return
+		}
+		m.lintf(LintRuleError, "%s has no error", rule.Descriptor().Name())
+	})
+}
+
+// LintOneOf runs the lint checks on the rule of a oneof.
+func (m *Module) LintOneOf(rule *validate.OneOf) {
+	if rule.GetRequired() && rule.GetError() == nil {
+		m.lintf(LintRuleError, "required oneof has no error")
+	}
+}
+
+// lintString reports strings of typ, the type of the field or of its elements
+// in scope, without a rule of rules bounding their length.
+func (m *Module) lintString(scope string, typ FieldType, rules []*validate.FieldRules) {
+	if typ.ProtoType() != pgs.StringT &&
+		(typ.Embed() == nil || typ.Embed().WellKnownType() != pgs.StringValueWKT) {
+		return
+	}
+	for _, r := range rules {
+		for _, s := range r.GetString_().GetRules() {
+			if s.MaxLen != nil || s.Len != nil || s.MaxBytes != nil || s.LenBytes != nil || s.Const != nil || len(s.In) > 0 {
+				return
+			}
+		}
+	}
+
+	if scope != "" {
+		m.push(scope)
+		defer m.pop()
+	}
+	m.lintf(LintStringMaxLen, "string has no `max_len` rule")
+}
@@ -0,0 +1,167 @@
+package module
+
+import (
+	"encoding/json"
+)
+
+// The JSON report lists the findings with their location and the rules they
+// are about.
+type (
+	jsonLint struct {
+		Findings []jsonFinding `json:"findings"`
+	}
+
+	jsonFinding struct {
+		Check    string   `json:"check"`
+		Severity Severity `json:"severity"`
+		File     string   `json:"file"`
+		Line     int      `json:"line,omitempty"`
+		Column   int      `json:"column,omitempty"`
+		Message  string   `json:"message,omitempty"`
+		Field    string   `json:"field,omitempty"`
+		Rule     *int     `json:"rule,omitempty"`
+		Text     string   `json:"text"`
+	}
+)
+
+func jsonReport(findings []Finding) []byte {
+	report := jsonLint{Findings: make([]jsonFinding, len(findings))}
+	for i, f := range findings {
+		report.Findings[i] = jsonFinding{
+			Check:    f.Check,
+			Severity: f.Severity,
+			File:     f.File,
+			Line:     f.Line,
+			Column:   f.Column,
+			Message:  f.Message,
+			Field:    f.Field,
+			Text:     f.Text,
+		}
+		if f.Rule >= 0 {
+			rule := f.Rule
+			report.Findings[i].Rule = &rule
+		}
+	}
+	return marshalReport(report)
+}
+
+// The SARIF report is a SARIF 2.1.0 log of a single run, with the enabled
+// lint checks as the rules of the tool.
+type (
+	sarifLog struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name  string      `json:"name"`
+		Rules []sarifRule `json:"rules"`
+	}
+
+	sarifRule struct {
+		ID                   string             `json:"id"`
+		ShortDescription     sarifMessage       `json:"shortDescription"`
+		DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	}
+
+	sarifConfiguration struct {
+		Level Severity `json:"level"`
+	}
+
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		Level     Severity        `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+		LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *sarifRegion          `json:"region,omitempty"`
+	}
+
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+	}
+
+	sarifLogicalLocation struct {
+		FullyQualifiedName string `json:"fullyQualifiedName"`
+	}
+)
+
+func sarifReport(findings []Finding, severities map[string]Severity) []byte {
+	driver := sarifDriver{Name: "protoc-gen-validate", Rules: []sarifRule{}}
+	for _, c := range LintChecks {
+		if sev := severities[c.Name]; sev != SeverityOff {
+			driver.Rules = append(driver.Rules, sarifRule{
+				ID:                   c.Name,
+				ShortDescription:     sarifMessage{Text: c.Description},
+				DefaultConfiguration: sarifConfiguration{Level: sev},
+			})
+		}
+	}
+
+	results := make([]sarifResult, len(findings))
+	for i, f := range findings {
+		loc := sarifLocation{PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: f.File}}}
+		if f.Line > 0 {
+			loc.PhysicalLocation.Region = &sarifRegion{StartLine: f.Line, StartColumn: f.Column}
+		}
+		if f.Message != "" {
+			name := f.Message
+			if f.Field != "" {
+				name += "." + f.Field
+			}
+			loc.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: name}}
+		}
+		text := f.Text
+		if subject := f.subject(); subject != "" {
+			text = subject + ": " + text
+		}
+		results[i] = sarifResult{
+			RuleID:    f.Check,
+			Level:     f.Severity,
+			Message:   sarifMessage{Text: text},
+			Locations: []sarifLocation{loc},
+		}
+	}
+
+	return marshalReport(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	})
+}
+
+func marshalReport(v interface{}) []byte {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		// the reports only hold strings and numbers
+		panic(err)
+	}
+	return append(out, '\n')
+}
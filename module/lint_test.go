@@ -0,0 +1,87 @@
+package module
+
+import (
+	"strings"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields string
+		params pgs.Parameters
+		want   []string
+	}{
+		{
+			name:   "bounded fields",
+			fields: `string a = 1 [(validate.rules).string = {rules: [{max_len: 10, %e}]}]; repeated int32 b = 2 [(validate.rules).repeated = {rules: [{max_items: 3, %e}]}];`,
+		},
+		{
+			name:   "string without rules",
+			fields: `string a = 1;`,
+			want:   []string{"case.proto:13:1: warning: acme.M.a: string has no `max_len` rule [string_max_len]"},
+		},
+		{
+			name:   "string value with another bound",
+			fields: `google.protobuf.StringValue a = 1 [(validate.rules).string = {rules: [{in: ["x"], %e}]}];`,
+		},
+		{
+			name:   "repeated strings",
+			fields: `repeated string a = 1 [(validate.rules).repeated = {rules: [{min_items: 1, %e}]}];`,
+			want: []string{
+				"case.proto:13:24: warning: acme.M.a: repeated field has no `max_items` rule [repeated_max_items]",
+				"case.proto:13:24: warning: acme.M.a.items: string has no `max_len` rule [string_max_len]",
+			},
+		},
+		{
+			name:   "map keys",
+			fields: `map<string, int32> a = 1 [(validate.rules).map = {rules: [{max_pairs: 3, keys: {string: {rules: [{len: 2, %e}]}}, %e}]}];`,
+		},
+		{
+			name:   "map values",
+			fields: `map<int32, string> a = 1 [(validate.rules).map = {rules: [{max_pairs: 3, %e}]}];`,
+			want:   []string{"case.proto:13:27: warning: acme.M.a.values: string has no `max_len` rule [string_max_len]"},
+		},
+		{
+			name:   "required oneof without error",
+			fields: `oneof o { option (validate.oneof) = {required: true}; int32 a = 1; }`,
+			want:   []string{"case.proto:13:11: warning: acme.M.o: required oneof has no error [rule_error]"},
+		},
+		{
+			name:   "message rules without error",
+			fields: `M a = 1 [(validate.rules).message = {skip: true}];`,
+		},
+		{
+			name:   "severities",
+			fields: `string a = 1; repeated int32 b = 2;`,
+			params: pgs.Parameters{langParam: lintLang, "lint.string_max_len": "off", "lint.repeated_max_items": "error"},
+			want:   []string{"case.proto:13:15: error: acme.M.b: repeated field has no `max_items` rule [repeated_max_items]"},
+		},
+		{
+			name:   "inconsistent rules",
+			fields: `int32 a = 1 [(validate.rules).int32 = {rules: [{const: 1, %e}, {const: 2, %e}]}];`,
+			want:   []string{"case.proto:13:14: error: acme.M.a (rule 1): cannot have multi `const` rules on the same field [rules]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			if params == nil {
+				params = pgs.Parameters{langParam: lintLang}
+			}
+			m, f := compileCase(t, checkerCase{fields: tt.fields, params: params})
+			findings := m.Lint(f)
+			SortFindings(findings)
+
+			got := make([]string, len(findings))
+			for i, finding := range findings {
+				got[i] = finding.String()
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("got findings:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
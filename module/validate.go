@@ -1,26 +1,50 @@
 package module
 
 import (
+	"regexp"
 	"strings"
 
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/curl-li/protoc-gen-validate/templates"
 	"github.com/curl-li/protoc-gen-validate/templates/java"
+	"github.com/curl-li/protoc-gen-validate/templates/rust"
+	"github.com/curl-li/protoc-gen-validate/templates/ts"
 )
 
 const (
 	validatorName = "validator"
 	langParam     = "lang"
 	moduleParam   = "module"
-	formatParam   = "format"
+	// formatParam formats the generated code: true runs the formatter of the
+	// language, for Java google-java-format, and builtin the pure Go
+	// pretty-printer for Java.
+	formatParam = "format"
+	// errorModeParam selects when Java validators build the errors of rules:
+	// `eager` (default) builds them once per validator, `lazy` on each failure.
+	errorModeParam = "error_mode"
 )
 
+var sha256Hex = regexp.MustCompile("^[0-9a-fA-F]{64}$")
+
 type Module struct {
 	*pgs.ModuleBase
 	ctx        pgsgo.Context
 	formatCode bool
+
+	// the file being checked with its source locations, the path to its
+	// rules being checked and the diagnostics found so far
+	file      string
+	locations []*descriptorpb.SourceCodeInfo_Location
+	scopes    []scope
+	diags     []Diagnostic
+
+	// the severities of the lint checks while linting, and their findings
+	// so far
+	severities map[string]Severity
+	findings   []Finding
 }
 
 func Validator() pgs.Module { return &Module{ModuleBase: &pgs.ModuleBase{}} }
@@ -35,22 +59,44 @@ func (m *Module) Name() string { return validatorName }
 func (m *Module) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Package) []pgs.Artifact {
 	lang := m.Parameters().Str(langParam)
 	m.Assert(lang != "", "`lang` parameter must be set")
-	format, err := m.Parameters().Bool(formatParam)
-	m.Assert(err == nil, "`format` parameter must be set true or false, default is false")
-	m.formatCode = format
+	if m.Parameters().Str(formatParam) == java.BuiltinFormat {
+		m.formatCode = true
+	} else {
+		format, err := m.Parameters().Bool(formatParam)
+		m.Assert(err == nil, "`format` parameter must be set true, false or builtin, default is false")
+		m.formatCode = format
+	}
+	_, err := m.Parameters().BoolDefault(java.BeanValidationParam, false)
+	m.Assert(err == nil, "`java_bean_validation` parameter must be set true or false, default is false")
+	_, err = m.Parameters().BoolDefault(java.GrpcParam, false)
+	m.Assert(err == nil, "`java_grpc` parameter must be set true or false, default is false")
+	sum := m.Parameters().Str(java.FormatToolSumParam)
+	m.Assert(sum == "" || sha256Hex.MatchString(sum),
+		"`format_tool_sha256` parameter must be the hex sha256 of the format tool")
+	errorMode := m.Parameters().Str(errorModeParam)
+	m.Assert(errorMode == "" || errorMode == "eager" || errorMode == "lazy",
+		"`error_mode` parameter must be eager or lazy, default is eager")
+	longType := m.Parameters().Str(ts.LongTypeParam)
+	m.Assert(longType == "" || longType == "bigint" || longType == "string" || longType == "number",
+		"`ts_long_type` parameter must be bigint, string or number, default is bigint")
+	m.Assert((m.Parameters().Str(rust.ErrorTypeParam) == "") == (m.Parameters().Str(rust.ErrorParam) == ""),
+		"`rust_error_type` and `rust_error` parameters must be set together")
 	module := m.Parameters().Str(moduleParam)
 
+	if lang == lintLang {
+		m.LintTargets(targets)
+		return m.Artifacts()
+	}
+
 	// Process file-level templates
 	tpls := templates.Template(m.Parameters())[lang]
 	m.Assert(tpls != nil, "could not find templates for `lang`: ", lang)
 
+	m.CheckTargets(targets)
+
 	for _, f := range targets {
 		m.Push(f.Name().String())
 
-		for _, msg := range f.AllMessages() {
-			m.CheckRules(msg)
-		}
-
 		for _, tpl := range tpls {
 			out := templates.FilePathFor(tpl)(f, m.ctx, tpl)
 
@@ -58,16 +104,18 @@ func (m *Module) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Packag
 			// implementation-specific FilePathFor implementations.
 			// Ex: Don't generate Java validators for files that don't reference PGV.
 			if out != nil {
-				outPath := strings.TrimLeft(strings.ReplaceAll(out.String(), module, ""), "/")
-
-				if opts := f.Descriptor().GetOptions(); opts != nil && opts.GetJavaMultipleFiles() && lang == "java" {
+				if tpl.Name() == "interceptor" {
+					for _, svc := range f.Services() {
+						m.AddGeneratorTemplateFile(outPath(java.InterceptorFilePath(f, svc), module), tpl, svc)
+					}
+				} else if opts := f.Descriptor().GetOptions(); opts != nil && opts.GetJavaMultipleFiles() && tpl.Name() == "java" {
 					// TODO: Only Java supports multiple file generation. If more languages add multiple file generation
 					// support, the implementation should be made more inderect.
 					for _, msg := range f.Messages() {
 						m.AddGeneratorTemplateFile(java.JavaMultiFilePath(f, msg).String(), tpl, msg)
 					}
 				} else {
-					m.AddGeneratorTemplateFile(outPath, tpl, f)
+					m.AddGeneratorTemplateFile(outPath(*out, module), tpl, f)
 				}
 			}
 		}
@@ -78,6 +126,12 @@ func (m *Module) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Packag
 	return m.Artifacts()
 }
 
+// outPath returns the path of a generated file relative to the `module`
+// parameter.
+func outPath(p pgs.FilePath, module string) string {
+	return strings.TrimLeft(strings.ReplaceAll(p.String(), module, ""), "/")
+}
+
 // AddGeneratorTemplateFile behaves the same as AddGeneratorFile, however the
 // contents are rendered from the provided tpl and data.
 func (m *Module) AddGeneratorTemplateFile(name string, tpl pgs.Template, data interface{}) {
@@ -0,0 +1,363 @@
+"""Runtime support for the validators protoc-gen-validate generates with
+``lang=python``.
+
+Generated ``*_pb2_validate.py`` modules define a ``check_<message>`` function
+per message, which reports failed rules to a :class:`Violations` instance,
+plus ``validate_<message>`` and ``validate_<message>_all`` wrappers built on
+:func:`validate` and :func:`validate_all`.
+
+The factories named by ``validate.Error`` are Java methods, which Python
+applications map onto their own exceptions through an :class:`ErrorRegistry`.
+"""
+
+import ipaddress
+import re
+import time
+from collections import namedtuple
+from contextlib import contextmanager
+
+ErrorSpec = namedtuple("ErrorSpec", ["pkg", "cls", "method", "params"])
+ErrorSpec.__doc__ = """The error a rule was declared with, after ``error_base``
+inheritance. Params are resolved by the generator: string, int, float and
+bool literals, :class:`Ref` to named constants and, for the ``$value``
+placeholder, the offending value itself."""
+
+ErrorInfo = namedtuple("ErrorInfo", ErrorSpec._fields + ("field", "rule", "value"))
+ErrorInfo.__doc__ = """An :class:`ErrorSpec` together with the path of the
+offending field, e.g. ``address.lines[2]``, the name of the failed rule, e.g.
+``string.min_len``, and the offending value."""
+
+Violation = namedtuple("Violation", ["field_path", "rule", "error"])
+Violation.__doc__ = """A single failed rule: the path of the offending field,
+the name of the rule and the exception built for it."""
+
+
+class Ref(object):
+    """A reference to a named constant, the ``ref`` kind of ``validate.Param``."""
+
+    __slots__ = ("name",)
+
+    def __init__(self, name):
+        self.name = name
+
+    def __eq__(self, other):
+        return isinstance(other, Ref) and other.name == self.name
+
+    def __hash__(self):
+        return hash(self.name)
+
+    def __repr__(self):
+        return "Ref(%r)" % self.name
+
+    def __str__(self):
+        return self.name
+
+
+def ref(name):
+    return Ref(name)
+
+
+def error_name(spec):
+    """Returns the qualified name of the factory method of an error, e.g.
+    ``com.acme.errors.Errors.nameEmpty``."""
+    return ".".join(part for part in (spec.pkg, spec.cls, spec.method) if part)
+
+
+class ValidationFailed(Exception):
+    """The exception built for failed rules without a registered factory."""
+
+    def __init__(self, info):
+        prefix = info.field + ": " if info.field else ""
+        super(ValidationFailed, self).__init__("%s%s: %s" % (prefix, info.rule, error_name(info)))
+        self.info = info
+
+
+class ErrorRegistry(object):
+    """Maps the qualified names of error factories onto callables taking an
+    :class:`ErrorInfo` and returning the exception to report.
+
+    A factory is looked up by the full name of the error, then by each of its
+    prefixes, so a factory registered for ``com.acme.errors.Errors`` builds
+    every error of that class and one registered for the empty name every
+    error at all. Errors without a factory become :class:`ValidationFailed`.
+    """
+
+    def __init__(self):
+        self._factories = {}
+
+    def register(self, name, factory=None):
+        """Registers ``factory`` for ``name``. Without a factory, returns a
+        decorator registering the decorated callable."""
+        if factory is None:
+            def decorator(fn):
+                self._factories[name] = fn
+                return fn
+            return decorator
+        self._factories[name] = factory
+        return factory
+
+    def unregister(self, name):
+        self._factories.pop(name, None)
+
+    def build(self, info):
+        name = error_name(info)
+        while True:
+            factory = self._factories.get(name)
+            if factory is not None:
+                return factory(info)
+            if not name:
+                return ValidationFailed(info)
+            name = name.rpartition(".")[0]
+
+
+default_registry = ErrorRegistry()
+"""The registry validators use unless given another one."""
+
+
+def register_error_factory(name, factory=None):
+    """Registers ``factory`` for ``name`` in the :data:`default_registry`."""
+    return default_registry.register(name, factory)
+
+
+class Violations(object):
+    """Gathers the violations found while validating a message. A fail-fast
+    instance raises the error of the first failed rule, any other instance
+    records it and carries on with the next rule."""
+
+    def __init__(self, fail_fast, registry=None):
+        self.fail_fast = fail_fast
+        self._registry = registry if registry is not None else default_registry
+        self._path = []
+        self._found = []
+
+    def fail(self, field, rule, value, spec):
+        """Reports a failed rule of ``field``, an empty field standing for the
+        current path itself."""
+        field_path = self._path_to(field)
+        error = self._registry.build(ErrorInfo(*spec, field=field_path, rule=rule, value=value))
+        if self.fail_fast:
+            raise error
+        self._found.append(Violation(field_path, rule, error))
+
+    @contextmanager
+    def within(self, field):
+        """Appends ``field`` to the current path for the duration of the
+        block, used when descending into embedded messages, repeated items and
+        map entries."""
+        if not field:
+            yield
+            return
+        self._path.append(self._path_to(field))
+        try:
+            yield
+        finally:
+            self._path.pop()
+
+    def each(self, field, items):
+        """Iterates over the items of the repeated ``field``, each within its
+        index."""
+        with self.within(field):
+            for idx, item in enumerate(items):
+                with self.within("[%d]" % idx):
+                    yield item
+
+    def entries(self, field, mapping):
+        """Iterates over the key and value pairs of the map ``field``, each
+        within its key."""
+        with self.within(field):
+            for key in sorted(mapping):
+                with self.within("[%s]" % _key_str(key)):
+                    yield key, mapping[key]
+
+    def nested(self, field, value, check):
+        """Validates the embedded message ``field`` with ``check``."""
+        with self.within(field):
+            check(value, self)
+
+    @property
+    def violations(self):
+        """Returns the violations recorded so far."""
+        return list(self._found)
+
+    def _path_to(self, field):
+        parent = self._path[-1] if self._path else ""
+        if not field:
+            return parent
+        if not parent or field.startswith("["):
+            return parent + field
+        return parent + "." + field
+
+
+def _key_str(key):
+    if isinstance(key, bool):
+        return "true" if key else "false"
+    return str(key)
+
+
+def validate(check, m, registry=None):
+    """Validates ``m`` with ``check``, raising the error of the first failed
+    rule."""
+    check(m, Violations(True, registry))
+
+
+def validate_all(check, m, registry=None):
+    """Validates ``m`` with ``check``, returning every failed rule."""
+    v = Violations(False, registry)
+    check(m, v)
+    return v.violations
+
+
+# strings
+
+def byte_len(s):
+    """Returns the length of ``s`` encoded as UTF-8."""
+    return len(s.encode("utf-8"))
+
+
+_hostname_label = re.compile(r"^[a-z0-9]([a-z0-9-]*[a-z0-9])?$")
+
+
+def is_hostname(host):
+    if len(host) > 253:
+        return False
+    s = host[:-1] if host.endswith(".") else host
+    return all(0 < len(part) <= 63 and _hostname_label.match(part) for part in s.lower().split("."))
+
+
+_email_local_part = re.compile(r"^[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+(\.[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+)*$")
+_email_angled = re.compile(r"^[^<]*<([^>]*)>$")
+
+
+def is_email(addr):
+    """Validates an RFC 5322 address, optionally with a display name, e.g.
+    ``Jane <jane@example.com>``."""
+    angled = _email_angled.match(addr)
+    s = angled.group(1) if angled else addr.strip()
+    if len(s) > 254:
+        return False
+    local, at, domain = s.rpartition("@")
+    if not at:
+        return False
+    return len(local) <= 64 and bool(_email_local_part.match(local)) and is_hostname(domain)
+
+
+def is_ipv4(s):
+    try:
+        ipaddress.IPv4Address(s)
+    except ValueError:
+        return False
+    return True
+
+
+def is_ipv6(s):
+    if "%" in s:
+        # scoped addresses aren't IP addresses to Go and Java
+        return False
+    try:
+        ipaddress.IPv6Address(s)
+    except ValueError:
+        return False
+    return True
+
+
+def is_ip(s):
+    return is_ipv4(s) or is_ipv6(s)
+
+
+def is_address(s):
+    """Validates either an IP address or a hostname."""
+    return is_ip(s) or is_hostname(s)
+
+
+_uri_ref = re.compile(r"^([^\s%]|%[0-9a-fA-F]{2})*$")
+_uri_scheme = re.compile(r"^[a-zA-Z][a-zA-Z0-9+.-]*:")
+
+
+def is_uri(s):
+    """Validates an absolute URI as defined by RFC 3986."""
+    return bool(_uri_scheme.match(s)) and is_uri_ref(s)
+
+
+def is_uri_ref(s):
+    """Validates an absolute or relative URI reference as defined by RFC 3986."""
+    return bool(_uri_ref.match(s))
+
+
+_uuid = re.compile(r"^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+
+def is_uuid(s):
+    return bool(_uuid.match(s))
+
+
+_header_name = re.compile(r"^:?[0-9a-zA-Z!#$%&'*+\-.^_|~`]+$")
+_header_value = re.compile(r"^[^\x00-\x08\x0A-\x1F\x7F]*$")
+_header_loose = re.compile(r"^[^\x00\x0A\x0D]*$")
+
+
+def is_http_header_name(s, strict):
+    """Validates an HTTP header name as defined by RFC 7230, or with
+    ``strict`` unset, only disallows ``\\r\\n\\0``."""
+    if strict:
+        return bool(_header_name.match(s))
+    return s != "" and bool(_header_loose.match(s))
+
+
+def is_http_header_value(s, strict):
+    """Validates an HTTP header value as defined by RFC 7230, or with
+    ``strict`` unset, only disallows ``\\r\\n\\0``."""
+    return bool((_header_value if strict else _header_loose).match(s))
+
+
+# bytes
+
+def utf8(b):
+    """Decodes ``b`` as UTF-8, for matching bytes against patterns."""
+    return b.decode("utf-8", "replace")
+
+
+# repeated
+
+def unique(items):
+    """Returns True if no two items are equal."""
+    seen = set()
+    for item in items:
+        if item in seen:
+            return False
+        seen.add(item)
+    return True
+
+
+# google.protobuf.Duration and google.protobuf.Timestamp
+
+_NANOS_PER_SECOND = 1000000000
+
+
+def nanos(d):
+    """Returns ``d`` in nanoseconds, also used for timestamps as nanoseconds
+    since the epoch."""
+    return d.seconds * _NANOS_PER_SECOND + d.nanos
+
+
+def now_nanos():
+    return time.time_ns()
+
+
+def is_valid_duration(d):
+    """Returns True if ``d`` is within the range google.protobuf.Duration
+    documents."""
+    if not -315576000000 <= d.seconds <= 315576000000 or not -_NANOS_PER_SECOND < d.nanos < _NANOS_PER_SECOND:
+        return False
+    return not ((d.seconds > 0 and d.nanos < 0) or (d.seconds < 0 and d.nanos > 0))
+
+
+def is_valid_timestamp(ts):
+    """Returns True if ``ts`` is within the range google.protobuf.Timestamp
+    documents, years 1 to 9999."""
+    return -62135596800 <= ts.seconds <= 253402300799 and 0 <= ts.nanos < _NANOS_PER_SECOND
+
+
+def is_within_now(ts, within):
+    """Returns True if ``ts`` is at most ``within`` nanoseconds away from
+    now."""
+    return abs(nanos(ts) - now_nanos()) <= within
@@ -0,0 +1,89 @@
+import time
+import unittest
+from collections import namedtuple
+
+from protoc_gen_validate import validator as pgv
+
+spec = pgv.ErrorSpec("com.acme", "Errors", "bad", ())
+
+Seconds = namedtuple("Seconds", ["seconds", "nanos"])
+
+
+class NotFound(Exception):
+    pass
+
+
+class ViolationsTest(unittest.TestCase):
+
+    def test_fail_fast_raises_first_error(self):
+        v = pgv.Violations(True)
+        with self.assertRaises(pgv.ValidationFailed) as ctx:
+            with v.within("address"):
+                v.fail("city", "string.in", "c", spec)
+        self.assertEqual(ctx.exception.info.field, "address.city")
+        self.assertEqual(str(ctx.exception), "address.city: string.in: com.acme.Errors.bad")
+
+    def test_collects_violations_with_their_paths(self):
+        v = pgv.Violations(False)
+        v.fail("name", "string.min_len", "", spec)
+        for item in v.each("tags", ["a", "b"]):
+            v.fail("", "string.min_len", item, spec)
+        for key, val in v.entries("flags", {True: 0}):
+            v.fail("", "int32.range", val, spec)
+        v.nested("address", {"city": "c"}, lambda m, nv: nv.fail("city", "string.in", m["city"], spec))
+
+        self.assertEqual(
+            [it.field_path for it in v.violations],
+            ["name", "tags[0]", "tags[1]", "flags[true]", "address.city"],
+        )
+
+    def test_registry_falls_back_to_prefixes(self):
+        registry = pgv.ErrorRegistry()
+
+        @registry.register("com.acme.Errors")
+        def errors(info):
+            return NotFound("%s(%s)" % (info.method, info.value))
+
+        found = pgv.validate_all(lambda m, v: v.fail("", "string.const", m, spec), "x", registry)
+        self.assertEqual(len(found), 1)
+        self.assertIsInstance(found[0].error, NotFound)
+        self.assertEqual(str(found[0].error), "bad(x)")
+
+        registry.register("com.acme.Errors.bad", lambda info: KeyError(info.field))
+        with self.assertRaises(KeyError):
+            pgv.validate(lambda m, v: v.fail("name", "string.const", m, spec), "x", registry)
+
+
+class FormatsTest(unittest.TestCase):
+
+    def test_well_known_string_formats(self):
+        self.assertTrue(pgv.is_email("jane@example.com"))
+        self.assertTrue(pgv.is_email("Jane <jane@example.com>"))
+        self.assertFalse(pgv.is_email("jane.example.com"))
+        self.assertTrue(pgv.is_hostname("example.com."))
+        self.assertFalse(pgv.is_hostname("-example.com"))
+        self.assertTrue(pgv.is_ipv4("192.168.0.1"))
+        self.assertFalse(pgv.is_ipv4("192.168.0.01"))
+        self.assertTrue(pgv.is_ipv6("::ffff:192.168.0.1"))
+        self.assertFalse(pgv.is_ipv6("fe80::1%eth0"))
+        self.assertTrue(pgv.is_uri("https://example.com/a?b=%20"))
+        self.assertFalse(pgv.is_uri("/relative"))
+        self.assertTrue(pgv.is_uri_ref("/relative"))
+        self.assertTrue(pgv.is_uuid("123e4567-e89b-12d3-a456-426614174000"))
+        self.assertTrue(pgv.is_http_header_name(":authority", True))
+        self.assertFalse(pgv.is_http_header_value("a\r\nb", False))
+        self.assertEqual(pgv.byte_len(u"héllo\U0001F600"), 10)
+
+    def test_durations_and_timestamps(self):
+        self.assertEqual(pgv.nanos(Seconds(1, 5)), 1000000005)
+        self.assertFalse(pgv.is_valid_duration(Seconds(1, -1)))
+        self.assertFalse(pgv.is_valid_timestamp(Seconds(0, -1)))
+        self.assertTrue(pgv.is_within_now(Seconds(int(time.time()), 0), 5000000000))
+
+    def test_unique(self):
+        self.assertFalse(pgv.unique([b"\x01", b"\x01"]))
+        self.assertTrue(pgv.unique([1, 2]))
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,6 @@
+//! The measures of the bytes rules missing from slices.
+
+/// Reports if sub occurs in b.
+pub fn contains(b: &[u8], sub: &[u8]) -> bool {
+    sub.is_empty() || b.windows(sub.len()).any(|w| w == sub)
+}
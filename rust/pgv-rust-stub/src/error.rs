@@ -0,0 +1,108 @@
+use std::error::Error;
+use std::fmt;
+
+/// Param is an argument of an error: a literal resolved by the generator, a
+/// reference to a named constant, or the offending value.
+#[derive(Clone, Copy)]
+pub enum Param<'a> {
+    None,
+    Str(&'a str),
+    Int(i64),
+    Uint(u64),
+    Float(f64),
+    Bool(bool),
+    /// Ref is the name of a constant, the ref kind of validate.Param.
+    Ref(&'a str),
+    /// Value is the offending value.
+    Value(&'a dyn fmt::Debug),
+}
+
+impl fmt::Display for Param<'_> {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {
+            Param::None => Ok(()),
+            Param::Str(s) | Param::Ref(s) => f.write_str(s),
+            Param::Int(n) => write!(f, "{}", n),
+            Param::Uint(n) => write!(f, "{}", n),
+            Param::Float(n) => write!(f, "{}", n),
+            Param::Bool(b) => write!(f, "{}", b),
+            Param::Value(v) => write!(f, "{:?}", v),
+        }
+    }
+}
+
+impl fmt::Debug for Param<'_> {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {
+            Param::None => f.write_str("None"),
+            Param::Str(s) => write!(f, "Str({:?})", s),
+            Param::Ref(s) => write!(f, "Ref({})", s),
+            Param::Value(v) => write!(f, "Value({:?})", v),
+            _ => write!(f, "{}", self),
+        }
+    }
+}
+
+/// ErrorSpec is the error a rule was declared with, after error_base
+/// inheritance.
+#[derive(Clone, Copy, Debug)]
+pub struct ErrorSpec<'a> {
+    pub pkg: &'a str,
+    pub class: &'a str,
+    pub method: &'a str,
+    pub params: &'a [Param<'a>],
+}
+
+impl ErrorSpec<'_> {
+    /// Returns the qualified name of the factory method of the error, e.g.
+    /// `com.acme.errors.Errors.nameEmpty`.
+    pub fn name(&self) -> String {
+        [self.pkg, self.class, self.method]
+            .iter()
+            .filter(|part| !part.is_empty())
+            .copied()
+            .collect::<Vec<_>>()
+            .join(".")
+    }
+}
+
+/// ErrorInfo is an ErrorSpec together with where and why validation failed.
+#[derive(Clone, Copy, Debug)]
+pub struct ErrorInfo<'a> {
+    pub spec: &'a ErrorSpec<'a>,
+    /// The path of the offending field, e.g. `address.lines[2]` or
+    /// `labels[env]`.
+    pub field: &'a str,
+    /// The name of the failed rule, e.g. `string.min_len`.
+    pub rule: &'a str,
+    /// The offending value, [`Param::None`] for unset fields.
+    pub value: &'a Param<'a>,
+}
+
+/// ValidationError is the default error of failed rules, describing the rule,
+/// e.g. "name: string.min_len: com.acme.errors.Errors.nameEmpty".
+#[derive(Clone, Debug, PartialEq, Eq)]
+pub struct ValidationError {
+    pub message: String,
+}
+
+impl ValidationError {
+    pub fn new(info: &ErrorInfo<'_>) -> Self {
+        let field = if info.field.is_empty() {
+            String::new()
+        } else {
+            format!("{}: ", info.field)
+        };
+        ValidationError {
+            message: format!("{}{}: {}", field, info.rule, info.spec.name()),
+        }
+    }
+}
+
+impl fmt::Display for ValidationError {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        f.write_str(&self.message)
+    }
+}
+
+impl Error for ValidationError {}
@@ -0,0 +1,213 @@
+//! Runtime support for the validators protoc-gen-validate generates with
+//! `lang=rust`, which implement [`Validate`] for the message types of prost.
+//!
+//! The generated `<file>.validate.rs` is included in the module of the proto
+//! package, next to the code of prost:
+//!
+//! ```ignore
+//! pub mod v1 {
+//!     include!(concat!(env!("OUT_DIR"), "/acme.billing.v1.rs"));
+//!     include!(concat!(env!("OUT_DIR"), "/acme/billing/v1/invoice.validate.rs"));
+//! }
+//! ```
+
+use std::fmt;
+
+mod error;
+
+pub mod bytes;
+pub mod strings;
+pub mod times;
+
+pub use error::{ErrorInfo, ErrorSpec, Param, ValidationError};
+pub use strings::Pattern;
+
+/// A message with validation rules. protoc-gen-validate implements it for the
+/// message types of prost.
+pub trait Validate {
+    /// The error of a failed rule, [`ValidationError`] unless the generator
+    /// options `rust_error_type` and `rust_error` name another.
+    type Error;
+
+    /// Builds the error of a failed rule. The factory methods named by
+    /// validate.Error don't exist in Rust, so the generated implementations
+    /// call the constructor named by `rust_error` with the spec of the rule.
+    fn error(info: &ErrorInfo<'_>) -> Self::Error;
+
+    /// Reports the violations of the rules of this message to `v`.
+    fn check(&self, v: &mut Violations<Self::Error>) -> Checked;
+
+    /// Validates this message, returning the error of the first failed rule.
+    fn validate(&self) -> Result<(), Self::Error> {
+        let mut v = Violations::new(true, Self::error);
+        let _ = self.check(&mut v);
+        match v.into_violations().into_iter().next() {
+            Some(violation) => Err(violation.error),
+            None => Ok(()),
+        }
+    }
+
+    /// Validates this message, returning every failed rule.
+    fn validate_all(&self) -> Result<(), Vec<Violation<Self::Error>>> {
+        let mut v = Violations::new(false, Self::error);
+        let _ = self.check(&mut v);
+        let found = v.into_violations();
+        if found.is_empty() {
+            Ok(())
+        } else {
+            Err(found)
+        }
+    }
+}
+
+/// prost boxes the message fields of recursive messages.
+impl<T: Validate + ?Sized> Validate for Box<T> {
+    type Error = T::Error;
+
+    fn error(info: &ErrorInfo<'_>) -> Self::Error {
+        T::error(info)
+    }
+
+    fn check(&self, v: &mut Violations<Self::Error>) -> Checked {
+        (**self).check(v)
+    }
+}
+
+/// Stop is returned by a fail-fast [`Violations`] once a rule failed, for the
+/// generated checks to return early with `?`.
+#[derive(Clone, Copy, Debug, PartialEq, Eq)]
+pub struct Stop;
+
+/// The result of a check: `Err(Stop)` stops validation.
+pub type Checked = Result<(), Stop>;
+
+/// A single failed rule: the path of the offending field, the name of the rule
+/// and the error built for it.
+#[derive(Clone, Debug, PartialEq)]
+pub struct Violation<E> {
+    /// The path of the offending field, e.g. `address.lines[2]` or
+    /// `labels[env]`.
+    pub field: String,
+    /// The name of the failed rule, e.g. `string.min_len`.
+    pub rule: &'static str,
+    pub error: E,
+}
+
+impl<E: fmt::Display> fmt::Display for Violation<E> {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        if !self.field.is_empty() {
+            write!(f, "{}: ", self.field)?;
+        }
+        write!(f, "{}: {}", self.rule, self.error)
+    }
+}
+
+/// Violations gathers the violations found while validating a message. A
+/// fail-fast instance stops validation at the first failed rule, any other
+/// instance records it and carries on with the next rule.
+pub struct Violations<E> {
+    fail_fast: bool,
+    factory: fn(&ErrorInfo<'_>) -> E,
+    path: Vec<String>,
+    found: Vec<Violation<E>>,
+}
+
+impl<E> Violations<E> {
+    pub fn new(fail_fast: bool, factory: fn(&ErrorInfo<'_>) -> E) -> Self {
+        Violations {
+            fail_fast,
+            factory,
+            path: Vec::new(),
+            found: Vec::new(),
+        }
+    }
+
+    /// Reports a failed rule of field, an empty field standing for the current
+    /// path itself. value is the offending value, [`Param::None`] for unset
+    /// fields.
+    pub fn fail(&mut self, field: &str, rule: &'static str, value: Param<'_>, spec: ErrorSpec<'_>) -> Checked {
+        let path = self.path_to(field);
+        let error = (self.factory)(&ErrorInfo {
+            spec: &spec,
+            field: &path,
+            rule,
+            value: &value,
+        });
+        self.found.push(Violation { field: path, rule, error });
+        if self.fail_fast {
+            Err(Stop)
+        } else {
+            Ok(())
+        }
+    }
+
+    /// Runs check with field appended to the path violations are reported
+    /// under.
+    pub fn within(&mut self, field: &str, check: impl FnOnce(&mut Self) -> Checked) -> Checked {
+        let path = self.path_to(field);
+        self.path.push(path);
+        let result = check(self);
+        self.path.pop();
+        result
+    }
+
+    /// Validates an embedded message.
+    pub fn nested<M: Validate<Error = E> + ?Sized>(&mut self, field: &str, m: &M) -> Checked {
+        self.within(field, |v| m.check(v))
+    }
+
+    /// Runs check on every item of a repeated field, reporting violations under
+    /// `field[index]`.
+    pub fn each<T>(&mut self, field: &str, items: &[T], mut check: impl FnMut(&mut Self, &T) -> Checked) -> Checked {
+        for (i, item) in items.iter().enumerate() {
+            self.within(&format!("{}[{}]", field, i), |v| check(v, item))?;
+        }
+        Ok(())
+    }
+
+    /// Runs check on every entry of a map field, reporting violations under
+    /// `field[key]`.
+    pub fn entries<'m, K, V>(
+        &mut self,
+        field: &str,
+        entries: impl IntoIterator<Item = (&'m K, &'m V)>,
+        mut check: impl FnMut(&mut Self, &K, &V) -> Checked,
+    ) -> Checked
+    where
+        K: fmt::Display + 'm,
+        V: 'm,
+    {
+        for (key, val) in entries {
+            self.within(&format!("{}[{}]", field, key), |v| check(v, key, val))?;
+        }
+        Ok(())
+    }
+
+    /// Returns the violations recorded so far.
+    pub fn violations(&self) -> &[Violation<E>] {
+        &self.found
+    }
+
+    pub fn into_violations(self) -> Vec<Violation<E>> {
+        self.found
+    }
+
+    fn path_to(&self, field: &str) -> String {
+        let parent = self.path.last().map(String::as_str).unwrap_or("");
+        if field.is_empty() {
+            parent.to_string()
+        } else if parent.is_empty() {
+            field.to_string()
+        } else {
+            format!("{}.{}", parent, field)
+        }
+    }
+}
+
+/// Reports if the items of a repeated field are unique.
+pub fn unique<T: PartialEq>(items: &[T]) -> bool {
+    items
+        .iter()
+        .enumerate()
+        .all(|(i, item)| !items[..i].contains(item))
+}
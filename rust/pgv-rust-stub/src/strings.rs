@@ -0,0 +1,151 @@
+//! The well-known formats and measures of the string rules.
+
+use std::net::{Ipv4Addr, Ipv6Addr};
+use std::sync::OnceLock;
+
+use regex::Regex;
+
+/// Pattern is the regular expression of a pattern rule, compiled on first use
+/// so that the generated checks can keep it in a static.
+pub struct Pattern {
+    source: &'static str,
+    regex: OnceLock<Regex>,
+}
+
+impl Pattern {
+    pub const fn new(source: &'static str) -> Self {
+        Pattern {
+            source,
+            regex: OnceLock::new(),
+        }
+    }
+
+    /// Reports if s matches the pattern.
+    ///
+    /// # Panics
+    ///
+    /// Panics if the pattern is not a valid regular expression.
+    pub fn is_match(&self, s: &str) -> bool {
+        self.regex
+            .get_or_init(|| Regex::new(self.source).expect("invalid pattern rule"))
+            .is_match(s)
+    }
+
+    /// Reports if b is UTF-8 matching the pattern, like the pattern rule of
+    /// bytes.
+    pub fn is_match_bytes(&self, b: &[u8]) -> bool {
+        std::str::from_utf8(b).is_ok_and(|s| self.is_match(s))
+    }
+}
+
+/// Returns the number of code points of s, which is what the len rules of
+/// strings count.
+pub fn rune_count(s: &str) -> usize {
+    s.chars().count()
+}
+
+pub fn is_hostname(host: &str) -> bool {
+    if host.len() > 253 {
+        return false;
+    }
+    let s = host.strip_suffix('.').unwrap_or(host);
+    s.split('.').all(|part| {
+        let b = part.as_bytes();
+        !b.is_empty()
+            && b.len() <= 63
+            && b[0] != b'-'
+            && b[b.len() - 1] != b'-'
+            && b.iter().all(|c| c.is_ascii_alphanumeric() || *c == b'-')
+    })
+}
+
+/// Validates an RFC 5322 address, optionally with a display name, e.g.
+/// `Jane <jane@example.com>`.
+pub fn is_email(addr: &str) -> bool {
+    let s = match (addr.find('<'), addr.strip_suffix('>')) {
+        (Some(open), Some(rest)) if !rest[open + 1..].contains('>') => &rest[open + 1..],
+        _ => addr.trim(),
+    };
+    if s.len() > 254 {
+        return false;
+    }
+    let Some(at) = s.rfind('@') else {
+        return false;
+    };
+    let (local, host) = (&s[..at], &s[at + 1..]);
+    local.len() <= 64
+        && local.split('.').all(|atom| {
+            !atom.is_empty() && atom.chars().all(|c| c.is_ascii_alphanumeric() || "!#$%&'*+/=?^_`{|}~-".contains(c))
+        })
+        && is_hostname(host)
+}
+
+pub fn is_ipv4(s: &str) -> bool {
+    s.parse::<Ipv4Addr>().is_ok()
+}
+
+pub fn is_ipv6(s: &str) -> bool {
+    s.parse::<Ipv6Addr>().is_ok()
+}
+
+pub fn is_ip(s: &str) -> bool {
+    is_ipv4(s) || is_ipv6(s)
+}
+
+/// Validates either an IP address or a hostname.
+pub fn is_address(s: &str) -> bool {
+    is_ip(s) || is_hostname(s)
+}
+
+/// Validates an absolute URI as defined by RFC 3986.
+pub fn is_uri(s: &str) -> bool {
+    let scheme = s.split(':').next().unwrap_or("");
+    s.contains(':')
+        && scheme.starts_with(|c: char| c.is_ascii_alphabetic())
+        && scheme.chars().all(|c| c.is_ascii_alphanumeric() || "+.-".contains(c))
+        && is_uri_ref(s)
+}
+
+/// Validates an absolute or relative URI reference as defined by RFC 3986.
+pub fn is_uri_ref(s: &str) -> bool {
+    let hex = |c: Option<char>| c.is_some_and(|c| c.is_ascii_hexdigit());
+    let mut chars = s.chars();
+    while let Some(c) = chars.next() {
+        if c == '%' {
+            if !hex(chars.next()) || !hex(chars.next()) {
+                return false;
+            }
+        } else if c.is_whitespace() {
+            return false;
+        }
+    }
+    true
+}
+
+pub fn is_uuid(s: &str) -> bool {
+    let b = s.as_bytes();
+    b.len() == 36
+        && b.iter().enumerate().all(|(i, c)| match i {
+            8 | 13 | 18 | 23 => *c == b'-',
+            _ => c.is_ascii_hexdigit(),
+        })
+}
+
+/// Validates an HTTP header name as defined by RFC 7230, or with strict unset,
+/// only disallows `\r\n\0`.
+pub fn is_http_header_name(s: &str, strict: bool) -> bool {
+    if !strict {
+        return !s.is_empty() && !s.contains(['\0', '\n', '\r']);
+    }
+    let name = s.strip_prefix(':').unwrap_or(s);
+    !name.is_empty() && name.chars().all(|c| c.is_ascii_alphanumeric() || "!#$%&'*+-.^_|~`".contains(c))
+}
+
+/// Validates an HTTP header value as defined by RFC 7230, or with strict unset,
+/// only disallows `\r\n\0`.
+pub fn is_http_header_value(s: &str, strict: bool) -> bool {
+    if !strict {
+        return !s.contains(['\0', '\n', '\r']);
+    }
+    !s.chars().any(|c| (c < ' ' && c != '\t') || c == '\x7f')
+}
@@ -0,0 +1,45 @@
+//! Measures google.protobuf.Duration and google.protobuf.Timestamp in
+//! nanoseconds. They are i128s, which unlike i64s hold the whole range of
+//! both.
+
+use std::time::{SystemTime, UNIX_EPOCH};
+
+use prost_types::{Duration, Timestamp};
+
+const NANOS_PER_SECOND: i128 = 1_000_000_000;
+
+pub fn duration_nanos(d: &Duration) -> i128 {
+    i128::from(d.seconds) * NANOS_PER_SECOND + i128::from(d.nanos)
+}
+
+/// Returns the time since the epoch of ts.
+pub fn timestamp_nanos(ts: &Timestamp) -> i128 {
+    i128::from(ts.seconds) * NANOS_PER_SECOND + i128::from(ts.nanos)
+}
+
+pub fn now_nanos() -> i128 {
+    match SystemTime::now().duration_since(UNIX_EPOCH) {
+        Ok(since) => since.as_nanos() as i128,
+        Err(before) => -(before.duration().as_nanos() as i128),
+    }
+}
+
+/// Reports if d is within the range google.protobuf.Duration documents.
+pub fn is_valid_duration(d: &Duration) -> bool {
+    const MAX_SECONDS: i64 = 315_576_000_000;
+    if d.seconds < -MAX_SECONDS || d.seconds > MAX_SECONDS || d.nanos <= -1_000_000_000 || d.nanos >= 1_000_000_000 {
+        return false;
+    }
+    !((d.seconds > 0 && d.nanos < 0) || (d.seconds < 0 && d.nanos > 0))
+}
+
+/// Reports if ts is within the range google.protobuf.Timestamp documents,
+/// years 1 to 9999.
+pub fn is_valid_timestamp(ts: &Timestamp) -> bool {
+    ts.seconds >= -62_135_596_800 && ts.seconds <= 253_402_300_799 && ts.nanos >= 0 && ts.nanos < 1_000_000_000
+}
+
+/// Reports if ts is at most within nanoseconds away from now.
+pub fn is_within_now(ts: &Timestamp, within: i128) -> bool {
+    (timestamp_nanos(ts) - now_nanos()).abs() <= within
+}
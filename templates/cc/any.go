@@ -0,0 +1,41 @@
+package cc
+
+const anyConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const std::unordered_set<std::string> {{ constantName $ctx $index "InLookup" }} = {
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.NotIn }}
+const std::unordered_set<std::string> {{ constantName $ctx $index "NotInLookup" }} = {
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+};
+{{- end }}
+{{- end }}
+`
+
+const anyTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if and $r.GetRequired (present $ctx) }}
+	if (!{{ present $ctx }}) {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- if or $r.In $r.NotIn }}
+	{{ with present $ctx }}if ({{ . }}) {{ end }}{
+		const auto& a = {{ accessor $ctx }};
+		{{- $else := "" }}
+		{{- if $r.In }}
+		{{ $else }}if ({{ constantName $ctx $index "InLookup" }}.count(a.type_url()) == 0) {
+			{{ fail $ctx "in" $r.GetError }}
+		{{- $else = "} else " }}
+		{{- end }}
+		{{- if $r.NotIn }}
+		{{ $else }}if ({{ constantName $ctx $index "NotInLookup" }}.count(a.type_url()) != 0) {
+			{{ fail $ctx "not_in" $r.GetError }}
+		{{- $else = "} else " }}
+		{{- end }}
+		}
+	}
+	{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,8 @@
+package cc
+
+const boolTpl = `{{ $r := .Rules -}}
+{{- if $r.Const }}
+	if ({{ accessor . }} != {{ $r.GetConst }}) {
+		{{ fail . "const" $r.GetError }}
+	}
+{{- end }}`
@@ -0,0 +1,97 @@
+package cc
+
+const bytesConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const std::unordered_set<std::string> {{ constantName $ctx $index "InLookup" }} = {
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.NotIn }}
+const std::unordered_set<std::string> {{ constantName $ctx $index "NotInLookup" }} = {
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.Pattern }}
+const re2::RE2 {{ constantName $ctx $index "Pattern" }}({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const bytesTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if ({{ $val }} != {{ bytesLit $r.GetConst }}) {
+		{{ fail $ctx "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if ({{ constantName $ctx $index "InLookup" }}.count({{ $val }}) == 0) {
+		{{ fail $ctx "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if ({{ constantName $ctx $index "NotInLookup" }}.count({{ $val }}) != 0) {
+		{{ fail $ctx "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Len }}
+	{{ $else }}if ({{ $val }}.size() != {{ $r.GetLen }}) {
+		{{ fail $ctx "len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	{{ $else }}if ({{ $val }}.size() < {{ $r.GetMinLen }}) {
+		{{ fail $ctx "min_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	{{ $else }}if ({{ $val }}.size() > {{ $r.GetMaxLen }}) {
+		{{ fail $ctx "max_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	{{ $else }}if (!re2::RE2::PartialMatch({{ $val }}, {{ constantName $ctx $index "Pattern" }})) {
+		{{ fail $ctx "pattern" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	{{ $else }}if (!pgv::HasPrefix({{ $val }}, {{ bytesLit $r.GetPrefix }})) {
+		{{ fail $ctx "prefix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	{{ $else }}if (!pgv::HasSuffix({{ $val }}, {{ bytesLit $r.GetSuffix }})) {
+		{{ fail $ctx "suffix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Contains }}
+	{{ $else }}if (!pgv::Contains({{ $val }}, {{ bytesLit $r.GetContains }})) {
+		{{ fail $ctx "contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	{{ $else }}if ({{ $val }}.size() != 4 && {{ $val }}.size() != 16) {
+		{{ fail $ctx "ip" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	{{ $else }}if ({{ $val }}.size() != 4) {
+		{{ fail $ctx "ipv4" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	{{ $else }}if ({{ $val }}.size() != 16) {
+		{{ fail $ctx "ipv6" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,46 @@
+package cc
+
+const durationConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const std::unordered_set<int64_t> {{ constantName $ctx $index "InLookup" }} = {
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.NotIn }}
+const std::unordered_set<int64_t> {{ constantName $ctx $index "NotInLookup" }} = {
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end -}}
+};
+{{- end }}
+{{- end }}
+`
+
+const durationTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if and $r.GetRequired (present $ctx) }}
+	if (!{{ present $ctx }}) {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+	{{ with present $ctx }}if ({{ . }}) {{ end }}{
+		const auto& d = {{ accessor $ctx }};
+		if (!pgv::IsValidDuration(d)) {
+			{{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+		} else if (pgv::Nanos(d) != {{ nanosLit $r.GetConst }}) {
+			{{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond "pgv::Nanos(d)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		} else if ({{ . }}) {
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.In }}
+		} else if ({{ constantName $ctx $index "InLookup" }}.count(pgv::Nanos(d)) == 0) {
+			{{ fail $ctx "in" $r.GetError }}
+		{{- end }}
+		{{- if $r.NotIn }}
+		} else if ({{ constantName $ctx $index "NotInLookup" }}.count(pgv::Nanos(d)) != 0) {
+			{{ fail $ctx "not_in" $r.GetError }}
+		{{- end }}
+		}
+	}
+{{ end -}}
+`
@@ -0,0 +1,41 @@
+package cc
+
+const enumConstTpl = `{{ $r := .Rules -}}
+{{- if $r.In }}
+const std::unordered_set<int> {{ constantName . 0 "InLookup" }} = {
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.NotIn }}
+const std::unordered_set<int> {{ constantName . 0 "NotInLookup" }} = {
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}}
+};
+{{- end }}
+`
+
+const enumTpl = `{{ $r := .Rules }}{{ $val := accessor . }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if ({{ $val }} != {{ $r.GetConst }}) {
+		{{ fail . "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetDefinedOnly }}
+	{{ $else }}if (!{{ enumValid . }}({{ $val }})) {
+		{{ fail . "defined_only" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if ({{ constantName . 0 "InLookup" }}.count({{ $val }}) == 0) {
+		{{ fail . "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if ({{ constantName . 0 "NotInLookup" }}.count({{ $val }}) != 0) {
+		{{ fail . "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+`
@@ -0,0 +1,41 @@
+package cc
+
+const headerFileTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+
+#pragma once
+
+#include <string>
+#include <vector>
+
+#include "validate/validate.h"
+#include "{{ pbHeader . }}"
+{{- with errorFactoryInclude }}
+#include "{{ . }}"
+{{- end }}
+
+{{ with namespace . }}namespace {{ . }} {{ "{" }}{{ end }}
+{{ range .AllMessages }}
+	{{ template "decl" . }}
+{{ end }}
+{{ with namespace . }}}  // namespace {{ . }}{{ end }}
+`
+
+const moduleFileTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+
+#include "{{ header . }}"
+
+#include <cstdint>
+#include <limits>
+#include <string>
+#include <unordered_set>
+
+#include "re2/re2.h"
+
+{{ with namespace . }}namespace {{ . }} {{ "{" }}{{ end }}
+{{ range .AllMessages }}
+	{{ template "msg" . }}
+{{ end }}
+{{ with namespace . }}}  // namespace {{ . }}{{ end }}
+`
@@ -0,0 +1,51 @@
+package cc
+
+const mapConstTpl = `{{ renderConstants (.Key "" "Key") }}
+{{- renderConstants (.Elem "" "Value") -}}
+`
+
+const mapTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.MinPairs }}
+	{{ $else }}if ({{ $val }}.size() < {{ $r.GetMinPairs }}) {
+		{{ fail $ctx "min_pairs" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxPairs }}
+	{{ $else }}if ({{ $val }}.size() > {{ $r.GetMaxPairs }}) {
+		{{ fail $ctx "max_pairs" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+	{{- if $r.GetNoSparse }}
+	// no_sparse always holds: the values of C++ maps cannot be null
+	{{- end }}
+	{{- if or $r.GetKeys $r.GetValues }}
+	for (const auto& entry : {{ $val }}) {
+		pgv::Violations::Scope scope(v, {{ lit (fieldPath $ctx) }}, entry.first);
+		{{- if $r.GetKeys }}
+		const auto& key = entry.first;
+		{{ render ($ctx.KeyWithErrIndex "key" "Key" $index) }}
+		{{- end }}
+		{{- if $r.GetValues }}
+		const auto& val = entry.second;
+		{{ render ($ctx.ElemWithErrIndex "val" "Value" $index) }}
+		{{- end }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasValues .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	for (const auto& entry : {{ $val }}) {
+		pgv::Violations::Scope scope(v, {{ lit (fieldPath .) }}, entry.first);
+		if (!v->CheckMessage("", entry.second)) return false;
+	}
+{{- end }}
+`
@@ -0,0 +1,16 @@
+package cc
+
+const messageTpl = `{{ $f := .Field }}{{ $r := .Rules }}
+	{{- if .MessageRules.GetSkip }}
+	// skipping validation for {{ $f.Name }}
+	{{- else -}}
+	{{- if and $r.GetRequired (present .) }}
+	if (!{{ present . }}) {
+		{{ fail . "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- if validated (embedded .) }}
+	if ({{ with present . }}{{ . }} && {{ end }}!v->CheckMessage({{ lit (fieldPath .) }}, {{ accessor . }})) return false;
+	{{- end }}
+	{{- end -}}
+`
@@ -0,0 +1,77 @@
+package cc
+
+const declTpl = `
+{{ if not (ignored .) -}}
+// Check reports the violations of the rules of {{ .Name }} to v. It returns
+// false if validation stopped at a violation.
+bool Check(const {{ msgTyp . }}& m, pgv::Violations* v);
+
+// Validate checks {{ .Name }} against the rules defined in the proto
+// definition for this message, storing the error of the first violation in err.
+inline bool Validate(const {{ msgTyp . }}& m, pgv::ValidationMsg* err) {
+	return pgv::Validate<{{ msgTyp . }}>(Check, m, err, {{ errorFactory }});
+}
+
+// ValidateAll checks {{ .Name }} against the rules defined in the proto
+// definition for this message, storing every violation in violations.
+inline bool ValidateAll(const {{ msgTyp . }}& m, std::vector<pgv::Violation>* violations) {
+	return pgv::ValidateAll<{{ msgTyp . }}>(Check, m, violations, {{ errorFactory }});
+}
+{{- end -}}
+`
+
+const msgConstTpl = `{{ $ctx := . }}{{ range validatedFields . -}}
+	{{ renderConstants (context $ctx .) }}
+{{ end -}}
+{{ template "oneOfConst" . }}`
+
+const msgTpl = `
+{{ if not (ignored .) -}}
+{{ $ctx := . -}}
+{{ with msgConstants . -}}
+namespace {
+
+{{ . }}
+
+}  // namespace
+{{ end }}
+bool Check(const {{ msgTyp . }}& m, pgv::Violations* v) {
+	{{- if disabled . }}
+	// validation is disabled for {{ .Name }}
+	(void)m;
+	(void)v;
+	{{- else }}
+	{{- range validatedFields . }}
+	{{ template "field" (context $ctx .) }}
+	{{- end }}
+	{{- template "oneOf" . }}
+	{{- end }}
+	return true;
+}
+
+static const pgv::Validator<{{ msgTyp . }}> {{ validatorName . }}(Check);
+{{- end -}}
+`
+
+// fieldTpl gates the rules of a proto3 optional scalar field on it being set,
+// and reports its required rule otherwise.
+const fieldTpl = `{{ with hasCond . -}}
+{{ $cond := . -}}
+{{ if constrained $.Rules }}
+	if ({{ $cond }}) {
+		{{ render $ }}
+	}
+	{{- with failRequired $ }} else {
+		{{ . }}
+	}
+	{{- end }}
+{{- else -}}
+	{{ with failRequired $ }}
+	if (!{{ $cond }}) {
+		{{ . }}
+	}
+	{{- end }}
+{{- end }}
+{{- else -}}
+	{{ render . }}
+{{- end }}`
@@ -0,0 +1,4 @@
+package cc
+
+const noneTpl = `// no validation rules for {{ .Field.Name }}
+`
@@ -0,0 +1,49 @@
+package cc
+
+const numConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const std::unordered_set<{{ setType $ctx }}> {{ constantName $ctx $index "InLookup" }} = {
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ numLit $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.NotIn }}
+const std::unordered_set<{{ setType $ctx }}> {{ constantName $ctx $index "NotInLookup" }} = {
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ numLit $v }}{{ end -}}
+};
+{{- end }}
+{{- end }}
+`
+
+const numTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if ({{ $val }} != {{ numLit $r.GetConst }}) {
+		{{ fail $ctx "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- with rangeCond $val $r.Lt $r.Lte $r.Gt $r.Gte }}
+	{{ $else }}if ({{ . }}) {
+		{{ fail $ctx (boundsRule $r) $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if ({{ constantName $ctx $index "InLookup" }}.count({{ $val }}) == 0) {
+		{{ fail $ctx "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if ({{ constantName $ctx $index "NotInLookup" }}.count({{ $val }}) != 0) {
+		{{ fail $ctx "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
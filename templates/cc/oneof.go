@@ -0,0 +1,22 @@
+package cc
+
+const oneOfConstTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+{{- range .Fields }}{{ renderConstants (context $msg .) }}{{ end -}}
+{{- end -}}
+`
+
+const oneOfTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+	switch (m.{{ oneofName . }}_case()) {
+	{{- range .Fields }}
+	case {{ oneofCase . }}:
+		{{ render (context $msg .) }}
+		break;
+	{{- end }}
+	default:
+	{{- if (oneofRule .).GetRequired }}
+		{{ failOneOf $msg . }}
+	{{- end }}
+		break;
+	}
+{{- end -}}
+`
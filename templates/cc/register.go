@@ -0,0 +1,860 @@
+package cc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+const (
+	// ErrorFactoryParam names a function converting to pgv::ErrorFactory, used
+	// by the generated Validate and ValidateAll to build the errors of failed
+	// rules instead of pgv::DefaultErrorFactory.
+	ErrorFactoryParam = "cc_error_factory"
+	// ErrorFactoryIncludeParam is the header declaring the function named by
+	// cc_error_factory.
+	ErrorFactoryIncludeParam = "cc_error_factory_include"
+)
+
+// RegisterHeader registers the template of the header declaring the
+// validators of a proto file.
+func RegisterHeader(tpl *template.Template, params pgs.Parameters) {
+	fns := newFuncs(params)
+	tpl.Funcs(fns.funcMap(tpl))
+
+	template.Must(tpl.Parse(headerFileTpl))
+	template.Must(tpl.New("decl").Parse(declTpl))
+}
+
+// RegisterModule registers the templates of the module defining the
+// validators of a proto file.
+func RegisterModule(tpl *template.Template, params pgs.Parameters) {
+	fns := newFuncs(params)
+	tpl.Funcs(fns.funcMap(tpl))
+
+	template.Must(tpl.Parse(moduleFileTpl))
+	template.Must(tpl.New("msg").Parse(msgTpl))
+	template.Must(tpl.New("msgConst").Parse(msgConstTpl))
+	template.Must(tpl.New("field").Parse(fieldTpl))
+
+	template.Must(tpl.New("none").Parse(noneTpl))
+
+	template.Must(tpl.New("float").Parse(numTpl))
+	template.Must(tpl.New("floatConst").Parse(numConstTpl))
+	template.Must(tpl.New("double").Parse(numTpl))
+	template.Must(tpl.New("doubleConst").Parse(numConstTpl))
+	template.Must(tpl.New("int32").Parse(numTpl))
+	template.Must(tpl.New("int32Const").Parse(numConstTpl))
+	template.Must(tpl.New("int64").Parse(numTpl))
+	template.Must(tpl.New("int64Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint32").Parse(numTpl))
+	template.Must(tpl.New("uint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint64").Parse(numTpl))
+	template.Must(tpl.New("uint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint32").Parse(numTpl))
+	template.Must(tpl.New("sint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint64").Parse(numTpl))
+	template.Must(tpl.New("sint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed32").Parse(numTpl))
+	template.Must(tpl.New("fixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed64").Parse(numTpl))
+	template.Must(tpl.New("fixed64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed32").Parse(numTpl))
+	template.Must(tpl.New("sfixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed64").Parse(numTpl))
+	template.Must(tpl.New("sfixed64Const").Parse(numConstTpl))
+
+	template.Must(tpl.New("bool").Parse(boolTpl))
+	template.Must(tpl.New("string").Parse(stringTpl))
+	template.Must(tpl.New("stringConst").Parse(stringConstTpl))
+	template.Must(tpl.New("bytes").Parse(bytesTpl))
+	template.Must(tpl.New("bytesConst").Parse(bytesConstTpl))
+
+	template.Must(tpl.New("any").Parse(anyTpl))
+	template.Must(tpl.New("anyConst").Parse(anyConstTpl))
+	template.Must(tpl.New("enum").Parse(enumTpl))
+	template.Must(tpl.New("enumConst").Parse(enumConstTpl))
+	template.Must(tpl.New("message").Parse(messageTpl))
+	template.Must(tpl.New("repeated").Parse(repeatedTpl))
+	template.Must(tpl.New("repeatedConst").Parse(repeatedConstTpl))
+	template.Must(tpl.New("map").Parse(mapTpl))
+	template.Must(tpl.New("mapConst").Parse(mapConstTpl))
+	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
+	template.Must(tpl.New("oneOfConst").Parse(oneOfConstTpl))
+
+	template.Must(tpl.New("timestamp").Parse(timestampTpl))
+	template.Must(tpl.New("duration").Parse(durationTpl))
+	template.Must(tpl.New("durationConst").Parse(durationConstTpl))
+	template.Must(tpl.New("wrapper").Parse(wrapperTpl))
+	template.Must(tpl.New("wrapperConst").Parse(wrapperConstTpl))
+}
+
+// ccFuncs renders validators against the message classes protoc generates for
+// C++.
+type ccFuncs struct {
+	errorFactory        string
+	errorFactoryInclude string
+}
+
+func newFuncs(params pgs.Parameters) ccFuncs {
+	return ccFuncs{
+		errorFactory:        params.StrDefault(ErrorFactoryParam, "pgv::DefaultErrorFactory"),
+		errorFactoryInclude: params.Str(ErrorFactoryIncludeParam),
+	}
+}
+
+// FuncMap returns the functions of the templates of the header and the
+// module, which the templates of ccnop share.
+func FuncMap(tpl *template.Template, params pgs.Parameters) template.FuncMap {
+	return newFuncs(params).funcMap(tpl)
+}
+
+func (fns ccFuncs) funcMap(tpl *template.Template) template.FuncMap {
+	return template.FuncMap{
+		"accessor":            fns.accessor,
+		"boundsRule":          shared.BoundsRule,
+		"bytesLit":            fns.bytesLit,
+		"constantName":        fns.constantName,
+		"constrained":         shared.HasConstraints,
+		"embedded":            fns.embedded,
+		"emptyCond":           fns.emptyCond,
+		"enumValid":           fns.enumValid,
+		"errorFactory":        func() string { return fns.errorFactory },
+		"errorFactoryInclude": func() string { return fns.errorFactoryInclude },
+		"fail":                fns.fail,
+		"failOneOf":           fns.failOneOf,
+		"failRequired":        fns.failRequired,
+		"fieldPath":           fns.fieldPath,
+		"hasCond":             fns.hasCond,
+		"hasItems":            hasItems,
+		"hasValues":           hasValues,
+		"header":              headerPath,
+		"lit":                 fns.lit,
+		"msgTyp":              fns.msgTyp,
+		"msgConstants":        fns.msgConstants(tpl),
+		"namespace":           fns.namespace,
+		"nanosLit":            fns.nanosLit,
+		"numLit":              fns.numLit,
+		"oneofCase":           fns.oneofCase,
+		"oneofName":           fns.oneofName,
+		"pbHeader":            pbHeaderPath,
+		"present":             fns.present,
+		"rangeCond":           fns.rangeCond,
+		"renderConstants":     fns.renderConstants(tpl),
+		"setType":             fns.setType,
+		"unwrap":              fns.unwrap,
+		"validated":           fns.validated,
+		"validatedFields":     fns.validatedFields,
+		"validatorName":       fns.validatorName,
+	}
+}
+
+// CcFilePath places the header and the module next to the ones protoc
+// generates for the proto file, as <file>.pb.validate.h and .cc.
+func CcFilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	out := f.InputPath().SetExt(".pb.validate." + tpl.Name())
+	return &out
+}
+
+func headerPath(f pgs.File) string {
+	return f.InputPath().SetExt(".pb.validate.h").String()
+}
+
+func pbHeaderPath(f pgs.File) string {
+	return f.InputPath().SetExt(".pb.h").String()
+}
+
+// CodeFormat re-indents the rendered validators by their braces with two
+// spaces, leaving the bodies of namespaces unindented like the Google C++ style
+// does. Blank lines are collapsed, and dropped within nested blocks and at the
+// start and end of functions.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(src), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	var buf bytes.Buffer
+	// blocks records for each open brace whether it opens a namespace, and if
+	// a case label of the switch it opens has been seen.
+	type block struct{ namespace, inCase bool }
+	var blocks []block
+	depth := func() int {
+		n := 0
+		for _, b := range blocks {
+			if !b.namespace {
+				n++
+			}
+		}
+		return n
+	}
+	prev := ""
+	for i, line := range lines {
+		if line == "" {
+			next := ""
+			for _, l := range lines[i+1:] {
+				if l != "" {
+					next = l
+					break
+				}
+			}
+			edge := strings.HasSuffix(prev, "{") || strings.HasPrefix(next, "}")
+			if prev == "" || next == "" || depth() > 1 || (depth() == 1 && edge) {
+				continue
+			}
+			buf.WriteByte('\n')
+			prev = ""
+			continue
+		}
+
+		ops := braces(line)
+		for len(ops) > 0 && ops[0] == '}' {
+			if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			ops = ops[1:]
+		}
+
+		label := strings.HasPrefix(line, "case ") || strings.HasPrefix(line, "default:")
+		if label && len(blocks) > 0 {
+			blocks[len(blocks)-1].inCase = true
+		}
+		indent := 0
+		if !strings.HasPrefix(line, "#") {
+			for j, b := range blocks {
+				if b.namespace {
+					continue
+				}
+				indent++
+				// statements of a case are indented below its label
+				if b.inCase && !(label && j == len(blocks)-1) {
+					indent++
+				}
+			}
+		}
+		buf.WriteString(strings.Repeat("  ", indent))
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+
+		for _, op := range ops {
+			if op == '{' {
+				blocks = append(blocks, block{namespace: strings.HasPrefix(line, "namespace")})
+			} else if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+		}
+		prev = line
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// braces returns the braces of a line outside of comments and string and
+// character literals, in order.
+func braces(line string) []rune {
+	var out []rune
+	var quote rune
+	escaped := false
+	prev := rune(0)
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '/' && prev == '/':
+			return out
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '{' || r == '}':
+			out = append(out, r)
+		}
+		prev = r
+	}
+	return out
+}
+
+// typeName returns the name protoc gives the C++ class of a message or enum:
+// nested types are joined to their parents with underscores.
+func typeName(e pgs.Entity) string {
+	name := strings.TrimPrefix(e.FullyQualifiedName(), ".")
+	if pkg := e.Package().ProtoName().String(); pkg != "" {
+		name = strings.TrimPrefix(name, pkg+".")
+	}
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// namespace returns the C++ namespace of the proto package of e, empty for
+// the global one.
+func (fns ccFuncs) namespace(e pgs.Entity) string {
+	return strings.ReplaceAll(e.Package().ProtoName().String(), ".", "::")
+}
+
+// qualified returns the fully qualified C++ name of a message or enum.
+func (fns ccFuncs) qualified(e pgs.Entity) string {
+	if ns := fns.namespace(e); ns != "" {
+		return "::" + ns + "::" + typeName(e)
+	}
+	return "::" + typeName(e)
+}
+
+func (fns ccFuncs) msgTyp(msg pgs.Message) string {
+	return fns.qualified(msg)
+}
+
+// validatorName returns the name of the pgv::Validator registering the Check
+// of msg.
+func (fns ccFuncs) validatorName(msg pgs.Message) string {
+	return "validator_" + strings.ReplaceAll(strings.TrimPrefix(msg.FullyQualifiedName(), "."), ".", "_")
+}
+
+// enumValid returns the function protoc generates to check that a value of the
+// enum of ctx is defined.
+func (fns ccFuncs) enumValid(ctx shared.RuleContext) string {
+	t := ctx.Field.Type()
+	if t.IsRepeated() || t.IsMap() {
+		return fns.qualified(t.Element().Enum()) + "_IsValid"
+	}
+	return fns.qualified(t.Enum()) + "_IsValid"
+}
+
+// embedded returns the message type of ctx, resolving repeated items and map
+// values.
+func (fns ccFuncs) embedded(ctx shared.RuleContext) pgs.Message {
+	if t := ctx.Field.Type(); t.IsRepeated() || t.IsMap() {
+		return t.Element().Embed()
+	}
+	return ctx.Field.Type().Embed()
+}
+
+// validated reports if a Check is registered for msg: well-known types and
+// ignored messages have none. Messages of files generated without validators
+// are looked up all the same, and pass.
+func (fns ccFuncs) validated(msg pgs.Message) bool {
+	if msg == nil || msg.IsWellKnown() {
+		return false
+	}
+	ignored, err := shared.Ignored(msg)
+	return err == nil && !ignored
+}
+
+// fieldName returns the name protoc gives the accessors of f.
+func fieldName(f pgs.Field) string {
+	return strings.ToLower(f.Name().String())
+}
+
+func (fns ccFuncs) accessor(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride
+	}
+	return fmt.Sprintf("m.%s()", fieldName(ctx.Field))
+}
+
+// present renders the condition under which the message field of ctx is set,
+// empty for repeated items and map values which always are.
+func (fns ccFuncs) present(ctx shared.RuleContext) string {
+	if fns.fieldPath(ctx) == "" {
+		return ""
+	}
+	return fmt.Sprintf("m.has_%s()", fieldName(ctx.Field))
+}
+
+// validatedFields returns the fields of msg outside of a real oneof, proto3
+// optional fields included.
+func (fns ccFuncs) validatedFields(msg pgs.Message) (out []pgs.Field) {
+	for _, f := range msg.Fields() {
+		if !f.InRealOneOf() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// hasCond renders the condition under which the proto3 optional scalar field
+// of ctx is set, empty for other fields.
+func (fns ccFuncs) hasCond(ctx shared.RuleContext) string {
+	f := ctx.Field
+	if ctx.Typ == "none" || !f.InOneOf() || f.InRealOneOf() || f.Type().IsEmbed() {
+		return ""
+	}
+	return fmt.Sprintf("m.has_%s()", fieldName(f))
+}
+
+// failRequired renders the report of the required rule of the proto3
+// optional scalar field of ctx, empty if it has none.
+func (fns ccFuncs) failRequired(ctx shared.RuleContext) (string, error) {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil || !shared.ScalarRequired(&rules) {
+		return "", err
+	}
+	return fns.fail(ctx, "required", shared.RequiredError(ctx.Rules))
+}
+
+// oneofName returns the name protoc gives the accessors of oo.
+func (fns ccFuncs) oneofName(oo pgs.OneOf) string {
+	return strings.ToLower(oo.Name().String())
+}
+
+// oneofCase returns the constant of the case of the oneof field f.
+func (fns ccFuncs) oneofCase(f pgs.Field) string {
+	return fmt.Sprintf("%s::k%s", fns.msgTyp(f.Message()), camelCase(f.Name().String()))
+}
+
+// camelCase converts a field name the way protoc names the cases of oneofs.
+func camelCase(name string) string {
+	var b strings.Builder
+	capNext := true
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z':
+			if capNext {
+				r -= 'a' - 'A'
+			}
+			b.WriteRune(r)
+			capNext = false
+		case r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+			capNext = false
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+			capNext = true
+		default:
+			capNext = true
+		}
+	}
+	return b.String()
+}
+
+// fieldPath returns the name violations of ctx are reported under, empty for
+// repeated items and map entries which are reported under their index or key.
+func (fns ccFuncs) fieldPath(ctx shared.RuleContext) string {
+	switch strings.SplitN(ctx.AccessorOverride, ".", 2)[0] {
+	case "item", "key", "val":
+		return ""
+	}
+	return ctx.Field.Name().String()
+}
+
+// constantName returns the name of a constant of the module backing the rule
+// at index of ctx.
+func (fns ccFuncs) constantName(ctx shared.RuleContext, index int, rule string) string {
+	return fmt.Sprintf("k%s_%s%s_%d_%s", typeName(ctx.Field.Message()), ctx.Field.Name(), ctx.Index, index, rule)
+}
+
+// setType returns the element type of the lookup sets of the numbers of ctx.
+func (fns ccFuncs) setType(ctx shared.RuleContext) string {
+	switch ctx.Typ {
+	case "float":
+		return "float"
+	case "double":
+		return "double"
+	case "int64", "sint64", "sfixed64":
+		return "int64_t"
+	case "uint64", "fixed64":
+		return "uint64_t"
+	case "uint32", "fixed32":
+		return "uint32_t"
+	default:
+		return "int32_t"
+	}
+}
+
+// emptyCond renders the condition under which the value of ctx is set, for
+// ignore_empty.
+func (fns ccFuncs) emptyCond(ctx shared.RuleContext) string {
+	value := fns.accessor(ctx)
+	switch ctx.Typ {
+	case "string", "bytes", "repeated", "map":
+		return "!" + value + ".empty()"
+	default:
+		return value + " != 0"
+	}
+}
+
+// lit renders s as a C++ string literal, or as a std::string if it holds NULs
+// which would end the literal.
+func (fns ccFuncs) lit(s string) string {
+	if strings.IndexByte(s, 0) >= 0 {
+		return fns.bytesLit([]byte(s))
+	}
+	return quote(s)
+}
+
+// bytesLit renders b as a std::string, which unlike a literal may hold NULs.
+func (fns ccFuncs) bytesLit(b []byte) string {
+	return fmt.Sprintf("std::string(%s, %d)", quote(string(b)), len(b))
+}
+
+// quote renders s as a C++ string literal. Bytes other than printable ASCII
+// are escaped in octal, which unlike hex escapes ends after three digits.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		case c == '\t':
+			b.WriteString(`\t`)
+		case c == '?':
+			// avoid trigraphs
+			b.WriteString(`\?`)
+		case c < 0x20 || c >= 0x7F:
+			fmt.Fprintf(&b, `\%03o`, c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// numLit renders a number of a rule as a C++ literal of its type.
+func (fns ccFuncs) numLit(v interface{}) string {
+	switch n := v.(type) {
+	case float32:
+		return floatLit(float64(n), 32)
+	case float64:
+		return floatLit(n, 64)
+	case int32:
+		if n == math.MinInt32 {
+			return "std::numeric_limits<int32_t>::min()"
+		}
+		return strconv.FormatInt(int64(n), 10)
+	case int64:
+		if n == math.MinInt64 {
+			return "std::numeric_limits<int64_t>::min()"
+		}
+		return fmt.Sprintf("INT64_C(%d)", n)
+	case uint32:
+		return fmt.Sprintf("%dU", n)
+	case uint64:
+		return fmt.Sprintf("UINT64_C(%d)", n)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// floatLit renders v as a C++ literal of a float (bits 32) or double.
+func floatLit(v float64, bits int) string {
+	typ, suffix := "double", ""
+	if bits == 32 {
+		typ, suffix = "float", "F"
+	}
+	switch {
+	case math.IsNaN(v):
+		return fmt.Sprintf("std::numeric_limits<%s>::quiet_NaN()", typ)
+	case math.IsInf(v, 1):
+		return fmt.Sprintf("std::numeric_limits<%s>::infinity()", typ)
+	case math.IsInf(v, -1):
+		return fmt.Sprintf("-std::numeric_limits<%s>::infinity()", typ)
+	}
+	s := strconv.FormatFloat(v, 'g', -1, bits)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s + suffix
+}
+
+// nanosLit renders a duration, or a timestamp as the time since the epoch, in
+// nanoseconds.
+func (fns ccFuncs) nanosLit(v interface{}) string {
+	switch t := v.(type) {
+	case *durationpb.Duration:
+		return fns.numLit(t.AsDuration().Nanoseconds())
+	case *timestamppb.Timestamp:
+		return fns.numLit(t.GetSeconds()*1e9 + int64(t.GetNanos()))
+	}
+	return fmt.Sprint(v)
+}
+
+// rangeCond renders the condition under which value violates the lt/lte/gt/gte
+// bounds of a rule. Like the Java runtime, a lower bound above the upper bound
+// inverts the range into an exclusive one.
+func (fns ccFuncs) rangeCond(value string, lt, lte, gt, gte interface{}) (string, error) {
+	ltLit, ltVal, hasLt, err := fns.bound(lt)
+	if err != nil {
+		return "", err
+	}
+	lteLit, lteVal, hasLte, err := fns.bound(lte)
+	if err != nil {
+		return "", err
+	}
+	gtLit, gtVal, hasGt, err := fns.bound(gt)
+	if err != nil {
+		return "", err
+	}
+	gteLit, gteVal, hasGte, err := fns.bound(gte)
+	if err != nil {
+		return "", err
+	}
+
+	var upper, lower string
+	var upperVal, lowerVal float64
+	switch {
+	case hasLt:
+		upper, upperVal = fmt.Sprintf("%s >= %s", value, ltLit), ltVal
+	case hasLte:
+		upper, upperVal = fmt.Sprintf("%s > %s", value, lteLit), lteVal
+	}
+	switch {
+	case hasGt:
+		lower, lowerVal = fmt.Sprintf("%s <= %s", value, gtLit), gtVal
+	case hasGte:
+		lower, lowerVal = fmt.Sprintf("%s < %s", value, gteLit), gteVal
+	}
+
+	switch {
+	case upper == "":
+		return lower, nil
+	case lower == "":
+		return upper, nil
+	case lowerVal <= upperVal:
+		return fmt.Sprintf("%s || %s", lower, upper), nil
+	}
+
+	// exclusive range: the value must fall outside of [upper, lower]
+	inUpper := fmt.Sprintf("%s > %s", value, lteLit)
+	if hasLt {
+		inUpper = fmt.Sprintf("%s >= %s", value, ltLit)
+	}
+	inLower := fmt.Sprintf("%s < %s", value, gteLit)
+	if hasGt {
+		inLower = fmt.Sprintf("%s <= %s", value, gtLit)
+	}
+	return fmt.Sprintf("%s && %s", inUpper, inLower), nil
+}
+
+// bound resolves an optional rule bound into its C++ literal and a numeric
+// value used to order the bounds of a range.
+func (fns ccFuncs) bound(v interface{}) (lit string, val float64, ok bool, err error) {
+	switch b := v.(type) {
+	case *durationpb.Duration:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsDuration()), true, nil
+	case *timestamppb.Timestamp:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsTime().UnixNano()), true, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Ptr {
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	if rv.IsNil() {
+		return
+	}
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Int32, reflect.Int64:
+		val = float64(rv.Int())
+	case reflect.Uint32, reflect.Uint64:
+		val = float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		val = rv.Float()
+	default:
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	return fns.numLit(rv.Interface()), val, true, nil
+}
+
+// unwrap returns the context of the value of a wrapper.
+func (fns ccFuncs) unwrap(ctx shared.RuleContext) (shared.RuleContext, error) {
+	return ctx.Unwrap(fns.accessor(ctx) + ".value()")
+}
+
+func (fns ccFuncs) renderConstants(tpl *template.Template) func(ctx shared.RuleContext) (string, error) {
+	return func(ctx shared.RuleContext) (string, error) {
+		var b bytes.Buffer
+		var err error
+
+		if t := tpl.Lookup(ctx.Typ + "Const"); t != nil {
+			err = t.Execute(&b, ctx)
+		}
+
+		return b.String(), err
+	}
+}
+
+// msgConstants renders the constants backing the rules of msg, empty if there
+// are none.
+func (fns ccFuncs) msgConstants(tpl *template.Template) func(msg pgs.Message) (string, error) {
+	return func(msg pgs.Message) (string, error) {
+		var b bytes.Buffer
+		if err := tpl.ExecuteTemplate(&b, "msgConst", msg); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(b.String()), nil
+	}
+}
+
+// fail renders the report of the named rule of ctx failing with e, returning
+// from Check if the validation stops. Nested item, key and value rules without
+// their own Error fall back to the Error of the enclosing repeated or map rule
+// at ctx.ErrIndex.
+func (fns ccFuncs) fail(ctx shared.RuleContext, rule string, e *validate.Error) (string, error) {
+	if e == nil && ctx.AccessorOverride != "" {
+		e = parentError(ctx)
+	}
+	args, err := shared.FieldErrorArgs(ctx.Field, e)
+	if err != nil {
+		return "", err
+	}
+	// repeated and map rules report the size, which unlike the container a
+	// pgv::Param can hold
+	value := fns.accessor(ctx)
+	switch {
+	case rule == "required":
+		value = ""
+	case ctx.Typ == "repeated" || ctx.Typ == "map":
+		value += ".size()"
+	}
+	spec, err := fns.errorSpec(ctx.ErrBase, e, args, value)
+	if err != nil {
+		return "", err
+	}
+	typ := ctx.Typ
+	if typ == "wrapper" {
+		typ = "message"
+	}
+	if value == "" {
+		value = "pgv::Param()"
+	}
+	return fmt.Sprintf("if (!v->Fail(%s, %s, %s, %s)) return false;", fns.lit(fns.fieldPath(ctx)), fns.lit(typ+"."+rule), value, spec), nil
+}
+
+func (fns ccFuncs) failOneOf(msg pgs.Message, oo pgs.OneOf) (string, error) {
+	base, err := errorBase(msg)
+	if err != nil {
+		return "", err
+	}
+	rule, err := shared.OneOfRule(oo)
+	if err != nil {
+		return "", err
+	}
+	args, err := shared.ErrorArgs(oo.Name().String(), rule.ProtoReflect(), rule.GetError())
+	if err != nil {
+		return "", err
+	}
+	spec, err := fns.errorSpec(base, rule.GetError(), args, "")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("if (!v->Fail(%s, \"oneof.required\", pgv::Param(), %s)) return false;", fns.lit(oo.Name().String()), spec), nil
+}
+
+// errorSpec renders the pgv::ErrorSpec of e; value is the expression of the
+// validated value, if any.
+func (fns ccFuncs) errorSpec(base *validate.ErrorBase, e *validate.Error, args []shared.ErrorArg, value string) (string, error) {
+	pkg, class := resolveErrorTarget(base, e)
+
+	params := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg.Kind {
+		case shared.IntArg:
+			params = append(params, fns.numLit(arg.Int))
+		case shared.UintArg:
+			params = append(params, fns.numLit(arg.Uint))
+		case shared.FloatArg:
+			params = append(params, floatLit(arg.Float, 64))
+		case shared.BoolArg:
+			params = append(params, strconv.FormatBool(arg.Bool))
+		case shared.RefArg:
+			params = append(params, fmt.Sprintf("pgv::Ref{%s}", fns.lit(arg.Str)))
+		case shared.ValueArg:
+			if value == "" {
+				return "", fmt.Errorf("error %q: $value is not available here", e.GetMethod())
+			}
+			params = append(params, value)
+		default:
+			params = append(params, fns.lit(arg.Str))
+		}
+	}
+	return fmt.Sprintf("{%s, %s, %s, {%s}}", fns.lit(pkg), fns.lit(class), fns.lit(e.GetMethod()), strings.Join(params, ", ")), nil
+}
+
+func hasItems(rules *validate.RepeatedRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetItems() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasValues(rules *validate.MapRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetValues() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveErrorTarget(base *validate.ErrorBase, e *validate.Error) (pkg, class string) {
+	if base != nil {
+		pkg = base.GetPkg()
+		class = base.GetClass()
+	}
+	if len(e.GetPkg()) > 0 {
+		pkg = e.GetPkg()
+	}
+	if len(e.GetClass()) > 0 {
+		class = e.GetClass()
+	}
+	return
+}
+
+func errorBase(msg pgs.Message) (base *validate.ErrorBase, err error) {
+	_, err = msg.Extension(validate.E_ErrorBase, &base)
+	return
+}
+
+func parentError(ctx shared.RuleContext) *validate.Error {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	switch {
+	case rules.GetRepeated() != nil:
+		if rs := rules.GetRepeated().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	case rules.GetMap() != nil:
+		if rs := rules.GetMap().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package cc
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCamelCase(t *testing.T) {
+	tests := map[string]string{
+		"phone":        "Phone",
+		"phone_number": "PhoneNumber",
+		"field2x":      "Field2X",
+		"IPv6_addr":    "IPv6Addr",
+	}
+	for in, want := range tests {
+		if got := camelCase(in); got != want {
+			t.Errorf("camelCase(%q) = %q; want %q", in, got, want)
+		}
+	}
+}
+
+func TestLiterals(t *testing.T) {
+	var fns ccFuncs
+	tests := []struct {
+		got, want string
+	}{
+		{fns.lit(`a"b\c`), `"a\"b\\c"`},
+		{fns.lit("é??="), `"\303\251\?\?="`},
+		{fns.lit("a\x00b"), `std::string("a\000b", 3)`},
+		{fns.bytesLit([]byte{0xff, '1'}), `std::string("\3771", 2)`},
+		{fns.numLit(float32(1)), "1.0F"},
+		{fns.numLit(0.25), "0.25"},
+		{fns.numLit(math.Inf(-1)), "-std::numeric_limits<double>::infinity()"},
+		{fns.numLit(int32(-3)), "-3"},
+		{fns.numLit(int64(math.MinInt64)), "std::numeric_limits<int64_t>::min()"},
+		{fns.numLit(uint32(7)), "7U"},
+		{fns.numLit(uint64(math.MaxUint64)), "UINT64_C(18446744073709551615)"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %s; want %s", tt.got, tt.want)
+		}
+	}
+}
+
+func TestCodeFormat(t *testing.T) {
+	in := `#include "a.h"
+namespace acme {
+
+namespace {
+const re2::RE2 kPattern("{");
+}  // namespace
+
+bool Check(const M& m, pgv::Violations* v) {
+
+if (m.s() != "}") {
+
+
+if (!v->Fail("s", "string.const", m.s(), {"a", "B", "c", {}})) return false;
+}
+
+switch (m.o_case()) {
+case M::kX:
+// no validation rules for x
+break;
+default:
+break;
+}
+
+return true;
+}
+}  // namespace acme
+`
+	want := `#include "a.h"
+namespace acme {
+
+namespace {
+const re2::RE2 kPattern("{");
+}  // namespace
+
+bool Check(const M& m, pgv::Violations* v) {
+  if (m.s() != "}") {
+    if (!v->Fail("s", "string.const", m.s(), {"a", "B", "c", {}})) return false;
+  }
+
+  switch (m.o_case()) {
+    case M::kX:
+      // no validation rules for x
+      break;
+    default:
+      break;
+  }
+
+  return true;
+}
+}  // namespace acme
+`
+	var out bytes.Buffer
+	if err := CodeFormat(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("CodeFormat:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
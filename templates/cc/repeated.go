@@ -0,0 +1,45 @@
+package cc
+
+const repeatedConstTpl = `{{ renderConstants (.Elem "" "") }}`
+
+const repeatedTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.MinItems }}
+	{{ $else }}if ({{ $val }}.size() < {{ $r.GetMinItems }}) {
+		{{ fail $ctx "min_items" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxItems }}
+	{{ $else }}if ({{ $val }}.size() > {{ $r.GetMaxItems }}) {
+		{{ fail $ctx "max_items" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUnique }}
+	{{ $else }}if (!pgv::Unique({{ $val }})) {
+		{{ fail $ctx "unique" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+	{{- if $r.GetItems }}
+	for (int i = 0; i < {{ $val }}.size(); i++) {
+		const auto& item = {{ $val }}[i];
+		pgv::Violations::Scope scope(v, {{ lit (fieldPath $ctx) }}, i);
+		{{ render ($ctx.ElemWithErrIndex "item" "" $index) }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasItems .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	for (int i = 0; i < {{ $val }}.size(); i++) {
+		pgv::Violations::Scope scope(v, {{ lit (fieldPath .) }}, i);
+		if (!v->CheckMessage("", {{ $val }}[i])) return false;
+	}
+{{- end }}
+`
@@ -0,0 +1,156 @@
+package cc
+
+const stringConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const std::unordered_set<std::string> {{ constantName $ctx $index "InLookup" }} = {
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.NotIn }}
+const std::unordered_set<std::string> {{ constantName $ctx $index "NotInLookup" }} = {
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+};
+{{- end }}
+{{- if $r.Pattern }}
+const re2::RE2 {{ constantName $ctx $index "Pattern" }}({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const stringTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if ({{ $val }} != {{ lit $r.GetConst }}) {
+		{{ fail $ctx "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if ({{ constantName $ctx $index "InLookup" }}.count({{ $val }}) == 0) {
+		{{ fail $ctx "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if ({{ constantName $ctx $index "NotInLookup" }}.count({{ $val }}) != 0) {
+		{{ fail $ctx "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Len }}
+	{{ $else }}if (pgv::Utf8Len({{ $val }}) != {{ $r.GetLen }}) {
+		{{ fail $ctx "len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	{{ $else }}if (pgv::Utf8Len({{ $val }}) < {{ $r.GetMinLen }}) {
+		{{ fail $ctx "min_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	{{ $else }}if (pgv::Utf8Len({{ $val }}) > {{ $r.GetMaxLen }}) {
+		{{ fail $ctx "max_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.LenBytes }}
+	{{ $else }}if ({{ $val }}.size() != {{ $r.GetLenBytes }}) {
+		{{ fail $ctx "len_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinBytes }}
+	{{ $else }}if ({{ $val }}.size() < {{ $r.GetMinBytes }}) {
+		{{ fail $ctx "min_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxBytes }}
+	{{ $else }}if ({{ $val }}.size() > {{ $r.GetMaxBytes }}) {
+		{{ fail $ctx "max_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	{{ $else }}if (!re2::RE2::PartialMatch({{ $val }}, {{ constantName $ctx $index "Pattern" }})) {
+		{{ fail $ctx "pattern" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	{{ $else }}if (!pgv::HasPrefix({{ $val }}, {{ lit $r.GetPrefix }})) {
+		{{ fail $ctx "prefix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	{{ $else }}if (!pgv::HasSuffix({{ $val }}, {{ lit $r.GetSuffix }})) {
+		{{ fail $ctx "suffix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Contains }}
+	{{ $else }}if (!pgv::Contains({{ $val }}, {{ lit $r.GetContains }})) {
+		{{ fail $ctx "contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotContains }}
+	{{ $else }}if (pgv::Contains({{ $val }}, {{ lit $r.GetNotContains }})) {
+		{{ fail $ctx "not_contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetEmail }}
+	{{ $else }}if (!pgv::IsValidEmail({{ $val }})) {
+		{{ fail $ctx "email" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetAddress }}
+	{{ $else }}if (!pgv::IsValidAddress({{ $val }})) {
+		{{ fail $ctx "address" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetHostname }}
+	{{ $else }}if (!pgv::IsValidHostname({{ $val }})) {
+		{{ fail $ctx "hostname" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	{{ $else }}if (!pgv::IsValidIp({{ $val }})) {
+		{{ fail $ctx "ip" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	{{ $else }}if (!pgv::IsValidIpv4({{ $val }})) {
+		{{ fail $ctx "ipv4" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	{{ $else }}if (!pgv::IsValidIpv6({{ $val }})) {
+		{{ fail $ctx "ipv6" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUri }}
+	{{ $else }}if (!pgv::IsValidUri({{ $val }})) {
+		{{ fail $ctx "uri" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUriRef }}
+	{{ $else }}if (!pgv::IsValidUriRef({{ $val }})) {
+		{{ fail $ctx "uri_ref" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUuid }}
+	{{ $else }}if (!pgv::IsValidUuid({{ $val }})) {
+		{{ fail $ctx "uuid" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if eq $r.GetWellKnownRegex 1 }}
+	{{ $else }}if (!pgv::IsValidHttpHeaderName({{ $val }}, {{ $r.GetStrict }})) {
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- else if eq $r.GetWellKnownRegex 2 }}
+	{{ $else }}if (!pgv::IsValidHttpHeaderValue({{ $val }}, {{ $r.GetStrict }})) {
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,36 @@
+package cc
+
+const timestampTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if and $r.GetRequired (present $ctx) }}
+	if (!{{ present $ctx }}) {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+	{{ with present $ctx }}if ({{ . }}) {{ end }}{
+		const auto& ts = {{ accessor $ctx }};
+		if (!pgv::IsValidTimestamp(ts)) {
+			{{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+		} else if (pgv::Nanos(ts) != {{ nanosLit $r.GetConst }}) {
+			{{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond "pgv::Nanos(ts)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		} else if ({{ . }}) {
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.GetLtNow }}
+		} else if (pgv::Nanos(ts) >= pgv::NowNanos()) {
+			{{ fail $ctx "lt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.GetGtNow }}
+		} else if (pgv::Nanos(ts) <= pgv::NowNanos()) {
+			{{ fail $ctx "gt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.Within }}
+		} else if (!pgv::IsWithinNow(ts, {{ nanosLit $r.GetWithin }})) {
+			{{ fail $ctx "within" $r.GetError }}
+		{{- end }}
+		}
+	}
+{{ end -}}
+`
@@ -0,0 +1,13 @@
+package cc
+
+const wrapperConstTpl = `{{ renderConstants (unwrap .) }}`
+
+const wrapperTpl = `
+	{{ with present . }}if ({{ . }}) {{ end }}{
+		{{ render (unwrap .) }}
+	}
+	{{- if and .MessageRules.GetRequired (present .) }} else {
+		{{ fail . "required" .MessageRules.GetError }}
+	}
+	{{- end }}
+`
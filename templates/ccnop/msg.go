@@ -0,0 +1,24 @@
+package ccnop
+
+const moduleFileTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+
+#include "{{ header . }}"
+
+{{ with namespace . }}namespace {{ . }} {{ "{" }}{{ end }}
+{{ range .AllMessages }}
+	{{ template "msg" . }}
+{{ end }}
+{{ with namespace . }}}  // namespace {{ . }}{{ end }}
+`
+
+const msgTpl = `
+{{ if not (ignored .) -}}
+bool Check(const {{ msgTyp . }}&, pgv::Violations*) {
+	// validation is disabled by lang=ccnop
+	return true;
+}
+
+static const pgv::Validator<{{ msgTyp . }}> {{ validatorName . }}(Check);
+{{- end -}}
+`
@@ -0,0 +1,26 @@
+// Package ccnop renders C++ validators which accept every message, for builds
+// that link against the generated validators but must not pay for them.
+package ccnop
+
+import (
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"github.com/curl-li/protoc-gen-validate/templates/cc"
+)
+
+// RegisterHeader registers the template of the header declaring the
+// validators of a proto file, the same as the one of cc.
+func RegisterHeader(tpl *template.Template, params pgs.Parameters) {
+	cc.RegisterHeader(tpl, params)
+}
+
+// RegisterModule registers the template of the module defining validators
+// which report no violations.
+func RegisterModule(tpl *template.Template, params pgs.Parameters) {
+	tpl.Funcs(cc.FuncMap(tpl, params))
+
+	template.Must(tpl.Parse(moduleFileTpl))
+	template.Must(tpl.New("msg").Parse(msgTpl))
+}
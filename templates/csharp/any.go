@@ -0,0 +1,37 @@
+package csharp
+
+const anyConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+private static readonly scg::HashSet<string> {{ constantName $ctx $index "InLookup" }} = new scg::HashSet<string> { {{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }} };
+{{- end }}
+{{- if $r.NotIn }}
+private static readonly scg::HashSet<string> {{ constantName $ctx $index "NotInLookup" }} = new scg::HashSet<string> { {{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }} };
+{{- end }}
+{{- end }}
+`
+
+const anyTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if and $r.GetRequired (present $ctx) }}
+	if ({{ accessor $ctx }} == null) {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- if or $r.In $r.NotIn }}
+	{{ with present $ctx }}if ({{ . }}) {{ end }}{
+		var a = {{ accessor $ctx }};
+		{{- $else := "" }}
+		{{- if $r.In }}
+		{{ $else }}if (!{{ constantName $ctx $index "InLookup" }}.Contains(a.TypeUrl)) {
+			{{ fail $ctx "in" $r.GetError }}
+		{{- $else = "} else " }}
+		{{- end }}
+		{{- if $r.NotIn }}
+		{{ $else }}if ({{ constantName $ctx $index "NotInLookup" }}.Contains(a.TypeUrl)) {
+			{{ fail $ctx "not_in" $r.GetError }}
+		{{- $else = "} else " }}
+		{{- end }}
+		}
+	}
+	{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,93 @@
+package csharp
+
+const bytesConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+private static readonly scg::HashSet<pb::ByteString> {{ constantName $ctx $index "InLookup" }} = new scg::HashSet<pb::ByteString> { {{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ byteStringLit $v }}{{ end }} };
+{{- end }}
+{{- if $r.NotIn }}
+private static readonly scg::HashSet<pb::ByteString> {{ constantName $ctx $index "NotInLookup" }} = new scg::HashSet<pb::ByteString> { {{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ byteStringLit $v }}{{ end }} };
+{{- end }}
+{{- if $r.Pattern }}
+private static readonly global::System.Text.RegularExpressions.Regex {{ constantName $ctx $index "Pattern" }} = new global::System.Text.RegularExpressions.Regex({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const bytesTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if ({{ $val }} != {{ byteStringLit $r.GetConst }}) {
+		{{ fail $ctx "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if (!{{ constantName $ctx $index "InLookup" }}.Contains({{ $val }})) {
+		{{ fail $ctx "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if ({{ constantName $ctx $index "NotInLookup" }}.Contains({{ $val }})) {
+		{{ fail $ctx "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Len }}
+	{{ $else }}if ({{ $val }}.Length != {{ $r.GetLen }}) {
+		{{ fail $ctx "len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	{{ $else }}if ({{ $val }}.Length < {{ $r.GetMinLen }}) {
+		{{ fail $ctx "min_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	{{ $else }}if ({{ $val }}.Length > {{ $r.GetMaxLen }}) {
+		{{ fail $ctx "max_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	{{ $else }}if (!{{ constantName $ctx $index "Pattern" }}.IsMatch({{ $val }}.ToStringUtf8())) {
+		{{ fail $ctx "pattern" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	{{ $else }}if (!pgv::Bytes.HasPrefix({{ $val }}, {{ bytesLit $r.GetPrefix }})) {
+		{{ fail $ctx "prefix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	{{ $else }}if (!pgv::Bytes.HasSuffix({{ $val }}, {{ bytesLit $r.GetSuffix }})) {
+		{{ fail $ctx "suffix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Contains }}
+	{{ $else }}if (!pgv::Bytes.Contains({{ $val }}, {{ bytesLit $r.GetContains }})) {
+		{{ fail $ctx "contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	{{ $else }}if ({{ $val }}.Length != 4 && {{ $val }}.Length != 16) {
+		{{ fail $ctx "ip" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	{{ $else }}if ({{ $val }}.Length != 4) {
+		{{ fail $ctx "ipv4" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	{{ $else }}if ({{ $val }}.Length != 16) {
+		{{ fail $ctx "ipv6" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,42 @@
+package csharp
+
+const durationConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+private static readonly scg::HashSet<decimal> {{ constantName $ctx $index "InLookup" }} = new scg::HashSet<decimal> { {{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end }} };
+{{- end }}
+{{- if $r.NotIn }}
+private static readonly scg::HashSet<decimal> {{ constantName $ctx $index "NotInLookup" }} = new scg::HashSet<decimal> { {{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end }} };
+{{- end }}
+{{- end }}
+`
+
+const durationTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if and $r.GetRequired (present $ctx) }}
+	if ({{ accessor $ctx }} == null) {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+	{{ with present $ctx }}if ({{ . }}) {{ end }}{
+		var d = {{ accessor $ctx }};
+		if (!pgv::Times.IsValid(d)) {
+			{{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+		} else if (pgv::Times.Nanos(d) != {{ nanosLit $r.GetConst }}) {
+			{{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond "pgv::Times.Nanos(d)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		} else if ({{ . }}) {
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.In }}
+		} else if (!{{ constantName $ctx $index "InLookup" }}.Contains(pgv::Times.Nanos(d))) {
+			{{ fail $ctx "in" $r.GetError }}
+		{{- end }}
+		{{- if $r.NotIn }}
+		} else if ({{ constantName $ctx $index "NotInLookup" }}.Contains(pgv::Times.Nanos(d))) {
+			{{ fail $ctx "not_in" $r.GetError }}
+		{{- end }}
+		}
+	}
+{{ end -}}
+`
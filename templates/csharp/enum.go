@@ -0,0 +1,37 @@
+package csharp
+
+const enumConstTpl = `{{ $r := .Rules -}}
+{{- if $r.In }}
+private static readonly scg::HashSet<int> {{ constantName . 0 "InLookup" }} = new scg::HashSet<int> { {{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ $v }}{{ end }} };
+{{- end }}
+{{- if $r.NotIn }}
+private static readonly scg::HashSet<int> {{ constantName . 0 "NotInLookup" }} = new scg::HashSet<int> { {{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ $v }}{{ end }} };
+{{- end }}
+`
+
+const enumTpl = `{{ $r := .Rules }}{{ $val := accessor . }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if ((int) {{ $val }} != {{ $r.GetConst }}) {
+		{{ fail . "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetDefinedOnly }}
+	{{ $else }}if (!global::System.Enum.IsDefined(typeof({{ enumTyp . }}), {{ $val }})) {
+		{{ fail . "defined_only" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if (!{{ constantName . 0 "InLookup" }}.Contains((int) {{ $val }})) {
+		{{ fail . "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if ({{ constantName . 0 "NotInLookup" }}.Contains((int) {{ $val }})) {
+		{{ fail . "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+`
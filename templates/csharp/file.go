@@ -0,0 +1,22 @@
+package csharp
+
+const fileTpl = `// <auto-generated>
+//     Generated by protoc-gen-validate. DO NOT EDIT!
+//     source: {{ .InputPath }}
+// </auto-generated>
+#pragma warning disable 1591, 0612, 3021, 8981
+#region Designer generated code
+
+using pb = global::Google.Protobuf;
+using scg = global::System.Collections.Generic;
+using pgv = global::Spaceli.Pgv;
+{{ with namespace . }}
+namespace {{ . }} {{ "{" }}
+{{- end }}
+{{ range .Messages }}
+	{{- template "msg" . }}
+{{ end }}
+{{ with namespace . }}{{ "}" }}{{ end }}
+
+#endregion Designer generated code
+`
@@ -0,0 +1,45 @@
+package csharp
+
+const mapConstTpl = `{{ renderConstants (.Key "" "Key") }}
+{{- renderConstants (.Elem "" "Value") -}}
+`
+
+const mapTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.MinPairs }}
+	{{ $else }}if ({{ $val }}.Count < {{ $r.GetMinPairs }}) {
+		{{ fail $ctx "min_pairs" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxPairs }}
+	{{ $else }}if ({{ $val }}.Count > {{ $r.GetMaxPairs }}) {
+		{{ fail $ctx "max_pairs" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+	{{- if $r.GetNoSparse }}
+	// no_sparse always holds: the values of a MapField cannot be null
+	{{- end }}
+	{{- if or $r.GetKeys $r.GetValues }}
+	v.Entries({{ lit (fieldPath $ctx) }}, {{ $val }}, (key, val) => {
+		{{- if $r.GetKeys }}
+		{{ render ($ctx.KeyWithErrIndex "key" "Key" $index) }}
+		{{- end }}
+		{{- if $r.GetValues }}
+		{{ render ($ctx.ElemWithErrIndex "val" "Value" $index) }}
+		{{- end }}
+	});
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasValues .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	v.Entries({{ lit (fieldPath .) }}, {{ $val }}, (key, val) => v.Nested("", val));
+{{- end }}
+`
@@ -0,0 +1,28 @@
+package csharp
+
+const messageTpl = `{{ $f := .Field }}{{ $r := .Rules }}
+	{{- if .MessageRules.GetSkip }}
+	// skipping validation for {{ $f.Name }}
+	{{- else -}}
+	{{- if and $r.GetRequired (present .) }}
+	if ({{ accessor . }} == null) {
+		{{ fail . "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- if validated (embedded .) }}
+	v.Nested({{ lit (fieldPath .) }}, {{ accessor . }});
+	{{- end }}
+	{{- end -}}
+`
+
+const wrapperConstTpl = `{{ renderConstants (unwrap .) }}`
+
+const wrapperTpl = `
+	if ({{ accessor . }} != null) {
+		{{ render (unwrap .) }}
+	}
+	{{- if .MessageRules.GetRequired }} else {
+		{{ fail . "required" .MessageRules.GetError }}
+	}
+	{{- end }}
+`
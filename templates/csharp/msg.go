@@ -0,0 +1,68 @@
+package csharp
+
+const msgTpl = `
+{{ if emitted . -}}
+{{ $ctx := . -}}
+partial class {{ .Name }}{{ if not (ignored .) }} : pgv::IValidatable{{ end }} {
+{{- if not (ignored .) }}
+	{{- range validatedFields . }}
+	{{ renderConstants (context $ctx .) }}
+	{{- end }}
+	{{ template "oneOfConst" . }}
+
+	/// <summary>
+	/// Validates {{ .Name }}, throwing the error of the first failed rule.
+	/// </summary>
+	public void Validate() {
+		pgv::Violations.Validate(this);
+	}
+
+	/// <summary>
+	/// Validates {{ .Name }}, returning every failed rule.
+	/// </summary>
+	public scg::IReadOnlyList<pgv::Violation> ValidateAll() {
+		return pgv::Violations.ValidateAll(this);
+	}
+
+	/// <summary>
+	/// Reports the violations of the rules of {{ .Name }} to v.
+	/// </summary>
+	public void Check(pgv::Violations v) {
+		{{- if disabled . }}
+		// validation is disabled for {{ .Name }}
+		{{- else }}
+		{{- range validatedFields . }}
+		{{ template "field" (context $ctx .) }}
+		{{- end }}
+		{{- template "oneOf" . }}
+		{{- end }}
+	}
+{{- end }}
+{{- with emittedMessages . }}
+
+	#region Nested types
+	/// <summary>Container for nested types declared in the {{ $.Name }} message type.</summary>
+	static partial class Types {
+		{{- range . }}
+		{{ template "msg" . }}
+		{{- end }}
+	}
+	#endregion
+{{- end }}
+}
+{{- end -}}
+`
+
+// fieldTpl gates the rules of a scalar field with explicit presence on it
+// being set, and reports its required rule otherwise.
+const fieldTpl = `{{ with hasCond . -}}
+	if ({{ . }}) {
+		{{ render $ }}
+	}
+	{{- with failRequired $ }} else {
+		{{ . }}
+	}
+	{{- end }}
+{{- else -}}
+	{{ render . }}
+{{- end }}`
@@ -0,0 +1,4 @@
+package csharp
+
+const noneTpl = `// no validation rules for {{ .Field.Name }}
+`
@@ -0,0 +1,22 @@
+package csharp
+
+const oneOfConstTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+{{- range .Fields }}{{ renderConstants (context $msg .) }}{{ end -}}
+{{- end -}}
+`
+
+const oneOfTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+	switch ({{ oneofName . }}Case) {
+	{{- range .Fields }}
+	case {{ oneofCase . }}:
+		{{ render (context $msg .) }}
+		break;
+	{{- end }}
+	{{- if (oneofRule .).GetRequired }}
+	default:
+		{{ failOneOf $msg . }}
+		break;
+	{{- end }}
+	}
+{{- end -}}
+`
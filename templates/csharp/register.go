@@ -0,0 +1,879 @@
+package csharp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+// Register registers the templates of the partial classes adding validators
+// to the message classes protoc generates for C#, see csharp/pgv-csharp-stub
+// for their runtime.
+func Register(tpl *template.Template, params pgs.Parameters) {
+	fns := csharpFuncs{}
+
+	tpl.Funcs(map[string]interface{}{
+		"accessor":        fns.accessor,
+		"boundsRule":      shared.BoundsRule,
+		"byteStringLit":   fns.byteStringLit,
+		"bytesLit":        fns.bytesLit,
+		"constantName":    fns.constantName,
+		"embedded":        fns.embedded,
+		"emitted":         fns.emitted,
+		"emittedMessages": fns.emittedMessages,
+		"emptyCond":       fns.emptyCond,
+		"enumTyp":         fns.enumTyp,
+		"fail":            fns.fail,
+		"failOneOf":       fns.failOneOf,
+		"failRequired":    fns.failRequired,
+		"fieldPath":       fns.fieldPath,
+		"hasCond":         fns.hasCond,
+		"hasItems":        hasItems,
+		"hasValues":       hasValues,
+		"lit":             fns.lit,
+		"namespace":       fns.namespace,
+		"nanosLit":        fns.nanosLit,
+		"numLit":          fns.numLit,
+		"oneofCase":       fns.oneofCase,
+		"oneofName":       fns.oneofName,
+		"present":         fns.present,
+		"rangeCond":       fns.rangeCond,
+		"renderConstants": fns.renderConstants(tpl),
+		"setType":         fns.setType,
+		"unwrap":          fns.unwrap,
+		"validated":       fns.validated,
+		"validatedFields": fns.validatedFields,
+	})
+
+	template.Must(tpl.Parse(fileTpl))
+	template.Must(tpl.New("msg").Parse(msgTpl))
+	template.Must(tpl.New("field").Parse(fieldTpl))
+
+	template.Must(tpl.New("none").Parse(noneTpl))
+
+	template.Must(tpl.New("float").Parse(numTpl))
+	template.Must(tpl.New("floatConst").Parse(numConstTpl))
+	template.Must(tpl.New("double").Parse(numTpl))
+	template.Must(tpl.New("doubleConst").Parse(numConstTpl))
+	template.Must(tpl.New("int32").Parse(numTpl))
+	template.Must(tpl.New("int32Const").Parse(numConstTpl))
+	template.Must(tpl.New("int64").Parse(numTpl))
+	template.Must(tpl.New("int64Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint32").Parse(numTpl))
+	template.Must(tpl.New("uint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint64").Parse(numTpl))
+	template.Must(tpl.New("uint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint32").Parse(numTpl))
+	template.Must(tpl.New("sint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint64").Parse(numTpl))
+	template.Must(tpl.New("sint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed32").Parse(numTpl))
+	template.Must(tpl.New("fixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed64").Parse(numTpl))
+	template.Must(tpl.New("fixed64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed32").Parse(numTpl))
+	template.Must(tpl.New("sfixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed64").Parse(numTpl))
+	template.Must(tpl.New("sfixed64Const").Parse(numConstTpl))
+
+	template.Must(tpl.New("bool").Parse(boolTpl))
+	template.Must(tpl.New("string").Parse(stringTpl))
+	template.Must(tpl.New("stringConst").Parse(stringConstTpl))
+	template.Must(tpl.New("bytes").Parse(bytesTpl))
+	template.Must(tpl.New("bytesConst").Parse(bytesConstTpl))
+
+	template.Must(tpl.New("any").Parse(anyTpl))
+	template.Must(tpl.New("anyConst").Parse(anyConstTpl))
+	template.Must(tpl.New("enum").Parse(enumTpl))
+	template.Must(tpl.New("enumConst").Parse(enumConstTpl))
+	template.Must(tpl.New("message").Parse(messageTpl))
+	template.Must(tpl.New("repeated").Parse(repeatedTpl))
+	template.Must(tpl.New("repeatedConst").Parse(repeatedConstTpl))
+	template.Must(tpl.New("map").Parse(mapTpl))
+	template.Must(tpl.New("mapConst").Parse(mapConstTpl))
+	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
+	template.Must(tpl.New("oneOfConst").Parse(oneOfConstTpl))
+
+	template.Must(tpl.New("timestamp").Parse(timestampTpl))
+	template.Must(tpl.New("duration").Parse(durationTpl))
+	template.Must(tpl.New("durationConst").Parse(durationConstTpl))
+	template.Must(tpl.New("wrapper").Parse(wrapperTpl))
+	template.Must(tpl.New("wrapperConst").Parse(wrapperConstTpl))
+}
+
+// csharpFuncs renders validators against the message classes Google.Protobuf
+// generates. The partial classes leave out access modifiers, which are taken
+// from the declarations of protoc, internal_access included.
+type csharpFuncs struct{}
+
+// CodeFormat re-indents the rendered validators by their braces with two
+// spaces, like protoc does for C#. Blank lines are collapsed, and only kept
+// between the members of namespaces and classes.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(src), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	var buf bytes.Buffer
+	// blocks records for each open brace whether it opens a namespace or a
+	// class, and if a case label of the switch it opens has been seen.
+	type block struct{ decl, inCase bool }
+	var blocks []block
+	inCode := func() bool {
+		for _, b := range blocks {
+			if !b.decl {
+				return true
+			}
+		}
+		return false
+	}
+	prev := ""
+	for i, line := range lines {
+		if line == "" {
+			next := ""
+			for _, l := range lines[i+1:] {
+				if l != "" {
+					next = l
+					break
+				}
+			}
+			if prev == "" || next == "" || inCode() || strings.HasSuffix(prev, "{") || strings.HasPrefix(next, "}") {
+				continue
+			}
+			buf.WriteByte('\n')
+			prev = ""
+			continue
+		}
+
+		ops := braces(line)
+		for len(ops) > 0 && ops[0] == '}' {
+			if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			ops = ops[1:]
+		}
+
+		label := strings.HasPrefix(line, "case ") || strings.HasPrefix(line, "default:")
+		if label && len(blocks) > 0 {
+			blocks[len(blocks)-1].inCase = true
+		}
+		depth := 0
+		for j, b := range blocks {
+			depth++
+			// statements of a case are indented below its label
+			if b.inCase && !(label && j == len(blocks)-1) {
+				depth++
+			}
+		}
+		buf.WriteString(strings.Repeat("  ", depth))
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+
+		for _, op := range ops {
+			if op == '{' {
+				blocks = append(blocks, block{decl: isDecl(line)})
+			} else if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+		}
+		prev = line
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// isDecl reports if line opens a namespace or a class, whose members are
+// separated by blank lines.
+func isDecl(line string) bool {
+	return strings.HasPrefix(line, "namespace ") || strings.HasPrefix(line, "partial class ") ||
+		strings.Contains(line, " partial class ")
+}
+
+// braces returns the braces of a line outside of comments and string and
+// character literals, in order.
+func braces(line string) []rune {
+	var out []rune
+	var quote rune
+	escaped := false
+	prev := rune(0)
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '/' && prev == '/':
+			return out
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '{' || r == '}':
+			out = append(out, r)
+		}
+		prev = r
+	}
+	return out
+}
+
+// FilePath places the validators of a proto file in <File>.Validate.cs, next
+// to the <File>.cs protoc generates.
+func FilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	// Don't generate validators for files that don't import PGV
+	if !importsPgv(f) {
+		return nil
+	}
+	base := strings.TrimSuffix(f.InputPath().Base(), ".proto")
+	out := pgs.FilePath(pascalCase(base) + ".Validate." + tpl.Name())
+	return &out
+}
+
+func importsPgv(f pgs.File) bool {
+	for _, dep := range f.Descriptor().Dependency {
+		if strings.HasSuffix(dep, "validate.proto") {
+			return true
+		}
+	}
+	return false
+}
+
+// underscoresToCamelCase converts a proto name the way protoc does for C#:
+// letters following an underscore or digit are capitalized, and with
+// preservePeriod, periods are kept.
+func underscoresToCamelCase(name string, capNext, preservePeriod bool) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z':
+			if capNext {
+				r -= 'a' - 'A'
+			}
+			b.WriteRune(r)
+			capNext = false
+		case r >= 'A' && r <= 'Z':
+			if i == 0 && !capNext {
+				r += 'a' - 'A'
+			}
+			b.WriteRune(r)
+			capNext = false
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+			capNext = true
+		default:
+			capNext = true
+			if r == '.' && preservePeriod {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
+func pascalCase(name string) string {
+	return underscoresToCamelCase(name, true, false)
+}
+
+// reservedMembers are the members of the message classes protoc appends an
+// underscore to the properties of clashing fields for.
+var reservedMembers = map[string]bool{
+	"Types": true, "Descriptor": true, "Equals": true, "ToString": true, "GetHashCode": true, "WriteTo": true,
+	"Clone": true, "CalculateSize": true, "MergeFrom": true, "OnConstruction": true, "Parser": true,
+}
+
+// propertyName returns the name protoc gives the property of f.
+func propertyName(f pgs.Field) string {
+	name := pascalCase(f.Name().String())
+	if name == f.Message().Name().String() || reservedMembers[name] {
+		name += "_"
+	}
+	return name
+}
+
+// fileNamespace returns the C# namespace of the types of f: its
+// csharp_namespace option, or else its package.
+func fileNamespace(f pgs.File) string {
+	if opts := f.Descriptor().GetOptions(); opts != nil && opts.CsharpNamespace != nil {
+		return opts.GetCsharpNamespace()
+	}
+	return underscoresToCamelCase(f.Package().ProtoName().String(), true, true)
+}
+
+func (fns csharpFuncs) namespace(f pgs.File) string {
+	return fileNamespace(f)
+}
+
+// qualified returns the fully qualified C# name of a message or enum, nested
+// types being declared in the Types class of their parent.
+func (fns csharpFuncs) qualified(e pgs.Entity) string {
+	name := strings.TrimPrefix(e.FullyQualifiedName(), ".")
+	if pkg := e.Package().ProtoName().String(); pkg != "" {
+		name = strings.TrimPrefix(name, pkg+".")
+	}
+	name = strings.ReplaceAll(name, ".", ".Types.")
+	if ns := fileNamespace(e.File()); ns != "" {
+		return "global::" + ns + "." + name
+	}
+	return "global::" + name
+}
+
+// enumTyp returns the enum of ctx, resolving repeated items and map values.
+func (fns csharpFuncs) enumTyp(ctx shared.RuleContext) string {
+	t := ctx.Field.Type()
+	if t.IsRepeated() || t.IsMap() {
+		return fns.qualified(t.Element().Enum())
+	}
+	return fns.qualified(t.Enum())
+}
+
+// emitted reports if a partial class is rendered for msg: it has validators
+// or one of its nested messages does.
+func (fns csharpFuncs) emitted(msg pgs.Message) (bool, error) {
+	ignored, err := shared.Ignored(msg)
+	if err != nil || !ignored {
+		return !ignored, err
+	}
+	nested, err := fns.emittedMessages(msg)
+	return len(nested) > 0, err
+}
+
+// emittedMessages returns the nested messages of msg a partial class is
+// rendered for.
+func (fns csharpFuncs) emittedMessages(msg pgs.Message) (out []pgs.Message, err error) {
+	for _, nested := range msg.Messages() {
+		ok, err := fns.emitted(nested)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, nested)
+		}
+	}
+	return out, nil
+}
+
+// validatedFields returns the fields of msg outside of a real oneof, proto3
+// optional fields included.
+func (fns csharpFuncs) validatedFields(msg pgs.Message) (out []pgs.Field) {
+	for _, f := range msg.Fields() {
+		if !f.InRealOneOf() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// embedded returns the message type of ctx, resolving repeated items and map
+// values.
+func (fns csharpFuncs) embedded(ctx shared.RuleContext) pgs.Message {
+	if t := ctx.Field.Type(); t.IsRepeated() || t.IsMap() {
+		return t.Element().Embed()
+	}
+	return ctx.Field.Type().Embed()
+}
+
+// validated reports if msg implements pgv::IValidatable: well-known types and
+// messages of files without rules do not.
+func (fns csharpFuncs) validated(msg pgs.Message) bool {
+	if msg == nil || msg.IsWellKnown() || !importsPgv(msg.File()) {
+		return false
+	}
+	ignored, err := shared.Ignored(msg)
+	return err == nil && !ignored
+}
+
+func (fns csharpFuncs) accessor(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride
+	}
+	return propertyName(ctx.Field)
+}
+
+// present renders the condition under which the message field of ctx is set,
+// empty for repeated items and map values which always are.
+func (fns csharpFuncs) present(ctx shared.RuleContext) string {
+	if fns.fieldPath(ctx) == "" {
+		return ""
+	}
+	return fns.accessor(ctx) + " != null"
+}
+
+// hasCond renders the condition under which the scalar field of ctx with
+// explicit presence is set, empty for other fields.
+func (fns csharpFuncs) hasCond(ctx shared.RuleContext) string {
+	f := ctx.Field
+	if ctx.Typ == "none" || !f.HasPresence() || f.InRealOneOf() {
+		return ""
+	}
+	if t := f.Type(); t.IsEmbed() || t.IsRepeated() || t.IsMap() {
+		return ""
+	}
+	return "Has" + propertyName(f)
+}
+
+// failRequired renders the report of the required rule of the scalar field of
+// ctx, empty if it has none.
+func (fns csharpFuncs) failRequired(ctx shared.RuleContext) (string, error) {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil || !shared.ScalarRequired(&rules) {
+		return "", err
+	}
+	return fns.fail(ctx, "required", shared.RequiredError(ctx.Rules))
+}
+
+// oneofName returns the name protoc gives the properties of oo.
+func (fns csharpFuncs) oneofName(oo pgs.OneOf) string {
+	return pascalCase(oo.Name().String())
+}
+
+// oneofCase returns the member of the OneofCase enum of the oneof field f.
+func (fns csharpFuncs) oneofCase(f pgs.Field) string {
+	name := propertyName(f)
+	if name == "None" {
+		name += "_"
+	}
+	return fmt.Sprintf("%sOneofCase.%s", fns.oneofName(f.OneOf()), name)
+}
+
+// fieldPath returns the name violations of ctx are reported under, empty for
+// repeated items and map entries which are reported under their index or key.
+func (fns csharpFuncs) fieldPath(ctx shared.RuleContext) string {
+	switch strings.SplitN(ctx.AccessorOverride, ".", 2)[0] {
+	case "item", "key", "val":
+		return ""
+	}
+	return ctx.Field.Name().String()
+}
+
+// constantName returns the name of a static field of the partial class
+// backing the rule at index of ctx.
+func (fns csharpFuncs) constantName(ctx shared.RuleContext, index int, rule string) string {
+	return fmt.Sprintf("_pgv_%s%s_%d_%s", ctx.Field.Name(), ctx.Index, index, rule)
+}
+
+// setType returns the element type of the lookup sets of the numbers of ctx.
+func (fns csharpFuncs) setType(ctx shared.RuleContext) string {
+	switch ctx.Typ {
+	case "float":
+		return "float"
+	case "double":
+		return "double"
+	case "int64", "sint64", "sfixed64":
+		return "long"
+	case "uint64", "fixed64":
+		return "ulong"
+	case "uint32", "fixed32":
+		return "uint"
+	default:
+		return "int"
+	}
+}
+
+// emptyCond renders the condition under which the value of ctx is set, for
+// ignore_empty.
+func (fns csharpFuncs) emptyCond(ctx shared.RuleContext) string {
+	value := fns.accessor(ctx)
+	switch ctx.Typ {
+	case "string", "bytes":
+		return value + ".Length != 0"
+	case "repeated", "map":
+		return value + ".Count != 0"
+	default:
+		return value + " != 0"
+	}
+}
+
+// lit renders s as a C# string literal, escaping everything but printable
+// ASCII.
+func (fns csharpFuncs) lit(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7F || (r > 0x7F && r <= 0xFFFF):
+			fmt.Fprintf(&b, `\u%04X`, r)
+		case r > 0xFFFF:
+			fmt.Fprintf(&b, `\U%08X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// bytesLit renders b as a C# byte array.
+func (fns csharpFuncs) bytesLit(b []byte) string {
+	parts := make([]string, len(b))
+	for i, c := range b {
+		parts[i] = fmt.Sprintf("0x%02X", c)
+	}
+	return fmt.Sprintf("new byte[] { %s }", strings.Join(parts, ", "))
+}
+
+// byteStringLit renders b as a ByteString.
+func (fns csharpFuncs) byteStringLit(b []byte) string {
+	return fmt.Sprintf("pb::ByteString.CopyFrom(%s)", fns.bytesLit(b))
+}
+
+// numLit renders a number of a rule as a C# literal of its type.
+func (fns csharpFuncs) numLit(v interface{}) string {
+	switch n := v.(type) {
+	case float32:
+		return floatLit(float64(n), 32)
+	case float64:
+		return floatLit(n, 64)
+	case int32:
+		if n == math.MinInt32 {
+			return "int.MinValue"
+		}
+		return strconv.FormatInt(int64(n), 10)
+	case int64:
+		if n == math.MinInt64 {
+			return "long.MinValue"
+		}
+		return fmt.Sprintf("%dL", n)
+	case uint32:
+		return fmt.Sprintf("%dU", n)
+	case uint64:
+		return fmt.Sprintf("%dUL", n)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// floatLit renders v as a C# literal of a float (bits 32) or double.
+func floatLit(v float64, bits int) string {
+	typ, suffix := "double", "D"
+	if bits == 32 {
+		typ, suffix = "float", "F"
+	}
+	switch {
+	case math.IsNaN(v):
+		return typ + ".NaN"
+	case math.IsInf(v, 1):
+		return typ + ".PositiveInfinity"
+	case math.IsInf(v, -1):
+		return typ + ".NegativeInfinity"
+	}
+	return strings.ToUpper(strconv.FormatFloat(v, 'g', -1, bits)) + suffix
+}
+
+// nanosLit renders a duration, or a timestamp as the time since the epoch, in
+// nanoseconds.
+func (fns csharpFuncs) nanosLit(v interface{}) string {
+	switch t := v.(type) {
+	case *durationpb.Duration:
+		return fmt.Sprintf("%dM", t.AsDuration().Nanoseconds())
+	case *timestamppb.Timestamp:
+		return fmt.Sprintf("%dM", t.GetSeconds()*1e9+int64(t.GetNanos()))
+	}
+	return fmt.Sprint(v)
+}
+
+// rangeCond renders the condition under which value violates the lt/lte/gt/gte
+// bounds of a rule. Like the Java runtime, a lower bound above the upper bound
+// inverts the range into an exclusive one.
+func (fns csharpFuncs) rangeCond(value string, lt, lte, gt, gte interface{}) (string, error) {
+	ltLit, ltVal, hasLt, err := fns.bound(lt)
+	if err != nil {
+		return "", err
+	}
+	lteLit, lteVal, hasLte, err := fns.bound(lte)
+	if err != nil {
+		return "", err
+	}
+	gtLit, gtVal, hasGt, err := fns.bound(gt)
+	if err != nil {
+		return "", err
+	}
+	gteLit, gteVal, hasGte, err := fns.bound(gte)
+	if err != nil {
+		return "", err
+	}
+
+	var upper, lower string
+	var upperVal, lowerVal float64
+	switch {
+	case hasLt:
+		upper, upperVal = fmt.Sprintf("%s >= %s", value, ltLit), ltVal
+	case hasLte:
+		upper, upperVal = fmt.Sprintf("%s > %s", value, lteLit), lteVal
+	}
+	switch {
+	case hasGt:
+		lower, lowerVal = fmt.Sprintf("%s <= %s", value, gtLit), gtVal
+	case hasGte:
+		lower, lowerVal = fmt.Sprintf("%s < %s", value, gteLit), gteVal
+	}
+
+	switch {
+	case upper == "":
+		return lower, nil
+	case lower == "":
+		return upper, nil
+	case lowerVal <= upperVal:
+		return fmt.Sprintf("%s || %s", lower, upper), nil
+	}
+
+	// exclusive range: the value must fall outside of [upper, lower]
+	inUpper := fmt.Sprintf("%s > %s", value, lteLit)
+	if hasLt {
+		inUpper = fmt.Sprintf("%s >= %s", value, ltLit)
+	}
+	inLower := fmt.Sprintf("%s < %s", value, gteLit)
+	if hasGt {
+		inLower = fmt.Sprintf("%s <= %s", value, gtLit)
+	}
+	return fmt.Sprintf("%s && %s", inUpper, inLower), nil
+}
+
+// bound resolves an optional rule bound into its C# literal and a numeric
+// value used to order the bounds of a range.
+func (fns csharpFuncs) bound(v interface{}) (lit string, val float64, ok bool, err error) {
+	switch b := v.(type) {
+	case *durationpb.Duration:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsDuration()), true, nil
+	case *timestamppb.Timestamp:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsTime().UnixNano()), true, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Ptr {
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	if rv.IsNil() {
+		return
+	}
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Int32, reflect.Int64:
+		val = float64(rv.Int())
+	case reflect.Uint32, reflect.Uint64:
+		val = float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		val = rv.Float()
+	default:
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	return fns.numLit(rv.Interface()), val, true, nil
+}
+
+// unwrap returns the context of the value of a wrapper: wrappers of strings
+// and bytes are null references, the others nullable values.
+func (fns csharpFuncs) unwrap(ctx shared.RuleContext) (shared.RuleContext, error) {
+	switch ctx.WrapperTyp {
+	case "string", "bytes":
+		return ctx.Unwrap(fns.accessor(ctx))
+	}
+	return ctx.Unwrap(fns.accessor(ctx) + ".Value")
+}
+
+func (fns csharpFuncs) renderConstants(tpl *template.Template) func(ctx shared.RuleContext) (string, error) {
+	return func(ctx shared.RuleContext) (string, error) {
+		var b bytes.Buffer
+		var err error
+
+		if t := tpl.Lookup(ctx.Typ + "Const"); t != nil {
+			err = t.Execute(&b, ctx)
+		}
+
+		return b.String(), err
+	}
+}
+
+// fail renders the report of the named rule of ctx failing with e. Nested
+// item, key and value rules without their own Error fall back to the Error of
+// the enclosing repeated or map rule at ctx.ErrIndex.
+func (fns csharpFuncs) fail(ctx shared.RuleContext, rule string, e *validate.Error) (string, error) {
+	if e == nil && ctx.AccessorOverride != "" {
+		e = parentError(ctx)
+	}
+	args, err := shared.FieldErrorArgs(ctx.Field, e)
+	if err != nil {
+		return "", err
+	}
+	value := fns.accessor(ctx)
+	if rule == "required" {
+		value = ""
+	}
+	call, err := fns.errorCall(ctx.ErrBase, e, args, value)
+	if err != nil {
+		return "", err
+	}
+	typ := ctx.Typ
+	if typ == "wrapper" {
+		typ = "message"
+	}
+	return fmt.Sprintf("v.Fail(%s, %s, () => %s);", fns.lit(fns.fieldPath(ctx)), fns.lit(typ+"."+rule), call), nil
+}
+
+func (fns csharpFuncs) failOneOf(msg pgs.Message, oo pgs.OneOf) (string, error) {
+	base, err := errorBase(msg)
+	if err != nil {
+		return "", err
+	}
+	rule, err := shared.OneOfRule(oo)
+	if err != nil {
+		return "", err
+	}
+	args, err := shared.ErrorArgs(oo.Name().String(), rule.ProtoReflect(), rule.GetError())
+	if err != nil {
+		return "", err
+	}
+	call, err := fns.errorCall(base, rule.GetError(), args, "")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("v.Fail(%s, \"oneof.required\", () => %s);", fns.lit(oo.Name().String()), call), nil
+}
+
+// errorCall renders the call of the static factory method of e. Its pkg is a
+// namespace, converted like the packages of protoc, and its method is
+// PascalCased; value is the expression of the validated value, if any.
+func (fns csharpFuncs) errorCall(base *validate.ErrorBase, e *validate.Error, args []shared.ErrorArg, value string) (string, error) {
+	pkg, class := resolveErrorTarget(base, e)
+
+	params := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg.Kind {
+		case shared.IntArg:
+			params = append(params, intArgLit(arg.Int))
+		case shared.UintArg:
+			params = append(params, uintArgLit(arg.Uint))
+		case shared.FloatArg:
+			params = append(params, floatLit(arg.Float, 64))
+		case shared.BoolArg:
+			params = append(params, strconv.FormatBool(arg.Bool))
+		case shared.RefArg:
+			params = append(params, arg.Str)
+		case shared.ValueArg:
+			if value == "" {
+				return "", fmt.Errorf("error %q: $value is not available here", e.GetMethod())
+			}
+			params = append(params, value)
+		default:
+			params = append(params, fns.lit(arg.Str))
+		}
+	}
+
+	target := "global::"
+	if ns := underscoresToCamelCase(pkg, true, true); ns != "" {
+		target += ns + "."
+	}
+	return fmt.Sprintf("%s%s.%s(%s)", target, class, pascalCase(e.GetMethod()), strings.Join(params, ", ")), nil
+}
+
+// intArgLit renders an int argument as an int literal, or a long one if it
+// does not fit.
+func intArgLit(n int64) string {
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		if n == math.MinInt64 {
+			return "long.MinValue"
+		}
+		return fmt.Sprintf("%dL", n)
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// uintArgLit renders a uint argument as an int literal, or a long or ulong
+// one if it does not fit.
+func uintArgLit(n uint64) string {
+	switch {
+	case n <= math.MaxInt32:
+		return strconv.FormatUint(n, 10)
+	case n <= math.MaxInt64:
+		return fmt.Sprintf("%dL", n)
+	}
+	return fmt.Sprintf("%dUL", n)
+}
+
+func hasItems(rules *validate.RepeatedRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetItems() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasValues(rules *validate.MapRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetValues() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveErrorTarget(base *validate.ErrorBase, e *validate.Error) (pkg, class string) {
+	if base != nil {
+		pkg = base.GetPkg()
+		class = base.GetClass()
+	}
+	if len(e.GetPkg()) > 0 {
+		pkg = e.GetPkg()
+	}
+	if len(e.GetClass()) > 0 {
+		class = e.GetClass()
+	}
+	return
+}
+
+func errorBase(msg pgs.Message) (base *validate.ErrorBase, err error) {
+	_, err = msg.Extension(validate.E_ErrorBase, &base)
+	return
+}
+
+func parentError(ctx shared.RuleContext) *validate.Error {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	switch {
+	case rules.GetRepeated() != nil:
+		if rs := rules.GetRepeated().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	case rules.GetMap() != nil:
+		if rs := rules.GetMap().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	}
+	return nil
+}
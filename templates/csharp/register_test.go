@@ -0,0 +1,106 @@
+package csharp
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUnderscoresToCamelCase(t *testing.T) {
+	tests := []struct {
+		in             string
+		preservePeriod bool
+		want           string
+	}{
+		{"phone", false, "Phone"},
+		{"phone_number", false, "PhoneNumber"},
+		{"field2x", false, "Field2X"},
+		{"IPv6_addr", false, "IPv6Addr"},
+		{"acme.billing.v1", true, "Acme.Billing.V1"},
+		{"acme.billing.v1", false, "AcmeBillingV1"},
+	}
+	for _, tt := range tests {
+		if got := underscoresToCamelCase(tt.in, true, tt.preservePeriod); got != tt.want {
+			t.Errorf("underscoresToCamelCase(%q, true, %t) = %q; want %q", tt.in, tt.preservePeriod, got, tt.want)
+		}
+	}
+}
+
+func TestLiterals(t *testing.T) {
+	var fns csharpFuncs
+	tests := []struct {
+		got, want string
+	}{
+		{fns.lit(`a"b\c`), `"a\"b\\c"`},
+		{fns.lit("é\x00\n😀"), `"\u00E9\u0000\n\U0001F600"`},
+		{fns.bytesLit([]byte{0xff, '1'}), "new byte[] { 0xFF, 0x31 }"},
+		{fns.numLit(float32(1)), "1F"},
+		{fns.numLit(1e21), "1E+21D"},
+		{fns.numLit(math.Inf(-1)), "double.NegativeInfinity"},
+		{fns.numLit(int32(math.MinInt32)), "int.MinValue"},
+		{fns.numLit(int64(-3)), "-3L"},
+		{fns.numLit(uint32(7)), "7U"},
+		{fns.numLit(uint64(math.MaxUint64)), "18446744073709551615UL"},
+		{intArgLit(1 << 40), "1099511627776L"},
+		{uintArgLit(math.MaxUint64), "18446744073709551615UL"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %s; want %s", tt.got, tt.want)
+		}
+	}
+}
+
+func TestCodeFormat(t *testing.T) {
+	in := `#region Designer generated code
+namespace Acme {
+partial class M : pgv::IValidatable {
+private static readonly scg::HashSet<string> _pgv_s_0_InLookup = new scg::HashSet<string> { "}" };
+
+
+public void Check(pgv::Violations v) {
+
+if (S != "{") {
+
+
+v.Fail("s", "string.const", () => global::Acme.Errors.Bad('}'));
+}
+
+switch (OCase) {
+case OOneofCase.X:
+// no validation rules for x
+break;
+}
+}
+}
+}
+#endregion
+`
+	want := `#region Designer generated code
+namespace Acme {
+  partial class M : pgv::IValidatable {
+    private static readonly scg::HashSet<string> _pgv_s_0_InLookup = new scg::HashSet<string> { "}" };
+
+    public void Check(pgv::Violations v) {
+      if (S != "{") {
+        v.Fail("s", "string.const", () => global::Acme.Errors.Bad('}'));
+      }
+      switch (OCase) {
+        case OOneofCase.X:
+          // no validation rules for x
+          break;
+      }
+    }
+  }
+}
+#endregion
+`
+	var out bytes.Buffer
+	if err := CodeFormat(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("CodeFormat:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,40 @@
+package csharp
+
+const repeatedConstTpl = `{{ renderConstants (.Elem "" "") }}`
+
+const repeatedTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.MinItems }}
+	{{ $else }}if ({{ $val }}.Count < {{ $r.GetMinItems }}) {
+		{{ fail $ctx "min_items" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxItems }}
+	{{ $else }}if ({{ $val }}.Count > {{ $r.GetMaxItems }}) {
+		{{ fail $ctx "max_items" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUnique }}
+	{{ $else }}if (!pgv::Collections.Unique({{ $val }})) {
+		{{ fail $ctx "unique" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+	{{- if $r.GetItems }}
+	v.Each({{ lit (fieldPath $ctx) }}, {{ $val }}, item => {
+		{{ render ($ctx.ElemWithErrIndex "item" "" $index) }}
+	});
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasItems .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	v.Each({{ lit (fieldPath .) }}, {{ $val }}, item => v.Nested("", item));
+{{- end }}
+`
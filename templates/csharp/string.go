@@ -0,0 +1,152 @@
+package csharp
+
+const stringConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+private static readonly scg::HashSet<string> {{ constantName $ctx $index "InLookup" }} = new scg::HashSet<string> { {{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }} };
+{{- end }}
+{{- if $r.NotIn }}
+private static readonly scg::HashSet<string> {{ constantName $ctx $index "NotInLookup" }} = new scg::HashSet<string> { {{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }} };
+{{- end }}
+{{- if $r.Pattern }}
+private static readonly global::System.Text.RegularExpressions.Regex {{ constantName $ctx $index "Pattern" }} = new global::System.Text.RegularExpressions.Regex({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const stringTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if ({{ $val }} != {{ lit $r.GetConst }}) {
+		{{ fail $ctx "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if (!{{ constantName $ctx $index "InLookup" }}.Contains({{ $val }})) {
+		{{ fail $ctx "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if ({{ constantName $ctx $index "NotInLookup" }}.Contains({{ $val }})) {
+		{{ fail $ctx "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Len }}
+	{{ $else }}if (pgv::Strings.RuneCount({{ $val }}) != {{ $r.GetLen }}) {
+		{{ fail $ctx "len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	{{ $else }}if (pgv::Strings.RuneCount({{ $val }}) < {{ $r.GetMinLen }}) {
+		{{ fail $ctx "min_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	{{ $else }}if (pgv::Strings.RuneCount({{ $val }}) > {{ $r.GetMaxLen }}) {
+		{{ fail $ctx "max_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.LenBytes }}
+	{{ $else }}if (pgv::Strings.ByteLength({{ $val }}) != {{ $r.GetLenBytes }}) {
+		{{ fail $ctx "len_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinBytes }}
+	{{ $else }}if (pgv::Strings.ByteLength({{ $val }}) < {{ $r.GetMinBytes }}) {
+		{{ fail $ctx "min_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxBytes }}
+	{{ $else }}if (pgv::Strings.ByteLength({{ $val }}) > {{ $r.GetMaxBytes }}) {
+		{{ fail $ctx "max_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	{{ $else }}if (!{{ constantName $ctx $index "Pattern" }}.IsMatch({{ $val }})) {
+		{{ fail $ctx "pattern" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	{{ $else }}if (!{{ $val }}.StartsWith({{ lit $r.GetPrefix }}, global::System.StringComparison.Ordinal)) {
+		{{ fail $ctx "prefix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	{{ $else }}if (!{{ $val }}.EndsWith({{ lit $r.GetSuffix }}, global::System.StringComparison.Ordinal)) {
+		{{ fail $ctx "suffix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Contains }}
+	{{ $else }}if (!{{ $val }}.Contains({{ lit $r.GetContains }})) {
+		{{ fail $ctx "contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotContains }}
+	{{ $else }}if ({{ $val }}.Contains({{ lit $r.GetNotContains }})) {
+		{{ fail $ctx "not_contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetEmail }}
+	{{ $else }}if (!pgv::Strings.IsEmail({{ $val }})) {
+		{{ fail $ctx "email" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetAddress }}
+	{{ $else }}if (!pgv::Strings.IsAddress({{ $val }})) {
+		{{ fail $ctx "address" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetHostname }}
+	{{ $else }}if (!pgv::Strings.IsHostname({{ $val }})) {
+		{{ fail $ctx "hostname" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	{{ $else }}if (!pgv::Strings.IsIp({{ $val }})) {
+		{{ fail $ctx "ip" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	{{ $else }}if (!pgv::Strings.IsIpv4({{ $val }})) {
+		{{ fail $ctx "ipv4" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	{{ $else }}if (!pgv::Strings.IsIpv6({{ $val }})) {
+		{{ fail $ctx "ipv6" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUri }}
+	{{ $else }}if (!pgv::Strings.IsUri({{ $val }})) {
+		{{ fail $ctx "uri" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUriRef }}
+	{{ $else }}if (!pgv::Strings.IsUriRef({{ $val }})) {
+		{{ fail $ctx "uri_ref" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUuid }}
+	{{ $else }}if (!pgv::Strings.IsUuid({{ $val }})) {
+		{{ fail $ctx "uuid" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if eq $r.GetWellKnownRegex 1 }}
+	{{ $else }}if (!pgv::Strings.IsHttpHeaderName({{ $val }}, {{ $r.GetStrict }})) {
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- else if eq $r.GetWellKnownRegex 2 }}
+	{{ $else }}if (!pgv::Strings.IsHttpHeaderValue({{ $val }}, {{ $r.GetStrict }})) {
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
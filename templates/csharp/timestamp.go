@@ -0,0 +1,36 @@
+package csharp
+
+const timestampTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if and $r.GetRequired (present $ctx) }}
+	if ({{ accessor $ctx }} == null) {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+	{{ with present $ctx }}if ({{ . }}) {{ end }}{
+		var ts = {{ accessor $ctx }};
+		if (!pgv::Times.IsValid(ts)) {
+			{{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+		} else if (pgv::Times.Nanos(ts) != {{ nanosLit $r.GetConst }}) {
+			{{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond "pgv::Times.Nanos(ts)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		} else if ({{ . }}) {
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.GetLtNow }}
+		} else if (pgv::Times.Nanos(ts) >= pgv::Times.NowNanos()) {
+			{{ fail $ctx "lt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.GetGtNow }}
+		} else if (pgv::Times.Nanos(ts) <= pgv::Times.NowNanos()) {
+			{{ fail $ctx "gt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.Within }}
+		} else if (!pgv::Times.IsWithinNow(ts, {{ nanosLit $r.GetWithin }})) {
+			{{ fail $ctx "within" $r.GetError }}
+		{{- end }}
+		}
+	}
+{{ end -}}
+`
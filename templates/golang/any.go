@@ -0,0 +1,39 @@
+package golang
+
+const anyConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+var {{ constantName $ctx $index "InLookup" }} = map[string]bool{
+	{{- range $r.In }}
+	{{ lit . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.NotIn }}
+var {{ constantName $ctx $index "NotInLookup" }} = map[string]bool{
+	{{- range $r.NotIn }}
+	{{ lit . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- end -}}
+`
+
+const anyTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	switch a := {{ accessor $ctx }}; {
+	{{- if $r.GetRequired }}
+	case a == nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.In }}
+	case a != nil && !{{ constantName $ctx $index "InLookup" }}[a.GetTypeUrl()]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	case a != nil && {{ constantName $ctx $index "NotInLookup" }}[a.GetTypeUrl()]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	default:
+		_ = a
+	}
+{{ end -}}
+`
@@ -0,0 +1,8 @@
+package golang
+
+const boolTpl = `{{ $r := .Rules -}}
+{{- if $r.Const }}
+	if {{ accessor . }} != {{ $r.GetConst }} {
+		{{- template "err" (error . $r.GetError) }}
+	}
+{{- end }}`
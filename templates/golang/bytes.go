@@ -0,0 +1,86 @@
+package golang
+
+const bytesConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+var {{ constantName $ctx $index "InLookup" }} = map[string]bool{
+	{{- range $r.In }}
+	{{ printf "%q" . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.NotIn }}
+var {{ constantName $ctx $index "NotInLookup" }} = map[string]bool{
+	{{- range $r.NotIn }}
+	{{ printf "%q" . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.Pattern }}
+var {{ constantName $ctx $index "Pattern" }} = regexp.MustCompile({{ lit $r.GetPattern }})
+{{ end -}}
+{{- end -}}
+`
+
+const bytesTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if len({{ accessor $ctx }}) > 0 {
+{{- end }}
+	switch {
+	{{- if $r.Const }}
+	case !bytes.Equal({{ accessor $ctx }}, {{ lit $r.GetConst }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName $ctx $index "InLookup" }}[string({{ accessor $ctx }})]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName $ctx $index "NotInLookup" }}[string({{ accessor $ctx }})]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Len }}
+	case len({{ accessor $ctx }}) != {{ $r.GetLen }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	case len({{ accessor $ctx }}) < {{ $r.GetMinLen }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	case len({{ accessor $ctx }}) > {{ $r.GetMaxLen }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	case !{{ constantName $ctx $index "Pattern" }}.Match({{ accessor $ctx }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	case !bytes.HasPrefix({{ accessor $ctx }}, {{ lit $r.GetPrefix }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	case !bytes.HasSuffix({{ accessor $ctx }}, {{ lit $r.GetSuffix }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Contains }}
+	case !bytes.Contains({{ accessor $ctx }}, {{ lit $r.GetContains }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	case len({{ accessor $ctx }}) != net.IPv4len && len({{ accessor $ctx }}) != net.IPv6len:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	case len({{ accessor $ctx }}) != net.IPv4len:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	case len({{ accessor $ctx }}) != net.IPv6len:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,50 @@
+package golang
+
+const durationConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+var {{ constantName $ctx $index "InLookup" }} = map[time.Duration]bool{
+	{{- range $r.In }}
+	{{ durLit . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.NotIn }}
+var {{ constantName $ctx $index "NotInLookup" }} = map[time.Duration]bool{
+	{{- range $r.NotIn }}
+	{{ durLit . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- end -}}
+`
+
+const durationTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	switch d := {{ accessor $ctx }}; {
+	{{- if $r.GetRequired }}
+	case d == nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- else }}
+	case d == nil:
+		// not set, nothing to validate
+	{{- end }}
+	case d.CheckValid() != nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- if $r.Const }}
+	case d.AsDuration() != {{ durLit $r.GetConst }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- with rangeCond "d.AsDuration()" $r.Lt $r.Lte $r.Gt $r.Gte }}
+	case {{ . }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName $ctx $index "InLookup" }}[d.AsDuration()]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName $ctx $index "NotInLookup" }}[d.AsDuration()]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	}
+{{ end -}}
+`
@@ -0,0 +1,39 @@
+package golang
+
+const enumConstTpl = `{{ $ctx := . }}{{ $r := .Rules -}}
+{{- if $r.In }}
+var {{ constantName . 0 "InLookup" }} = map[{{ enumName . }}]bool{
+	{{- range $r.In }}
+	{{ . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.NotIn }}
+var {{ constantName . 0 "NotInLookup" }} = map[{{ enumName . }}]bool{
+	{{- range $r.NotIn }}
+	{{ . }}: true,
+	{{- end }}
+}
+{{ end -}}
+`
+
+const enumTpl = `{{ $r := .Rules }}
+	switch {
+	{{- if $r.Const }}
+	case {{ accessor . }} != {{ $r.GetConst }}:
+		{{- template "err" (error . $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetDefinedOnly }}
+	case {{ enumName . }}_name[int32({{ accessor . }})] == "":
+		{{- template "err" (error . $r.GetError) }}
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName . 0 "InLookup" }}[{{ accessor . }}]:
+		{{- template "err" (error . $r.GetError) }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName . 0 "NotInLookup" }}[{{ accessor . }}]:
+		{{- template "err" (error . $r.GetError) }}
+	{{- end }}
+	}
+`
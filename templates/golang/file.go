@@ -0,0 +1,47 @@
+package golang
+
+const fileTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+
+package {{ pkg . }}
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	{{ range $path, $alias := errorImports . }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
+	{{ range $path, $pkg := enumImports . }}
+	{{ $pkg }} "{{ $path }}"
+	{{- end }}
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+{{ range .AllMessages }}
+	{{ template "msg" . }}
+{{ end }}
+`
@@ -0,0 +1,47 @@
+package golang
+
+const mapConstTpl = `{{ renderConstants (.Key "" "Key") }}
+{{- renderConstants (.Elem "" "Value") -}}
+`
+
+const mapTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if len({{ accessor $ctx }}) > 0 {
+{{- end }}
+	switch {
+	{{- if $r.MinPairs }}
+	case len({{ accessor $ctx }}) < {{ $r.GetMinPairs }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MaxPairs }}
+	case len({{ accessor $ctx }}) > {{ $r.GetMaxPairs }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetNoSparse }}
+	case func() bool { for _, val := range {{ accessor $ctx }} { if val == nil { return true } }; return false }():
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	}
+	{{- if or $r.GetKeys $r.GetValues }}
+
+	for key, val := range {{ accessor $ctx }} {
+		_, _ = key, val
+		{{- if $r.GetKeys }}
+		{{ render ($ctx.KeyWithErrIndex "key" "Key" $index) }}
+		{{- end }}
+		{{- if $r.GetValues }}
+		{{ render ($ctx.ElemWithErrIndex "val" "Value" $index) }}
+		{{- end }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasValues .Rules)) (eq (.Elem "" "").Typ "message") }}
+	for key, val := range {{ accessor . }} {
+		_, _ = key, val
+		{{ render (.Elem "val" "Value") }}
+	}
+{{- end }}
+`
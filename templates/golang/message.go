@@ -0,0 +1,30 @@
+package golang
+
+const messageTpl = `{{ $f := .Field }}{{ $r := .Rules }}
+	{{- if .MessageRules.GetSkip }}
+	// skipping validation for {{ $f.Name }}
+	{{- else -}}
+	{{- if $r.GetRequired }}
+	if {{ accessor . }} == nil {
+		{{- template "err" (error . $r.GetError) }}
+	}
+	{{- end }}
+
+	if all {
+		switch v := interface{}({{ accessor . }}).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, err)
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, err)
+			}
+		}
+	} else if v, ok := interface{}({{ accessor . }}).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	{{- end -}}
+`
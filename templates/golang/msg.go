@@ -0,0 +1,152 @@
+package golang
+
+const msgTpl = `
+{{ if not (ignored .) -}}
+{{ $ctx := . }}
+// Validate checks the field values on {{ msgTyp . }} with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *{{ msgTyp . }}) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on {{ msgTyp . }} with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in {{ msgTyp . }}MultiError, or
+// nil if none found.
+func (m *{{ msgTyp . }}) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *{{ msgTyp . }}) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{{ if disabled . -}}
+		// Validate is disabled for {{ msgTyp . }}
+	{{- else -}}
+	{{ range validatedFields . -}}
+		{{ template "field" (context $ctx .) }}
+	{{ end -}}
+	{{ template "oneOf" . }}
+	{{- end }}
+
+	if len(errors) > 0 {
+		return {{ msgTyp . }}MultiError(errors)
+	}
+
+	return nil
+}
+
+// {{ msgTyp . }}MultiError is an error wrapping multiple validation errors
+// returned by {{ msgTyp . }}.ValidateAll() if the designated constraints aren't met.
+type {{ msgTyp . }}MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m {{ msgTyp . }}MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m {{ msgTyp . }}MultiError) AllErrors() []error { return m }
+
+{{ if needs . "hostname" }}
+func (m *{{ msgTyp . }}) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+{{ end }}
+
+{{ if needs . "email" }}
+func (m *{{ msgTyp . }}) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+{{ end }}
+
+{{ if needs . "uuid" }}
+func (m *{{ msgTyp . }}) _validateUuid(uuid string) error {
+	if matched := _{{ msgTyp . }}_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+var _{{ msgTyp . }}_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+{{ end }}
+
+{{ range validatedFields . -}}
+	{{ renderConstants (context $ctx .) }}
+{{ end -}}
+{{ template "oneOfConst" . }}
+{{- end -}}
+`
+
+// fieldTpl gates the rules of a scalar field with explicit presence on it
+// being set, and reports its required rule otherwise.
+const fieldTpl = `{{ with hasCond . -}}
+	if {{ . }} {
+		{{- render $ }}
+	}
+	{{- with failRequired $ }} else {
+		{{- template "err" . }}
+	}
+	{{- end }}
+{{- else -}}
+	{{ render . }}
+{{- end }}`
+
+const errTpl = `
+			err := {{ . }}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)`
@@ -0,0 +1,4 @@
+package golang
+
+const noneTpl = `// no validation rules for {{ .Field.Name }}
+`
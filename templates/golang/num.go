@@ -0,0 +1,49 @@
+package golang
+
+const numConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+var {{ constantName $ctx $index "InLookup" }} = map[{{ elemType $ctx }}]bool{
+	{{- range $r.In }}
+	{{ . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.NotIn }}
+var {{ constantName $ctx $index "NotInLookup" }} = map[{{ elemType $ctx }}]bool{
+	{{- range $r.NotIn }}
+	{{ . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- end -}}
+`
+
+const numTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if or $r.Const $r.Lt $r.Lte $r.Gt $r.Gte $r.In $r.NotIn }}
+{{- if $r.GetIgnoreEmpty }}
+	if {{ accessor $ctx }} != 0 {
+{{- end }}
+	switch {
+	{{- if $r.Const }}
+	case {{ accessor $ctx }} != {{ $r.GetConst }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- with rangeCond (accessor $ctx) $r.Lt $r.Lte $r.Gt $r.Gte }}
+	case {{ . }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName $ctx $index "InLookup" }}[{{ accessor $ctx }}]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName $ctx $index "NotInLookup" }}[{{ accessor $ctx }}]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{- end }}
+{{ end -}}
+`
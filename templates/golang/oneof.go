@@ -0,0 +1,22 @@
+package golang
+
+const oneOfConstTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+{{- range .Fields }}{{ renderConstants (context $msg .) }}{{ end -}}
+{{- end -}}
+`
+
+const oneOfTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+	switch v := m.{{ name . }}.(type) {
+	{{- range .Fields }}
+	case *{{ oneof . }}:
+		_ = v
+		{{ render (context $msg .) }}
+	{{- end }}
+	default:
+		_ = v
+	{{- if (oneofRule .).GetRequired }}
+		{{- template "err" (errorOneOf $msg .) }}
+	{{- end }}
+	}
+{{ end -}}
+`
@@ -0,0 +1,615 @@
+package golang
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+func Register(tpl *template.Template, params pgs.Parameters) {
+	fns := goFuncs{Context: pgsgo.InitContext(params), errorImports: map[pgs.File]map[string]string{}}
+
+	tpl.Funcs(map[string]interface{}{
+		"accessor":        fns.accessor,
+		"constantName":    fns.constantName,
+		"durLit":          fns.durLit,
+		"elemType":        fns.elemType,
+		"enumImports":     fns.enumImports,
+		"enumName":        fns.enumName,
+		"error":           fns.errorInitializer,
+		"errorImports":    fns.errorImportTable,
+		"errorOneOf":      fns.errorOneOf,
+		"failRequired":    fns.failRequired,
+		"hasCond":         fns.hasCond,
+		"hasItems":        hasItems,
+		"hasValues":       hasValues,
+		"isBytes":         fns.isBytes,
+		"lit":             fns.lit,
+		"msgTyp":          fns.msgTyp,
+		"name":            fns.Name,
+		"oneof":           fns.OneofOption,
+		"pkg":             fns.PackageName,
+		"rangeCond":       fns.rangeCond,
+		"renderConstants": fns.renderConstants(tpl),
+		"tsLit":           fns.tsLit,
+		"typ":             fns.Type,
+		"unwrap":          fns.unwrap,
+		"validatedFields": fns.validatedFields,
+	})
+
+	template.Must(tpl.Parse(fileTpl))
+	template.Must(tpl.New("msg").Parse(msgTpl))
+	template.Must(tpl.New("field").Parse(fieldTpl))
+	template.Must(tpl.New("err").Parse(errTpl))
+
+	template.Must(tpl.New("none").Parse(noneTpl))
+
+	template.Must(tpl.New("float").Parse(numTpl))
+	template.Must(tpl.New("floatConst").Parse(numConstTpl))
+	template.Must(tpl.New("double").Parse(numTpl))
+	template.Must(tpl.New("doubleConst").Parse(numConstTpl))
+	template.Must(tpl.New("int32").Parse(numTpl))
+	template.Must(tpl.New("int32Const").Parse(numConstTpl))
+	template.Must(tpl.New("int64").Parse(numTpl))
+	template.Must(tpl.New("int64Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint32").Parse(numTpl))
+	template.Must(tpl.New("uint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint64").Parse(numTpl))
+	template.Must(tpl.New("uint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint32").Parse(numTpl))
+	template.Must(tpl.New("sint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint64").Parse(numTpl))
+	template.Must(tpl.New("sint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed32").Parse(numTpl))
+	template.Must(tpl.New("fixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed64").Parse(numTpl))
+	template.Must(tpl.New("fixed64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed32").Parse(numTpl))
+	template.Must(tpl.New("sfixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed64").Parse(numTpl))
+	template.Must(tpl.New("sfixed64Const").Parse(numConstTpl))
+
+	template.Must(tpl.New("bool").Parse(boolTpl))
+	template.Must(tpl.New("string").Parse(stringTpl))
+	template.Must(tpl.New("stringConst").Parse(stringConstTpl))
+	template.Must(tpl.New("bytes").Parse(bytesTpl))
+	template.Must(tpl.New("bytesConst").Parse(bytesConstTpl))
+
+	template.Must(tpl.New("any").Parse(anyTpl))
+	template.Must(tpl.New("anyConst").Parse(anyConstTpl))
+	template.Must(tpl.New("enum").Parse(enumTpl))
+	template.Must(tpl.New("enumConst").Parse(enumConstTpl))
+	template.Must(tpl.New("message").Parse(messageTpl))
+	template.Must(tpl.New("repeated").Parse(repeatedTpl))
+	template.Must(tpl.New("repeatedConst").Parse(repeatedConstTpl))
+	template.Must(tpl.New("map").Parse(mapTpl))
+	template.Must(tpl.New("mapConst").Parse(mapConstTpl))
+	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
+	template.Must(tpl.New("oneOfConst").Parse(oneOfConstTpl))
+
+	template.Must(tpl.New("timestamp").Parse(timestampTpl))
+	template.Must(tpl.New("duration").Parse(durationTpl))
+	template.Must(tpl.New("durationConst").Parse(durationConstTpl))
+	template.Must(tpl.New("wrapper").Parse(wrapperTpl))
+	template.Must(tpl.New("wrapperConst").Parse(wrapperConstTpl))
+}
+
+type goFuncs struct {
+	pgsgo.Context
+	// errorImports caches the error import table of each file rendered with
+	// this template.
+	errorImports map[pgs.File]map[string]string
+}
+
+// CodeFormat runs gofmt over the rendered validators.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("gofmt generated code failed, %w", err)
+	}
+	_, err = out.Write(formatted)
+	return err
+}
+
+func GoFilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	out := ctx.OutputPath(f)
+	out = out.SetExt(".validate." + tpl.Name())
+	return &out
+}
+
+func (fns goFuncs) accessor(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride
+	}
+	return fmt.Sprintf("m.Get%s()", fns.Name(ctx.Field))
+}
+
+// validatedFields returns the fields of msg outside of a real oneof, proto3
+// optional fields included.
+func (fns goFuncs) validatedFields(msg pgs.Message) (out []pgs.Field) {
+	for _, f := range msg.Fields() {
+		if !f.InRealOneOf() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// hasCond renders the condition under which the scalar field of ctx with
+// explicit presence is set, empty for other fields.
+func (fns goFuncs) hasCond(ctx shared.RuleContext) string {
+	f := ctx.Field
+	if ctx.Typ == "none" || !f.HasPresence() || f.InRealOneOf() {
+		return ""
+	}
+	if t := f.Type(); t.IsEmbed() || t.IsRepeated() || t.IsMap() {
+		return ""
+	}
+	return fmt.Sprintf("m.%s != nil", fns.Name(f))
+}
+
+// failRequired renders the error of the required rule of the scalar field of
+// ctx, empty if it has none.
+func (fns goFuncs) failRequired(ctx shared.RuleContext) (string, error) {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil || !shared.ScalarRequired(&rules) {
+		return "", err
+	}
+	return fns.errorInitializer(ctx, shared.RequiredError(ctx.Rules))
+}
+
+func (fns goFuncs) msgTyp(msg pgs.Message) pgsgo.TypeName {
+	return pgsgo.TypeName(fns.Name(msg))
+}
+
+// constantName returns the name of a package level variable backing the rule
+// at index of ctx. The message name keeps them unique in the Go package.
+func (fns goFuncs) constantName(ctx shared.RuleContext, index int, rule string) string {
+	return fmt.Sprintf("_%s_%s%s_%d_%s", fns.Name(ctx.Field.Message()), fns.Name(ctx.Field), ctx.Index, index, rule)
+}
+
+func (fns goFuncs) enumName(ctx shared.RuleContext) string {
+	typ := fns.Type(ctx.Field)
+	if ctx.Field.Type().IsRepeated() || ctx.Field.Type().IsMap() {
+		return typ.Element().Value().String()
+	}
+	return typ.Value().String()
+}
+
+// elemType returns the Go type of the value validated by ctx, resolving map
+// keys and values, repeated items and wrapper types.
+func (fns goFuncs) elemType(ctx shared.RuleContext) pgsgo.TypeName {
+	t := ctx.Field.Type()
+	switch {
+	case t.IsMap() && ctx.AccessorOverride == "key":
+		return fns.Type(ctx.Field).Key()
+	case t.IsMap() || t.IsRepeated():
+		return fns.Type(ctx.Field).Element()
+	case t.IsEmbed() && t.Embed().IsWellKnown():
+		return scalarType(t.Embed().Fields()[0].Type().ProtoType())
+	default:
+		return fns.Type(ctx.Field).Value()
+	}
+}
+
+func scalarType(t pgs.ProtoType) pgsgo.TypeName {
+	switch t {
+	case pgs.DoubleT:
+		return "float64"
+	case pgs.FloatT:
+		return "float32"
+	case pgs.Int64T, pgs.SFixed64, pgs.SInt64:
+		return "int64"
+	case pgs.UInt64T, pgs.Fixed64T:
+		return "uint64"
+	case pgs.Int32T, pgs.SFixed32, pgs.SInt32:
+		return "int32"
+	case pgs.UInt32T, pgs.Fixed32T:
+		return "uint32"
+	case pgs.BoolT:
+		return "bool"
+	case pgs.StringT:
+		return "string"
+	default:
+		return "[]byte"
+	}
+}
+
+func (fns goFuncs) isBytes(ctx shared.RuleContext) bool {
+	t := ctx.Field.Type()
+	if t.IsMap() && ctx.AccessorOverride == "key" {
+		return t.Key().ProtoType() == pgs.BytesT
+	}
+	if t.IsRepeated() || t.IsMap() {
+		return t.Element().ProtoType() == pgs.BytesT
+	}
+	return t.ProtoType() == pgs.BytesT
+}
+
+func (fns goFuncs) lit(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case []byte:
+		return fns.byteStr(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (fns goFuncs) byteStr(b []byte) string {
+	return fmt.Sprintf("[]byte(%q)", b)
+}
+
+func (fns goFuncs) durLit(dur *durationpb.Duration) string {
+	return fmt.Sprintf("time.Duration(%d)", dur.AsDuration().Nanoseconds())
+}
+
+func (fns goFuncs) tsLit(ts *timestamppb.Timestamp) string {
+	return fmt.Sprintf("time.Unix(%d, %d)", ts.GetSeconds(), ts.GetNanos())
+}
+
+// rangeCond renders the condition under which value violates the lt/lte/gt/gte
+// bounds of a rule. Like the Java runtime, a lower bound above the upper bound
+// inverts the range into an exclusive one.
+func (fns goFuncs) rangeCond(value string, lt, lte, gt, gte interface{}) (string, error) {
+	ltLit, ltVal, hasLt, err := fns.bound(lt)
+	if err != nil {
+		return "", err
+	}
+	lteLit, lteVal, hasLte, err := fns.bound(lte)
+	if err != nil {
+		return "", err
+	}
+	gtLit, gtVal, hasGt, err := fns.bound(gt)
+	if err != nil {
+		return "", err
+	}
+	gteLit, gteVal, hasGte, err := fns.bound(gte)
+	if err != nil {
+		return "", err
+	}
+
+	var upper, lower string
+	var upperVal, lowerVal float64
+	switch {
+	case hasLt:
+		upper, upperVal = fmt.Sprintf("%s >= %s", value, ltLit), ltVal
+	case hasLte:
+		upper, upperVal = fmt.Sprintf("%s > %s", value, lteLit), lteVal
+	}
+	switch {
+	case hasGt:
+		lower, lowerVal = fmt.Sprintf("%s <= %s", value, gtLit), gtVal
+	case hasGte:
+		lower, lowerVal = fmt.Sprintf("%s < %s", value, gteLit), gteVal
+	}
+
+	switch {
+	case upper == "":
+		return lower, nil
+	case lower == "":
+		return upper, nil
+	case lowerVal <= upperVal:
+		return fmt.Sprintf("%s || %s", lower, upper), nil
+	}
+
+	// exclusive range: the value must fall outside of [upper, lower]
+	inUpper := fmt.Sprintf("%s > %s", value, lteLit)
+	if hasLt {
+		inUpper = fmt.Sprintf("%s >= %s", value, ltLit)
+	}
+	inLower := fmt.Sprintf("%s < %s", value, gteLit)
+	if hasGt {
+		inLower = fmt.Sprintf("%s <= %s", value, gtLit)
+	}
+	return fmt.Sprintf("%s && %s", inUpper, inLower), nil
+}
+
+// bound resolves an optional rule bound into its Go literal and a numeric
+// value used to order the bounds of a range.
+func (fns goFuncs) bound(v interface{}) (lit string, val float64, ok bool, err error) {
+	switch b := v.(type) {
+	case *durationpb.Duration:
+		if b == nil {
+			return
+		}
+		return fns.durLit(b), float64(b.AsDuration()), true, nil
+	case *timestamppb.Timestamp:
+		if b == nil {
+			return
+		}
+		nanos := b.AsTime().UnixNano()
+		return fmt.Sprintf("int64(%d)", nanos), float64(nanos), true, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Ptr {
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	if rv.IsNil() {
+		return
+	}
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Int32, reflect.Int64:
+		val = float64(rv.Int())
+	case reflect.Uint32, reflect.Uint64:
+		val = float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		val = rv.Float()
+	default:
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	return fmt.Sprint(rv.Interface()), val, true, nil
+}
+
+func (fns goFuncs) unwrap(ctx shared.RuleContext) (shared.RuleContext, error) {
+	ctx, err := ctx.Unwrap("wrapper")
+	if err != nil {
+		return ctx, err
+	}
+	ctx.AccessorOverride = "wrapper.GetValue()"
+	return ctx, nil
+}
+
+func (fns goFuncs) renderConstants(tpl *template.Template) func(ctx shared.RuleContext) (string, error) {
+	return func(ctx shared.RuleContext) (string, error) {
+		var b bytes.Buffer
+		var err error
+
+		if t := tpl.Lookup(ctx.Typ + "Const"); t != nil {
+			err = t.Execute(&b, ctx)
+		}
+
+		return b.String(), err
+	}
+}
+
+// errorInitializer renders the call constructing the error of a rule. Nested
+// item, key and value rules without their own Error fall back to the Error of
+// the enclosing repeated or map rule at ctx.ErrIndex.
+func (fns goFuncs) errorInitializer(ctx shared.RuleContext, e *validate.Error) (string, error) {
+	if e == nil && ctx.AccessorOverride != "" {
+		e = parentError(ctx)
+	}
+	args, err := shared.FieldErrorArgs(ctx.Field, e)
+	if err != nil {
+		return "", err
+	}
+	return fns.errorCall(ctx.Field.File(), ctx.ErrBase, e, args, fns.accessor(ctx))
+}
+
+func (fns goFuncs) errorOneOf(msg pgs.Message, oo pgs.OneOf) (string, error) {
+	base, err := errorBase(msg)
+	if err != nil {
+		return "", err
+	}
+	rule, err := shared.OneOfRule(oo)
+	if err != nil {
+		return "", err
+	}
+	args, err := shared.ErrorArgs(oo.Name().String(), rule.ProtoReflect(), rule.GetError())
+	if err != nil {
+		return "", err
+	}
+	return fns.errorCall(msg.File(), base, rule.GetError(), args, "")
+}
+
+// errorCall renders the call creating e; value is the expression of the
+// validated value, if any.
+func (fns goFuncs) errorCall(f pgs.File, base *validate.ErrorBase, e *validate.Error, args []shared.ErrorArg, value string) (string, error) {
+	pkg, class := resolveErrorTarget(base, e)
+
+	buf := strings.Builder{}
+	if len(pkg) > 0 {
+		buf.WriteString(fns.errorImportTable(f)[pkg] + ".")
+	}
+	if len(class) > 0 {
+		buf.WriteString(strcase.ToCamel(class) + ".")
+	}
+	buf.WriteString(strcase.ToCamel(e.GetMethod()))
+	buf.WriteByte('(')
+	for i, arg := range args {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		switch arg.Kind {
+		case shared.IntArg:
+			buf.WriteString(strconv.FormatInt(arg.Int, 10))
+		case shared.UintArg:
+			buf.WriteString(strconv.FormatUint(arg.Uint, 10))
+		case shared.FloatArg:
+			buf.WriteString(strconv.FormatFloat(arg.Float, 'g', -1, 64))
+		case shared.BoolArg:
+			buf.WriteString(strconv.FormatBool(arg.Bool))
+		case shared.RefArg:
+			buf.WriteString(arg.Str)
+		case shared.ValueArg:
+			if value == "" {
+				return "", fmt.Errorf("error %q: $value is not available here", e.GetMethod())
+			}
+			buf.WriteString(value)
+		default:
+			buf.WriteString(strconv.Quote(arg.Str))
+		}
+	}
+	buf.WriteByte(')')
+	return buf.String(), nil
+}
+
+// enumImports returns the packages of enums from other Go packages that need
+// a `_name` lookup for `defined_only` rules, keyed by import path.
+func (fns goFuncs) enumImports(f pgs.File) map[pgs.FilePath]pgs.Name {
+	out := map[pgs.FilePath]pgs.Name{}
+	for _, msg := range f.AllMessages() {
+		for _, fld := range msg.Fields() {
+			var en pgs.Enum
+			switch t := fld.Type(); {
+			case t.IsEnum():
+				en = t.Enum()
+			case (t.IsRepeated() || t.IsMap()) && t.Element().IsEnum():
+				en = t.Element().Enum()
+			default:
+				continue
+			}
+			if fns.ImportPath(en) != fns.ImportPath(f) {
+				out[fns.ImportPath(en)] = fns.PackageName(en)
+			}
+		}
+	}
+	return out
+}
+
+func hasItems(rules *validate.RepeatedRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetItems() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasValues(rules *validate.MapRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetValues() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveErrorTarget(base *validate.ErrorBase, e *validate.Error) (pkg, class string) {
+	if base != nil {
+		pkg = base.GetPkg()
+		class = base.GetClass()
+	}
+	if len(e.GetPkg()) > 0 {
+		pkg = e.GetPkg()
+	}
+	if len(e.GetClass()) > 0 {
+		class = e.GetClass()
+	}
+	return
+}
+
+func errorBase(msg pgs.Message) (base *validate.ErrorBase, err error) {
+	_, err = msg.Extension(validate.E_ErrorBase, &base)
+	return
+}
+
+func parentError(ctx shared.RuleContext) *validate.Error {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	switch {
+	case rules.GetRepeated() != nil:
+		if rs := rules.GetRepeated().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	case rules.GetMap() != nil:
+		if rs := rules.GetMap().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	}
+	return nil
+}
+
+// reservedAliases are identifiers already bound in generated files.
+var reservedAliases = map[string]bool{
+	"bytes": true, "errors": true, "fmt": true, "net": true, "mail": true, "url": true,
+	"regexp": true, "sort": true, "strings": true, "time": true, "utf8": true, "anypb": true,
+	"m": true, "err": true, "all": true,
+}
+
+// errorImportTable assigns a unique alias to every error package referenced by
+// the rules of f, keyed by import path.
+func (fns goFuncs) errorImportTable(f pgs.File) map[string]string {
+	if table, ok := fns.errorImports[f]; ok {
+		return table
+	}
+
+	pkgs := map[string]struct{}{}
+	for _, msg := range f.AllMessages() {
+		base, _ := errorBase(msg)
+		for _, e := range shared.MessageErrors(msg) {
+			if pkg, _ := resolveErrorTarget(base, e); pkg != "" {
+				pkgs[pkg] = struct{}{}
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(pkgs))
+	for p := range pkgs {
+		paths = append(paths, p)
+	}
+	table := importAliases(paths)
+	fns.errorImports[f] = table
+	return table
+}
+
+// importAliases assigns a unique alias to every import path of paths, which
+// doesn't shadow the identifiers of generated files. Aliases taken by an
+// earlier path in sort order get a numeric suffix.
+func importAliases(paths []string) map[string]string {
+	sort.Strings(paths)
+	table := make(map[string]string, len(paths))
+	used := map[string]bool{}
+	for _, p := range paths {
+		base := importAlias(p)
+		if reservedAliases[base] {
+			base += "pkg"
+		}
+		alias := base
+		for n := 1; used[alias]; n++ {
+			alias = fmt.Sprintf("%s%d", base, n)
+		}
+		used[alias] = true
+		table[p] = alias
+	}
+	return table
+}
+
+// importAlias derives a Go identifier from the last element of importPath,
+// prefixed with pkg if it would not start with a letter.
+func importAlias(importPath string) string {
+	base := path.Base(importPath)
+	if i := strings.LastIndex(base, "."); i >= 0 {
+		base = base[i+1:]
+	}
+	alias := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return -1
+		}
+	}, base)
+	if alias == "" || alias[0] >= '0' && alias[0] <= '9' {
+		alias = "pkg" + alias
+	}
+	return alias
+}
@@ -0,0 +1,45 @@
+package golang
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestImportAlias(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"example.com/acme/errors", "errors"},
+		{"example.com/acme/user_errs", "usererrs"},
+		{"gopkg.in/errs.v2", "v2"},
+		{"example.com/acme/2fa", "pkg2fa"},
+		{"example.com/acme/_", "pkg"},
+	}
+	for _, tt := range tests {
+		if got := importAlias(tt.in); got != tt.want {
+			t.Errorf("importAlias(%q) = %q; want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestImportAliases(t *testing.T) {
+	got := importAliases([]string{
+		"example.com/x/errorspkg",
+		"example.com/other/errors",
+		"example.com/acme/errors",
+		"example.com/acme/2fa",
+		"example.com/acme/fmt",
+		"example.com/acme/codes",
+	})
+	want := map[string]string{
+		"example.com/acme/2fa":     "pkg2fa",
+		"example.com/acme/codes":   "codes",
+		"example.com/acme/errors":  "errorspkg",
+		"example.com/acme/fmt":     "fmtpkg",
+		"example.com/other/errors": "errorspkg1",
+		"example.com/x/errorspkg":  "errorspkg2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("importAliases = %v; want %v", got, want)
+	}
+}
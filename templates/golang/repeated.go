@@ -0,0 +1,59 @@
+package golang
+
+const repeatedConstTpl = `{{ renderConstants (.Elem "" "") }}
+{{- $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetUnique }}
+func {{ constantName $ctx $index "Unique" }}(items {{ typ $ctx.Field }}) bool {
+	seen := make(map[interface{}]struct{}, len(items))
+	for _, item := range items {
+		key := interface{}(item)
+		{{- if isBytes $ctx }}
+		key = string(item)
+		{{- end }}
+		if _, exists := seen[key]; exists {
+			return false
+		}
+		seen[key] = struct{}{}
+	}
+	return true
+}
+{{ end -}}
+{{- end -}}
+`
+
+const repeatedTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if len({{ accessor $ctx }}) > 0 {
+{{- end }}
+	switch {
+	{{- if $r.MinItems }}
+	case len({{ accessor $ctx }}) < {{ $r.GetMinItems }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MaxItems }}
+	case len({{ accessor $ctx }}) > {{ $r.GetMaxItems }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetUnique }}
+	case !{{ constantName $ctx $index "Unique" }}({{ accessor $ctx }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	}
+	{{- if $r.GetItems }}
+
+	for idx, item := range {{ accessor $ctx }} {
+		_, _ = idx, item
+		{{ render ($ctx.ElemWithErrIndex "item" "" $index) }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasItems .Rules)) (eq (.Elem "" "").Typ "message") }}
+	for idx, item := range {{ accessor . }} {
+		_, _ = idx, item
+		{{ render (.Elem "item" "") }}
+	}
+{{- end }}
+`
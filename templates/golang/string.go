@@ -0,0 +1,126 @@
+package golang
+
+const stringConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+var {{ constantName $ctx $index "InLookup" }} = map[string]bool{
+	{{- range $r.In }}
+	{{ lit . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.NotIn }}
+var {{ constantName $ctx $index "NotInLookup" }} = map[string]bool{
+	{{- range $r.NotIn }}
+	{{ lit . }}: true,
+	{{- end }}
+}
+{{ end -}}
+{{- if $r.Pattern }}
+var {{ constantName $ctx $index "Pattern" }} = regexp.MustCompile({{ lit $r.GetPattern }})
+{{ end -}}
+{{- end -}}
+`
+
+const stringTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if {{ accessor $ctx }} != "" {
+{{- end }}
+	switch {
+	{{- if $r.Const }}
+	case {{ accessor $ctx }} != {{ lit $r.GetConst }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName $ctx $index "InLookup" }}[{{ accessor $ctx }}]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName $ctx $index "NotInLookup" }}[{{ accessor $ctx }}]:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Len }}
+	case utf8.RuneCountInString({{ accessor $ctx }}) != {{ $r.GetLen }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	case utf8.RuneCountInString({{ accessor $ctx }}) < {{ $r.GetMinLen }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	case utf8.RuneCountInString({{ accessor $ctx }}) > {{ $r.GetMaxLen }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.LenBytes }}
+	case len({{ accessor $ctx }}) != {{ $r.GetLenBytes }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MinBytes }}
+	case len({{ accessor $ctx }}) < {{ $r.GetMinBytes }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.MaxBytes }}
+	case len({{ accessor $ctx }}) > {{ $r.GetMaxBytes }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	case !{{ constantName $ctx $index "Pattern" }}.MatchString({{ accessor $ctx }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	case !strings.HasPrefix({{ accessor $ctx }}, {{ lit $r.GetPrefix }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	case !strings.HasSuffix({{ accessor $ctx }}, {{ lit $r.GetSuffix }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Contains }}
+	case !strings.Contains({{ accessor $ctx }}, {{ lit $r.GetContains }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.NotContains }}
+	case strings.Contains({{ accessor $ctx }}, {{ lit $r.GetNotContains }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetEmail }}
+	case m._validateEmail({{ accessor $ctx }}) != nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetAddress }}
+	case net.ParseIP({{ accessor $ctx }}) == nil && m._validateHostname({{ accessor $ctx }}) != nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetHostname }}
+	case m._validateHostname({{ accessor $ctx }}) != nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	case net.ParseIP({{ accessor $ctx }}) == nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	case net.ParseIP({{ accessor $ctx }}).To4() == nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	case net.ParseIP({{ accessor $ctx }}) == nil || net.ParseIP({{ accessor $ctx }}).To4() != nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetUri }}
+	case func() bool { u, err := url.Parse({{ accessor $ctx }}); return err != nil || !u.IsAbs() }():
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetUriRef }}
+	case func() bool { _, err := url.Parse({{ accessor $ctx }}); return err != nil }():
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetUuid }}
+	case m._validateUuid({{ accessor $ctx }}) != nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
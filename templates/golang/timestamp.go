@@ -0,0 +1,36 @@
+package golang
+
+const timestampTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	switch ts := {{ accessor $ctx }}; {
+	{{- if $r.GetRequired }}
+	case ts == nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- else }}
+	case ts == nil:
+		// not set, nothing to validate
+	{{- end }}
+	case ts.CheckValid() != nil:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- if $r.Const }}
+	case !ts.AsTime().Equal({{ tsLit $r.GetConst }}):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- with rangeCond "ts.AsTime().UnixNano()" $r.Lt $r.Lte $r.Gt $r.Gte }}
+	case {{ . }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetLtNow }}
+	case !ts.AsTime().Before(time.Now()):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.GetGtNow }}
+	case !ts.AsTime().After(time.Now()):
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	{{- if $r.Within }}
+	case time.Since(ts.AsTime()) > {{ durLit $r.GetWithin }} || time.Until(ts.AsTime()) > {{ durLit $r.GetWithin }}:
+		{{- template "err" (error $ctx $r.GetError) }}
+	{{- end }}
+	}
+{{ end -}}
+`
@@ -0,0 +1,13 @@
+package golang
+
+const wrapperConstTpl = `{{ renderConstants (unwrap .) }}`
+
+const wrapperTpl = `
+	if wrapper := {{ accessor . }}; wrapper != nil {
+		{{ render (unwrap .) }}
+	}
+	{{- if .MessageRules.GetRequired }} else {
+		{{- template "err" (error . .MessageRules.GetError) }}
+	}
+	{{- end }}
+`
@@ -1,39 +1,39 @@
 package java
 
-const anyConstTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules }}
+const anyConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
 {{- if $r.In }}
-	private final String[] {{ constantName $ctx "In" }} = new String[]{
-		{{- range $r.In }}
-		"{{ . }}",
-		{{- end }}
-	};
+	{{ array (constantName $ctx "In") "String" (lits $r.In) }}
 {{- end -}}
 {{- if $r.NotIn }}
-	private final String[] {{ constantName $ctx "NotIn" }} = new String[]{
-		{{- range $r.NotIn }}
-		"{{ . }}",
-		{{- end }}
-	};
+	{{ array (constantName $ctx "NotIn") "String" (lits $r.NotIn) }}
 {{- end -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}
 `
 
-const anyTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules }}
-	{{- if $r.GetRequired }}
-		if ({{ hasAccessor $ctx }}) {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, {{ accessor $ctx }});
-		} else {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null);
-		};
-	{{- end -}}
-	{{- if $r.In }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.CollectiveValidation.in({{ errorName $ctx $index }}, {{ accessor $ctx }}.getTypeUrl(), {{ constantName $ctx "In" }});
-	{{- end -}}
-	{{- if $r.NotIn }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}.getTypeUrl(), {{ constantName $ctx "NotIn" }});
-	{{- end -}}
-	{{- end -}}
+const anyTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
+{{- if $r.GetRequired }}
+		if (!{{ hasAccessor $ctx }}) {{ check $ctx "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null){{ endCheck }}
+{{- end -}}
+{{- if $r.In }}
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "in" }}cn.spaceli.pgv.CollectiveValidation.{{ ident "in" }}({{ errorName $ctx $index }}, {{ accessor $ctx }}.getTypeUrl(), {{ constantName $ctx "In" }}){{ endCheck }}
+{{- end -}}
+{{- if $r.NotIn }}
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "not_in" }}cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}.getTypeUrl(), {{ constantName $ctx "NotIn" }}){{ endCheck }}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
+{{- end -}}
+{{- end -}}
 `
@@ -2,11 +2,32 @@ package java
 
 const boolConstTpl = `
 {{- if .Rules.Error }}
-	private final RuntimeException {{ errorName . 0 }} = {{ error . .Rules.Error }};
+	{{ constant (errorName . 0) errorType (error . .Rules.Error) }}
 {{- end -}}
 `
 
-const boolTpl = `{{ $f := .Field }}{{ $r := .Rules -}}
+const boolTpl = `{{ $r := .Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when . $r.GetWhen }}) {
+{{- end -}}
+{{- if $r.GetRequired }}
+		if (!{{ hasAccessor . }}) {{ check . "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, null){{ endCheck }}
+{{- end -}}
+{{- if optional . }}
+		if ({{ hasAccessor . }}) {
+{{- end -}}
 {{- if $r.Const }}
-			cn.spaceli.pgv.ConstantValidation.constant({{ errorName . 0 }}, {{ accessor . }}, {{ $r.GetConst }});
+			{{ check . "const" }}cn.spaceli.pgv.ConstantValidation.constant({{ errorName . 0 }}, {{ accessor . }}, {{ $r.GetConst }}){{ endCheck }}
+{{- end }}
+{{- if optional . }}
+		}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
 {{- end }}`
@@ -1,86 +1,99 @@
 package java
 
-const bytesConstTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const bytesConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
 {{- if $r.Const }}
-	private final com.google.protobuf.ByteString {{ constantName $ctx "Const" }} = com.google.protobuf.ByteString.copyFrom({{ byteArrayLit $r.GetConst }});
+	{{ constant (constantName $ctx "Const") "com.google.protobuf.ByteString" (lit $r.GetConst) }}
 {{- end -}}
 {{- if $r.In }}
-	private final com.google.protobuf.ByteString[] {{ constantName $ctx "In" }} = new com.google.protobuf.ByteString[]{
-		{{- range $r.In }}
-		com.google.protobuf.ByteString.copyFrom({{ byteArrayLit . }}),
-		{{- end }}
-	};
+	{{ array (constantName $ctx "In") "com.google.protobuf.ByteString" (lits $r.In) }}
 {{- end -}}
 {{- if $r.NotIn }}
-	private final com.google.protobuf.ByteString[] {{ constantName $ctx "NotIn" }} = new com.google.protobuf.ByteString[]{
-		{{- range $r.NotIn }}
-		com.google.protobuf.ByteString.copyFrom({{ byteArrayLit . }}),
-		{{- end }}
-	};
+	{{ array (constantName $ctx "NotIn") "com.google.protobuf.ByteString" (lits $r.NotIn) }}
 {{- end -}}
 {{- if $r.Pattern }}
-	private final com.google.re2j.Pattern {{ constantName $ctx "Pattern" }} = com.google.re2j.Pattern.compile({{ javaStringEscape $r.GetPattern }});
+	{{ constant (constantName $ctx "Pattern") "com.google.re2j.Pattern" (printf "com.google.re2j.Pattern.compile(%s)" (stringLit $r.GetPattern)) }}
 {{- end -}}
 {{- if $r.Prefix }}
-	private final byte[] {{ constantName $ctx "Prefix" }} = {{ byteArrayLit $r.GetPrefix }};
+	{{ constant (constantName $ctx "Prefix") byteArrayType (bytesLit $r.GetPrefix) }}
 {{- end -}}
 {{- if $r.Contains }}
-	private final byte[] {{ constantName $ctx "Contains" }} = {{ byteArrayLit $r.GetContains }};
+	{{ constant (constantName $ctx "Contains") byteArrayType (bytesLit $r.GetContains) }}
 {{- end -}}
 {{- if $r.Suffix }}
-	private final byte[] {{ constantName $ctx "Suffix" }} = {{ byteArrayLit $r.GetSuffix }};
+	{{ constant (constantName $ctx "Suffix") byteArrayType (bytesLit $r.GetSuffix) }}
 {{- end -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}
 `
 
-const bytesTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const bytesTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
+{{- if $r.GetRequired }}
+		if (!{{ hasAccessor $ctx }}) {{ check $ctx "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null){{ endCheck }}
+{{- end -}}
+{{- if and (optional $ctx) (constrained $r) }}
+		if ({{ hasAccessor $ctx }}) {
+{{- end -}}
 {{- if $r.GetIgnoreEmpty }}
-			if ( !{{ accessor $ctx }}.isEmpty() ) {
+		if (!{{ accessor $ctx }}.isEmpty()) {
 {{- end -}}
 {{- if $r.Const }}
-			cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }});
+			{{ check $ctx "const" }}cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Len }}
-			cn.spaceli.pgv.BytesValidation.length({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetLen }});
+			{{ check $ctx "len" }}cn.spaceli.pgv.BytesValidation.length({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetLen }}){{ endCheck }}
 {{- end -}}
 {{- if $r.MinLen }}
-			cn.spaceli.pgv.BytesValidation.minLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinLen }});
+			{{ check $ctx "min_len" }}cn.spaceli.pgv.BytesValidation.minLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinLen }}){{ endCheck }}
 {{- end -}}
 {{- if $r.MaxLen }}
-			cn.spaceli.pgv.BytesValidation.maxLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxLen }});
+			{{ check $ctx "max_len" }}cn.spaceli.pgv.BytesValidation.maxLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxLen }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Pattern }}
-			cn.spaceli.pgv.BytesValidation.pattern({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Pattern" }});
+			{{ check $ctx "pattern" }}cn.spaceli.pgv.BytesValidation.pattern({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Pattern" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Prefix }}
-			cn.spaceli.pgv.BytesValidation.prefix({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Prefix" }});
+			{{ check $ctx "prefix" }}cn.spaceli.pgv.BytesValidation.prefix({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Prefix" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Contains }}
-			cn.spaceli.pgv.BytesValidation.contains({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Contains" }});
+			{{ check $ctx "contains" }}cn.spaceli.pgv.BytesValidation.contains({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Contains" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Suffix }}
-			cn.spaceli.pgv.BytesValidation.suffix({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Suffix" }});
+			{{ check $ctx "suffix" }}cn.spaceli.pgv.BytesValidation.suffix({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Suffix" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIp }}
-			cn.spaceli.pgv.BytesValidation.ip({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "ip" }}cn.spaceli.pgv.BytesValidation.ip({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIpv4 }}
-			cn.spaceli.pgv.BytesValidation.ipv4({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "ipv4" }}cn.spaceli.pgv.BytesValidation.ipv4({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIpv6 }}
-			cn.spaceli.pgv.BytesValidation.ipv6({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "ipv6" }}cn.spaceli.pgv.BytesValidation.ipv6({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.In }}
-			cn.spaceli.pgv.CollectiveValidation.in({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }});
+			{{ check $ctx "in" }}cn.spaceli.pgv.CollectiveValidation.{{ ident "in" }}({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.NotIn }}
-			cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }});
+			{{ check $ctx "not_in" }}cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIgnoreEmpty }}
-			}
+		}
+{{- end -}}
+{{- if and (optional $ctx) (constrained $r) }}
+		}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
 {{- end -}}
 {{- end -}}
 `
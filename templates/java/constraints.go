@@ -0,0 +1,65 @@
+package java
+
+const constraintsTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+
+package {{ javaPackage . }};
+
+/**
+ * Jakarta Bean Validation constraints of the messages of {@code {{ .InputPath }}}, delegating to their generated
+ * validators. Register them with {@link #addMappings} for {@code @Valid} to validate the messages, or annotate a
+ * parameter with the constraint of its message.
+ */
+@SuppressWarnings("all")
+public final class {{ classNameFile . }}Constraints {
+	private {{ classNameFile . }}Constraints() {
+	}
+
+	/**
+	 * Adds the constraints of the messages of {@code {{ .InputPath }}} to {@code configuration}.
+	 */
+	public static void addMappings(jakarta.validation.Configuration<?> configuration) {
+		configuration.addMapping(new java.io.ByteArrayInputStream(MAPPINGS.getBytes(java.nio.charset.StandardCharsets.UTF_8)));
+	}
+
+	private static final String MAPPINGS = "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n"
+		+ "<constraint-mappings xmlns=\"https://jakarta.ee/xml/ns/validation/mapping\" xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xsi:schemaLocation=\"https://jakarta.ee/xml/ns/validation/mapping https://jakarta.ee/xml/ns/validation/validation-mapping-3.0.xsd\" version=\"3.0\">\n"
+{{- range .AllMessages }}{{ if not (ignored .) }}
+		+ "  <bean class=\"{{ binaryName . }}\" ignore-annotations=\"true\">\n"
+		+ "    <class ignore-annotations=\"true\">\n"
+		+ "      <constraint annotation=\"{{ javaPackage .File }}.{{ classNameFile .File }}Constraints$Valid{{ simpleName . }}\"/>\n"
+		+ "    </class>\n"
+		+ "  </bean>\n"
+{{- end }}{{ end }}
+		+ "</constraint-mappings>\n";
+{{ range .AllMessages }}{{ if not (ignored .) }}
+	/**
+	 * Validates {@code {{ simpleName . }}} protobuf objects with their generated validator.
+	 */
+	@jakarta.validation.Constraint(validatedBy = {{ simpleName . }}ConstraintValidator.class)
+	@java.lang.annotation.Target({
+		java.lang.annotation.ElementType.TYPE,
+		java.lang.annotation.ElementType.METHOD,
+		java.lang.annotation.ElementType.FIELD,
+		java.lang.annotation.ElementType.PARAMETER,
+		java.lang.annotation.ElementType.TYPE_USE,
+		java.lang.annotation.ElementType.ANNOTATION_TYPE,
+	})
+	@java.lang.annotation.Retention(java.lang.annotation.RetentionPolicy.RUNTIME)
+	@java.lang.annotation.Documented
+	public @interface Valid{{ simpleName . }} {
+		String message() default "invalid {{ qualifiedName . }}";
+
+		Class<?>[] groups() default {};
+
+		Class<? extends jakarta.validation.Payload>[] payload() default {};
+	}
+
+	public static class {{ simpleName . }}ConstraintValidator extends cn.spaceli.pgv.jakarta.ProtoConstraintValidator<Valid{{ simpleName . }}, {{ qualifiedName . }}> {
+		public {{ simpleName . }}ConstraintValidator() {
+			super({{ validatorClass . }}.validatorFor({{ qualifiedName . }}.class));
+		}
+	}
+{{ end }}{{ end -}}
+}
+`
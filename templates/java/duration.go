@@ -1,73 +1,73 @@
 package java
 
-const durationConstTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const durationConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
 {{- if $r.Const }}
-		private final com.google.protobuf.Duration {{ constantName $ctx "Const" }} = {{ durLit $r.GetConst }};
+	{{ constant (constantName $ctx "Const") "com.google.protobuf.Duration" (lit $r.GetConst) }}
 {{- end -}}
 {{- if $r.Lt }}
-		private final com.google.protobuf.Duration {{ constantName $ctx "Lt" }} = {{ durLit $r.GetLt }};
+	{{ constant (constantName $ctx "Lt") "com.google.protobuf.Duration" (lit $r.GetLt) }}
 {{- end -}}
 {{- if $r.Lte }}
-		private final com.google.protobuf.Duration {{ constantName $ctx "Lte" }} = {{ durLit $r.GetLte }};
+	{{ constant (constantName $ctx "Lte") "com.google.protobuf.Duration" (lit $r.GetLte) }}
 {{- end -}}
 {{- if $r.Gt }}
-		private final com.google.protobuf.Duration {{ constantName $ctx "Gt" }} = {{ durLit $r.GetGt }};
+	{{ constant (constantName $ctx "Gt") "com.google.protobuf.Duration" (lit $r.GetGt) }}
 {{- end -}}
 {{- if $r.Gte }}
-		private final com.google.protobuf.Duration {{ constantName $ctx "Gte" }} = {{ durLit $r.GetGte }};
+	{{ constant (constantName $ctx "Gte") "com.google.protobuf.Duration" (lit $r.GetGte) }}
 {{- end -}}
 {{- if $r.In }}
-		private final com.google.protobuf.Duration[] {{ constantName $ctx "In" }} = new com.google.protobuf.Duration[]{
-			{{- range $r.In }}
-			{{ durLit . }},
-			{{- end }}
-		};
+	{{ array (constantName $ctx "In") "com.google.protobuf.Duration" (lits $r.In) }}
 {{- end -}}
 {{- if $r.NotIn }}
-		private final com.google.protobuf.Duration[] {{ constantName $ctx "NotIn" }} = new com.google.protobuf.Duration[]{
-			{{- range $r.NotIn }}
-			{{ durLit . }},
-			{{- end }}
-		};
+	{{ array (constantName $ctx "NotIn") "com.google.protobuf.Duration" (lits $r.NotIn) }}
 {{- end -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}
 `
 
-const durationTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const durationTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
 {{- if $r.GetRequired }}
-		if ({{ hasAccessor $ctx }}) {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, {{ accessor $ctx }});
-		} else {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null);
-		};
+		if (!{{ hasAccessor $ctx }}) {{ check $ctx "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null){{ endCheck }}
 {{- end -}}
 {{- if $r.Const }}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }});
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "const" }}cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }}){{ endCheck }}
 {{- end -}}
 {{- if and (or $r.Lt $r.Lte) (or $r.Gt $r.Gte)}}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.range({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ if $r.Lt }}{{ constantName $ctx "Lt" }}{{ else }}null{{ end }}, {{ if $r.Lte }}{{ constantName $ctx "Lte" }}{{ else }}null{{ end }}, {{ if $r.Gt }}{{ constantName $ctx "Gt" }}{{ else }}null{{ end }}, {{ if $r.Gte }}{{ constantName $ctx "Gte" }}{{ else }}null{{ end }}, com.google.protobuf.util.Durations.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "range" }}cn.spaceli.pgv.ComparativeValidation.range({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ if $r.Lt }}{{ constantName $ctx "Lt" }}{{ else }}null{{ end }}, {{ if $r.Lte }}{{ constantName $ctx "Lte" }}{{ else }}null{{ end }}, {{ if $r.Gt }}{{ constantName $ctx "Gt" }}{{ else }}null{{ end }}, {{ if $r.Gte }}{{ constantName $ctx "Gte" }}{{ else }}null{{ end }}, com.google.protobuf.util.Durations.comparator()){{ endCheck }}
 {{- else -}}
 {{- if $r.Lt }}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lt" }}, com.google.protobuf.util.Durations.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "lt" }}cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lt" }}, com.google.protobuf.util.Durations.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.Lte }}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.lessThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lte" }}, com.google.protobuf.util.Durations.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "lte" }}cn.spaceli.pgv.ComparativeValidation.lessThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lte" }}, com.google.protobuf.util.Durations.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.Gt }}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gt" }}, com.google.protobuf.util.Durations.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "gt" }}cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gt" }}, com.google.protobuf.util.Durations.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.Gte }}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.greaterThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gte" }}, com.google.protobuf.util.Durations.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "gte" }}cn.spaceli.pgv.ComparativeValidation.greaterThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gte" }}, com.google.protobuf.util.Durations.comparator()){{ endCheck }}
 {{- end -}}
 {{- end -}}
 {{- if $r.In }}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.CollectiveValidation.in({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }});
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "in" }}cn.spaceli.pgv.CollectiveValidation.{{ ident "in" }}({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.NotIn }}
-		if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }});
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "not_in" }}cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }}){{ endCheck }}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
 {{- end -}}
 {{- end -}}
 `
@@ -1,37 +1,49 @@
 package java
 
-const enumConstTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ $r := .Rules -}}
+const enumConstTpl = `{{ $ctx := . }}{{ $r := .Rules -}}
 {{- if $r.In }}
-	private final {{ javaTypeFor . }}[] {{ constantName . "In" }} = new {{ javaTypeFor . }}[]{
-		{{- range $r.In }}
-		{{ javaTypeFor $ctx }}.forNumber({{- sprintf "%v" . -}}),
-		{{- end }}
-	};
+	{{ array (constantName . "In") (typeFor .) (enumLits . $r.In) }}
 {{- end -}}
 {{- if $r.NotIn }}
-	private final {{ javaTypeFor . }}[] {{ constantName . "NotIn" }} = new {{ javaTypeFor . }}[]{
-		{{- range $r.NotIn }}
-		{{ javaTypeFor $ctx }}.forNumber({{- sprintf "%v" . -}}),
-		{{- end }}
-	};
+	{{ array (constantName . "NotIn") (typeFor .) (enumLits . $r.NotIn) }}
 {{- end -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName . 0 }} = {{ error . $r.Error }};
+	{{ constant (errorName . 0) errorType (error . $r.Error) }}
 {{- end -}}
 `
 
-const enumTpl = `{{ $f := .Field }}{{ $r := .Rules -}}
+const enumTpl = `{{ $r := .Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when . $r.GetWhen }}) {
+{{- end -}}
+{{- if $r.GetRequired }}
+		if (!{{ hasAccessor . }}) {{ check . "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, null){{ endCheck }}
+{{- end -}}
+{{- if optional . }}
+		if ({{ hasAccessor . }}) {
+{{- end -}}
 {{- if $r.Const }}
-			cn.spaceli.pgv.ConstantValidation.constant({{ errorName . 0 }}, {{ accessor . }}, 
-				{{ javaTypeFor . }}.forNumber({{ $r.GetConst }}));
+			{{ check . "const" }}cn.spaceli.pgv.ConstantValidation.constant({{ errorName . 0 }}, {{ accessor . }}, {{ typeFor . }}.forNumber({{ $r.GetConst }})){{ endCheck }}
 {{- end -}}
 {{- if $r.GetDefinedOnly }}
-			cn.spaceli.pgv.EnumValidation.definedOnly({{ errorName . 0 }}, {{ accessor . }});
+			{{ check . "defined_only" }}cn.spaceli.pgv.EnumValidation.definedOnly({{ errorName . 0 }}, {{ accessor . }}){{ endCheck }}
 {{- end -}}
 {{- if $r.In }}
-			cn.spaceli.pgv.CollectiveValidation.in({{ errorName . 0 }}, {{ accessor . }}, {{ constantName . "In" }});
+			{{ check . "in" }}cn.spaceli.pgv.CollectiveValidation.{{ ident "in" }}({{ errorName . 0 }}, {{ accessor . }}, {{ constantName . "In" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.NotIn }}
-			cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName . 0 }}, {{ accessor . }}, {{ constantName . "NotIn" }});
+			{{ check . "not_in" }}cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName . 0 }}, {{ accessor . }}, {{ constantName . "NotIn" }}){{ endCheck }}
+{{- end -}}
+{{- if optional . }}
+		}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
 {{- end -}}
 `
@@ -0,0 +1,455 @@
+package java
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+const exprConstTpl = `{{ range exprRules . }}
+	{{ constant .ErrorName errorType .Error }}
+{{- end }}`
+
+const exprTpl = `{{ range exprRules . }}
+	{{ if .Groups }}if (violations.inGroups({{ .Groups }})) {{ end }}{{ .Check }}cn.spaceli.pgv.ExpressionValidation.holds({{ .ErrorName }}, {{ .Expr }}){{ endCheck }}
+{{- end }}`
+
+// javaExprRule is an expression rule of a message with its error and
+// expression rendered as Java or Kotlin.
+type javaExprRule struct {
+	ErrorName string
+	Error     string
+	Check     string
+	Expr      string
+	Groups    string
+}
+
+func (fns javaFuncs) exprRules(msg pgs.Message) ([]javaExprRule, error) {
+	rules, err := shared.CheckedExprRules(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]javaExprRule, 0, len(rules))
+	for i, r := range rules {
+		value, valueType := "proto", fns.qualifiedName(msg)
+		if r.Field != nil {
+			value, valueType = fns.fieldAccessor(r.Field), fns.fieldType(r.Field)
+		}
+		init, err := fns.newError(r.ErrBase, r.GetError(), r.Args, valueType)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, javaExprRule{
+			ErrorName: fmt.Sprintf("EXPR_%d_ERROR", i),
+			Error:     init,
+			Check:     fns.checkCall(r.GetField(), "message.expr", value),
+			Expr:      fns.expr(r.Expr),
+			Groups:    fns.groups(r),
+		})
+	}
+	return out, nil
+}
+
+// when renders the condition w of a rule on the field of ctx.
+func (fns javaFuncs) when(ctx shared.RuleContext, w *validate.When) (string, error) {
+	e, err := shared.WhenExpr(ctx.Field.Message(), w)
+	if err != nil {
+		return "", err
+	}
+	return fns.expr(e), nil
+}
+
+const exprRuntime = "cn.spaceli.pgv.ExpressionValidation"
+
+// expr renders a checked expression. Ints and uints are longs, the latter
+// compared and divided as unsigned, enum values are their numbers. Kotlin
+// doesn't widen numbers implicitly, so the operands of its comparisons and
+// arithmetic are converted to a common type.
+func (fns javaFuncs) expr(e shared.Expr) string {
+	switch e := e.(type) {
+	case *shared.LitExpr:
+		return fns.exprLit(e.Value)
+	case *shared.FieldExpr:
+		return fns.fieldExpr(e)
+	case *shared.HasExpr:
+		return fns.hasExpr(e)
+	case *shared.EnumValueExpr:
+		return strconv.Itoa(int(e.Value.Value()))
+	case *shared.VarExpr:
+		return fns.unsigned("_"+e.Name, e.Type())
+	case *shared.NowExpr:
+		return "cn.spaceli.pgv.TimestampValidation.currentTimestamp()"
+	case *shared.UnaryExpr:
+		if e.Type().Kind == shared.ExprDuration {
+			return exprRuntime + ".negate(" + fns.expr(e.X) + ")"
+		}
+		x := fns.expr(e.X)
+		if e.Op == "!" && strings.HasPrefix(x, "!") {
+			return x[1:]
+		}
+		return e.Op + x
+	case *shared.BinaryExpr:
+		return fns.binaryExpr(e)
+	case *shared.CondExpr:
+		if fns.kotlin {
+			return fmt.Sprintf("(if (%s) %s else %s)", fns.expr(e.Cond), fns.expr(e.Then), fns.expr(e.Else))
+		}
+		return fmt.Sprintf("(%s ? %s : %s)", fns.expr(e.Cond), fns.expr(e.Then), fns.expr(e.Else))
+	case *shared.ListExpr:
+		elems := make([]string, len(e.Elems))
+		for i, x := range e.Elems {
+			elems[i] = fns.expr(x)
+		}
+		if fns.kotlin {
+			return "listOf(" + strings.Join(elems, ", ") + ")"
+		}
+		return "java.util.Arrays.asList(" + strings.Join(elems, ", ") + ")"
+	case *shared.CallExpr:
+		return fns.callExpr(e)
+	case *shared.ComprehensionExpr:
+		return fns.comprehensionExpr(e)
+	}
+	panic(fmt.Sprintf("unexpected expression %T", e))
+}
+
+// exprLit renders the literal v, ints and uints as longs and doubles as
+// doubles, negative numbers in parentheses.
+func (fns javaFuncs) exprLit(v interface{}) string {
+	switch v := v.(type) {
+	case int64:
+		if v < 0 && v != math.MinInt64 {
+			return "(" + fns.lit(v) + ")"
+		}
+		if v == math.MinInt64 {
+			return "Long.MIN_VALUE"
+		}
+	case float64:
+		if v < 0 {
+			return "(" + fns.lit(v) + ")"
+		}
+	}
+	return fns.lit(v)
+}
+
+func javaGetter(f pgs.Field) string {
+	return upperCaseAfterNumber(strcase.ToCamel(f.Name().String()))
+}
+
+// openEnum reports whether the enum values of f are read as numbers, which
+// only proto3 generates accessors for.
+func openEnum(f pgs.Field) bool {
+	return f.Syntax() == pgs.Proto3
+}
+
+func (fns javaFuncs) fieldExpr(e *shared.FieldExpr) string {
+	recv := "proto"
+	if e.Operand != nil {
+		recv = fns.expr(e.Operand)
+	}
+	f, t := e.Field, e.Field.Type()
+	name := javaGetter(f)
+
+	switch {
+	case t.IsMap():
+		if t.Element().IsEnum() && openEnum(f) {
+			return fmt.Sprintf("%s.get%sValueMap()", recv, name)
+		}
+		return fmt.Sprintf("%s.get%sMap()", recv, name)
+	case t.IsRepeated():
+		if t.Element().IsEnum() {
+			switch {
+			case openEnum(f):
+				return fmt.Sprintf("%s.get%sValueList()", recv, name)
+			case fns.kotlin:
+				return fmt.Sprintf("%s.get%sList().map { it.getNumber() }", recv, name)
+			}
+			return fmt.Sprintf("%s.get%sList().stream().map(v -> v.getNumber()).collect(java.util.stream.Collectors.toList())", recv, name)
+		}
+		return fmt.Sprintf("%s.get%sList()", recv, name)
+	case t.IsEnum():
+		if openEnum(f) {
+			return fmt.Sprintf("%s.get%sValue()", recv, name)
+		}
+		return fmt.Sprintf("%s.get%s().getNumber()", recv, name)
+	case t.IsEmbed() && e.Type().Kind != shared.ExprMessage && e.Type().Proto != 0:
+		// a wrapper read as its value
+		return fns.unsigned(fmt.Sprintf("%s.get%s().getValue()", recv, name), e.Type())
+	}
+	return fns.unsigned(fmt.Sprintf("%s.get%s()", recv, name), e.Type())
+}
+
+// lang qualifies the class name of java.lang for Kotlin, whose Integer and
+// Long are not the ones of Java.
+func (fns javaFuncs) lang(name string) string {
+	if fns.kotlin {
+		return "java.lang." + name
+	}
+	return name
+}
+
+// unsigned widens 32 bit uints, held in ints, to longs.
+func (fns javaFuncs) unsigned(code string, t *shared.ExprType) string {
+	if t.Kind == shared.ExprUint && (t.Proto == pgs.UInt32T || t.Proto == pgs.Fixed32T) {
+		return fns.lang("Integer") + ".toUnsignedLong(" + code + ")"
+	}
+	return code
+}
+
+func (fns javaFuncs) hasExpr(e *shared.HasExpr) string {
+	recv := "proto"
+	if e.Operand != nil {
+		recv = fns.expr(e.Operand)
+	}
+	f, t := e.Field, e.Field.Type()
+	name := javaGetter(f)
+
+	switch {
+	case t.IsRepeated() || t.IsMap():
+		return fmt.Sprintf("(%s.get%sCount() > 0)", recv, name)
+	case f.HasPresence():
+		return fmt.Sprintf("%s.has%s()", recv, name)
+	case t.IsEnum():
+		return fmt.Sprintf("(%s.get%sValue() != 0)", recv, name)
+	}
+	switch t.ProtoType() {
+	case pgs.BoolT:
+		return fmt.Sprintf("%s.get%s()", recv, name)
+	case pgs.StringT, pgs.BytesT:
+		return fmt.Sprintf("!%s.get%s().isEmpty()", recv, name)
+	}
+	return fmt.Sprintf("(%s.get%s() != %s)", recv, name, fns.zero(shared.RuleContext{Field: f}))
+}
+
+// primitive reports whether values of kind k are Java primitives, compared
+// with ==.
+func primitive(k shared.ExprKind) bool {
+	switch k {
+	case shared.ExprBool, shared.ExprInt, shared.ExprUint, shared.ExprDouble, shared.ExprEnum:
+		return true
+	}
+	return false
+}
+
+// is32Bit reports whether t is held in an int or a float.
+func is32Bit(t *shared.ExprType) bool {
+	switch t.Proto {
+	case pgs.Int32T, pgs.SInt32, pgs.SFixed32, pgs.UInt32T, pgs.Fixed32T, pgs.EnumT, pgs.FloatT:
+		return true
+	}
+	return false
+}
+
+// as converts the number e to the type of numbers of kind k: a long for ints
+// and enum values, a double for doubles. Java only needs ints widened for
+// arithmetic on them to be 64 bit as CEL's.
+func (fns javaFuncs) as(e shared.Expr, k shared.ExprKind) string {
+	t := e.Type()
+	if !fns.kotlin {
+		if t.Kind == shared.ExprInt && is32Bit(t) {
+			return "((long) " + fns.expr(e) + ")"
+		}
+		return fns.expr(e)
+	}
+	switch k {
+	case shared.ExprDouble:
+		if t.Kind == shared.ExprDouble && !is32Bit(t) {
+			return fns.expr(e)
+		}
+		return "(" + fns.expr(e) + ").toDouble()"
+	case shared.ExprInt:
+		if v, ok := e.(*shared.EnumValueExpr); ok {
+			return strconv.Itoa(int(v.Value.Value())) + "L"
+		}
+		if t.Kind == shared.ExprInt && !is32Bit(t) {
+			return fns.expr(e)
+		}
+		return "(" + fns.expr(e) + ").toLong()"
+	}
+	return fns.expr(e)
+}
+
+// operands renders the operands of a comparison or arithmetic of numbers,
+// which Kotlin converts to a common type: a Double if either is one, else a
+// Long. Uints are Longs compared to uints only.
+func (fns javaFuncs) operands(e *shared.BinaryExpr) (string, string) {
+	x, y := e.X.Type().Kind, e.Y.Type().Kind
+	numeric := func(k shared.ExprKind) bool {
+		return k == shared.ExprInt || k == shared.ExprDouble || k == shared.ExprEnum
+	}
+	switch {
+	case !fns.kotlin, !numeric(x) || !numeric(y), x == shared.ExprEnum && y == shared.ExprEnum:
+		return fns.expr(e.X), fns.expr(e.Y)
+	case x == shared.ExprDouble || y == shared.ExprDouble:
+		return fns.as(e.X, shared.ExprDouble), fns.as(e.Y, shared.ExprDouble)
+	}
+	return fns.as(e.X, shared.ExprInt), fns.as(e.Y, shared.ExprInt)
+}
+
+// elem converts e to the type of the items of a list or the keys of a map of
+// elem, for contains and containsKey to compare boxes of one type.
+func (fns javaFuncs) elem(e shared.Expr, elem *shared.ExprType) string {
+	var typ string
+	switch elem.Kind {
+	case shared.ExprInt, shared.ExprUint, shared.ExprEnum:
+		typ = "long"
+		if is32Bit(elem) {
+			typ = "int"
+		}
+	case shared.ExprDouble:
+		typ = "double"
+		if is32Bit(elem) {
+			typ = "float"
+		}
+	default:
+		return fns.expr(e)
+	}
+	if fns.kotlin {
+		return "(" + fns.expr(e) + ").to" + strings.Title(typ) + "()"
+	}
+	return "(" + typ + ") (" + fns.expr(e) + ")"
+}
+
+func (fns javaFuncs) binaryExpr(e *shared.BinaryExpr) string {
+	x, y := e.X.Type(), e.Y.Type()
+	switch e.Op {
+	case "&&", "||":
+		return fmt.Sprintf("(%s %s %s)", fns.expr(e.X), e.Op, fns.expr(e.Y))
+	case "==", "!=":
+		if fns.kotlin || primitive(x.Kind) {
+			l, r := fns.operands(e)
+			return fmt.Sprintf("(%s %s %s)", l, e.Op, r)
+		}
+		eq := fmt.Sprintf("%s.equals(%s)", fns.expr(e.X), fns.expr(e.Y))
+		if e.Op == "!=" {
+			return "!" + eq
+		}
+		return eq
+	case "<", "<=", ">", ">=":
+		var cmp string
+		switch x.Kind {
+		case shared.ExprUint:
+			cmp = fmt.Sprintf("%s.compareUnsigned(%s, %s)", fns.lang("Long"), fns.expr(e.X), fns.expr(e.Y))
+		case shared.ExprString:
+			cmp = fmt.Sprintf("%s.compareTo(%s)", fns.expr(e.X), fns.expr(e.Y))
+		case shared.ExprTimestamp, shared.ExprDuration:
+			cmp = fmt.Sprintf("%s.compare(%s, %s)", exprRuntime, fns.expr(e.X), fns.expr(e.Y))
+		default:
+			l, r := fns.operands(e)
+			return fmt.Sprintf("(%s %s %s)", l, e.Op, r)
+		}
+		return fmt.Sprintf("(%s %s 0)", cmp, e.Op)
+	case "in":
+		if y.Kind == shared.ExprMap {
+			return fmt.Sprintf("%s.containsKey(%s)", fns.expr(e.Y), fns.elem(e.X, y.Key))
+		}
+		return fmt.Sprintf("%s.contains(%s)", fns.expr(e.Y), fns.elem(e.X, y.Elem))
+	}
+
+	switch {
+	case x.Kind == shared.ExprDuration && y.Kind == shared.ExprTimestamp:
+		return fmt.Sprintf("%s.add(%s, %s)", exprRuntime, fns.expr(e.Y), fns.expr(e.X))
+	case x.Kind == shared.ExprTimestamp || x.Kind == shared.ExprDuration:
+		fn := map[string]string{"+": "add", "-": "subtract"}[e.Op]
+		return fmt.Sprintf("%s.%s(%s, %s)", exprRuntime, fn, fns.expr(e.X), fns.expr(e.Y))
+	case x.Kind == shared.ExprBytes:
+		return fmt.Sprintf("%s.concat(%s)", fns.expr(e.X), fns.expr(e.Y))
+	case x.Kind == shared.ExprUint && e.Op == "/":
+		return fmt.Sprintf("%s.divideUnsigned(%s, %s)", fns.lang("Long"), fns.expr(e.X), fns.expr(e.Y))
+	case x.Kind == shared.ExprUint && e.Op == "%":
+		return fmt.Sprintf("%s.remainderUnsigned(%s, %s)", fns.lang("Long"), fns.expr(e.X), fns.expr(e.Y))
+	}
+	if fns.kotlin {
+		l, r := fns.operands(e)
+		return fmt.Sprintf("(%s %s %s)", l, e.Op, r)
+	}
+	return fmt.Sprintf("(%s %s %s)", fns.as(e.X, shared.ExprInt), e.Op, fns.as(e.Y, shared.ExprInt))
+}
+
+func (fns javaFuncs) callExpr(e *shared.CallExpr) string {
+	x := e.Args[0]
+	k := x.Type().Kind
+	switch e.Func {
+	case "size":
+		switch {
+		case k == shared.ExprString:
+			return exprRuntime + ".size(" + fns.expr(x) + ")"
+		case !fns.kotlin:
+			return fns.expr(x) + ".size()"
+		case k == shared.ExprBytes:
+			return fns.expr(x) + ".size().toLong()"
+		}
+		return fns.expr(x) + ".size.toLong()"
+	case "int", "uint":
+		switch {
+		case k == shared.ExprString && fns.kotlin:
+			return fns.expr(x) + ".toLong()"
+		case k == shared.ExprString:
+			return "Long.parseLong(" + fns.expr(x) + ")"
+		case (k == shared.ExprDouble || k == shared.ExprEnum) && fns.kotlin:
+			return "(" + fns.expr(x) + ").toLong()"
+		case k == shared.ExprDouble || k == shared.ExprEnum:
+			return "((long) " + fns.expr(x) + ")"
+		}
+		return fns.as(x, shared.ExprInt)
+	case "double":
+		switch {
+		case k == shared.ExprUint:
+			return exprRuntime + ".unsignedToDouble(" + fns.expr(x) + ")"
+		case fns.kotlin:
+			return "(" + fns.expr(x) + ").toDouble()"
+		}
+		return "((double) " + fns.expr(x) + ")"
+	case "string":
+		switch {
+		case k == shared.ExprString:
+			return fns.expr(x)
+		case k == shared.ExprUint:
+			return fns.lang("Long") + ".toUnsignedString(" + fns.expr(x) + ")"
+		case fns.kotlin:
+			return "(" + fns.expr(x) + ").toString()"
+		}
+		return "String.valueOf(" + fns.expr(x) + ")"
+	case "matches":
+		return fmt.Sprintf("%s.matches(%s, %s)", exprRuntime, fns.expr(x), fns.expr(e.Args[1]))
+	}
+	// startsWith, endsWith and contains
+	return fmt.Sprintf("%s.%s(%s)", fns.expr(x), e.Func, fns.expr(e.Args[1]))
+}
+
+func (fns javaFuncs) comprehensionExpr(e *shared.ComprehensionExpr) string {
+	if fns.kotlin {
+		rng := fns.expr(e.Range)
+		if e.Range.Type().Kind == shared.ExprMap {
+			rng += ".keys"
+		}
+		pred := fmt.Sprintf("{ _%s -> %s }", e.Var, fns.expr(e.Pred))
+		switch e.Macro {
+		case "all":
+			return fmt.Sprintf("%s.all %s", rng, pred)
+		case "exists":
+			return fmt.Sprintf("%s.any %s", rng, pred)
+		}
+		return fmt.Sprintf("(%s.count %s == 1)", rng, pred)
+	}
+
+	stream := fns.expr(e.Range) + ".stream()"
+	if e.Range.Type().Kind == shared.ExprMap {
+		stream = fns.expr(e.Range) + ".keySet().stream()"
+	}
+	pred := fmt.Sprintf("_%s -> %s", e.Var, fns.expr(e.Pred))
+	switch e.Macro {
+	case "all":
+		return fmt.Sprintf("%s.allMatch(%s)", stream, pred)
+	case "exists":
+		return fmt.Sprintf("%s.anyMatch(%s)", stream, pred)
+	}
+	return fmt.Sprintf("(%s.filter(%s).count() == 1)", stream, pred)
+}
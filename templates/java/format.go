@@ -0,0 +1,441 @@
+package java
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+const googleJavaFormatVersion = "1.15.0"
+
+// googleJavaFormatSum is the hex sha256 of the google-java-format jar of
+// googleJavaFormatVersion published on its release page, which the downloaded
+// jar is checked against unless `format_tool_sha256` is set.
+// TODO: pin the published sum of google-java-format-1.15.0-all-deps.jar.
+const googleJavaFormatSum = ""
+
+// ErrFormatToolUnverified is returned when the google-java-format jar can't be
+// verified against its checksum, which stops the generation instead of
+// falling back to unformatted code.
+var ErrFormatToolUnverified = errors.New("format tool not verified")
+
+const (
+	// BuiltinFormat selects the pure Go pretty-printer with `format=builtin`
+	// instead of google-java-format.
+	BuiltinFormat = "builtin"
+	// FormatToolParam points at a google-java-format jar to use instead of
+	// downloading one.
+	FormatToolParam = "format_tool"
+	// FormatToolSumParam is the hex sha256 the google-java-format jar must
+	// have. It defaults to the published one of googleJavaFormatVersion for the
+	// downloaded jar, a `format_tool` jar is only checked if it is set.
+	FormatToolSumParam = "format_tool_sha256"
+)
+
+// CodeFormatter returns the formatter of the generated code selected by the
+// `format`, `format_tool` and `format_tool_sha256` parameters.
+func CodeFormatter(params pgs.Parameters) func(in io.Reader, out io.Writer) error {
+	if params.Str("format") == BuiltinFormat {
+		return BuiltinCodeFormat
+	}
+	tool := formatTool{path: params.Str(FormatToolParam), sum: params.Str(FormatToolSumParam)}
+	if tool.path == "" && tool.sum == "" {
+		tool.sum = googleJavaFormatSum
+	}
+	return tool.format
+}
+
+// formatTool runs the google-java-format jar at path, or the one downloaded to
+// the user cache directory when path is empty, if it has the sha256 sum. The
+// jar at path is trusted when sum is empty.
+type formatTool struct {
+	path string
+	sum  string
+}
+
+func (t formatTool) format(in io.Reader, out io.Writer) error {
+	toolName := t.path
+	if toolName == "" {
+		var err error
+		if toolName, err = t.cached(); err != nil {
+			return err
+		}
+	}
+	if toolName != t.path || t.sum != "" {
+		if err := checkFormatTool(toolName, t.sum); err != nil {
+			return err
+		}
+	}
+
+	tmp, err := os.CreateTemp("", "*.java")
+	if err != nil {
+		return fmt.Errorf("create temp file for formatter failed, %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = io.Copy(tmp, in)
+	_ = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("copy data to temp file failed, %w", err)
+	}
+	cmd := exec.Command("java", "-jar", toolName, tmp.Name())
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("get stdout failed, %w", err)
+	}
+	defer stdout.Close()
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return fmt.Errorf("get stderr failed, %w", err)
+	}
+	defer stderr.Close()
+
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("start format command failed, %w", err)
+	}
+
+	if errBs, _ := ioutil.ReadAll(stderr); len(errBs) > 0 {
+		return fmt.Errorf("format code failed, %s", string(errBs))
+	}
+
+	if _, err = io.Copy(out, stdout); err != nil {
+		return fmt.Errorf("copy data from stdout failed, %w", err)
+	}
+	return cmd.Wait()
+}
+
+// cached returns the path of the google-java-format jar in the user cache
+// directory, downloading it on first use.
+func (t formatTool) cached() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("get cache dir for format tool failed, %w", err)
+	}
+	dir = filepath.Join(dir, "protoc-gen-validate")
+	toolName := filepath.Join(dir, fmt.Sprintf("google-java-format-%s-all-deps.jar", googleJavaFormatVersion))
+	if _, err = os.Stat(toolName); err == nil {
+		return toolName, nil
+	} else if !os.IsNotExist(err) {
+		return "", fmt.Errorf("get format tool info failed, %w", err)
+	}
+
+	if t.sum == "" {
+		return "", fmt.Errorf("%w: no checksum to download %s, set `%s`", ErrFormatToolUnverified, toolName, FormatToolSumParam)
+	}
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create cache dir for format tool failed, %w", err)
+	}
+	if err = downloadFormatTool(toolName, t.sum); err != nil {
+		return "", fmt.Errorf("download format tool failed, %w", err)
+	}
+	return toolName, nil
+}
+
+// checkFormatTool asserts that the jar at toolName has the sha256 sum.
+func checkFormatTool(toolName, sum string) error {
+	f, err := os.Open(toolName)
+	if err != nil {
+		return fmt.Errorf("open format tool failed, %w", err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return fmt.Errorf("read format tool failed, %w", err)
+	}
+	return checkSum(toolName, h.Sum(nil), sum)
+}
+
+func checkSum(toolName string, got []byte, sum string) error {
+	if sum == "" {
+		return fmt.Errorf("%w: no checksum for %s, set `%s`", ErrFormatToolUnverified, toolName, FormatToolSumParam)
+	}
+	if hex.EncodeToString(got) != strings.ToLower(sum) {
+		return fmt.Errorf("%w: checksum of %s is %x, expected %s", ErrFormatToolUnverified, toolName, got, sum)
+	}
+	return nil
+}
+
+// downloadFormatTool downloads the google-java-format jar to toolName, if it
+// has the sha256 sum.
+func downloadFormatTool(toolName, sum string) (err error) {
+	tool, err := os.CreateTemp(filepath.Dir(toolName), filepath.Base(toolName)+".*")
+	if err != nil {
+		return fmt.Errorf("create tool file failed, %w", err)
+	}
+	defer func() {
+		_ = tool.Close()
+		if err != nil {
+			_ = os.Remove(tool.Name())
+		}
+	}()
+	cli := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+		},
+		Timeout: 30 * time.Second,
+	}
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("https://github.com/google/google-java-format/releases/download/v%s/%s", googleJavaFormatVersion, filepath.Base(toolName)), nil)
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	h := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(tool, h), resp.Body); err != nil {
+		return fmt.Errorf("write tool data failed, %w", err)
+	}
+	if err = tool.Close(); err != nil {
+		return fmt.Errorf("write tool data failed, %w", err)
+	}
+	if err = checkSum(toolName, h.Sum(nil), sum); err != nil {
+		return err
+	}
+	return os.Rename(tool.Name(), toolName)
+}
+
+// BuiltinCodeFormat pretty-prints the generated validators without
+// google-java-format: it re-indents the code by its brackets with two spaces
+// per block and four per continued line, puts a space before opening braces,
+// sorts the imports and drops the blank lines the templates leave.
+func BuiltinCodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+
+	var lines, imports []string
+	for _, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "import ") {
+			imports = append(imports, line)
+			continue
+		}
+		lines = append(lines, line)
+	}
+	lines = insertImports(lines, imports)
+
+	var (
+		buf       bytes.Buffer
+		f         javaFormatter
+		prev      string
+		blank     bool
+		inComment bool
+	)
+	for _, line := range lines {
+		if line == "" {
+			blank = prev != ""
+			continue
+		}
+		if inComment {
+			buf.WriteString(strings.Repeat(" ", f.indent(false)))
+			if strings.HasPrefix(line, "*") {
+				// continued doc comment
+				buf.WriteByte(' ')
+			}
+			buf.WriteString(line + "\n")
+			inComment = !strings.Contains(line, "*/")
+			prev = line
+			continue
+		}
+
+		line, ops := scanBrackets(line)
+		label := strings.HasPrefix(line, "case ") || strings.HasPrefix(line, "default:")
+		if strings.HasPrefix(line, "/**") || strings.HasPrefix(line, "@") {
+			// members with a doc comment or an annotation are set apart from
+			// the end of the previous one
+			blank = strings.HasSuffix(prev, ";") || strings.HasSuffix(prev, "}")
+		} else if strings.HasSuffix(prev, "{") || strings.HasSuffix(prev, ":") ||
+			strings.HasPrefix(line, "}") || label || line == "break;" {
+			blank = false
+		}
+		if blank {
+			buf.WriteByte('\n')
+		}
+		blank = false
+
+		// the closers a line starts with take it back to the level of their
+		// openers
+		lead := 0
+		for lead < len(line) && lead < len(ops) && strings.IndexByte("})]", line[lead]) >= 0 {
+			lead++
+		}
+		f.apply(ops[:lead], false)
+		buf.WriteString(strings.Repeat(" ", f.indent(label)))
+		buf.WriteString(line + "\n")
+		f.apply(ops[lead:], strings.HasPrefix(line, "switch "))
+		f.endLine()
+
+		inComment = strings.HasPrefix(line, "/*") && !strings.Contains(line, "*/")
+		prev = line
+	}
+
+	_, err = out.Write(buf.Bytes())
+	return err
+}
+
+// insertImports puts the sorted and deduplicated imports after the package
+// declaration of lines, static imports first.
+func insertImports(lines, imports []string) []string {
+	if len(imports) == 0 {
+		return lines
+	}
+	seen := map[string]bool{}
+	var static, normal []string
+	for _, imp := range imports {
+		if seen[imp] {
+			continue
+		}
+		seen[imp] = true
+		if strings.HasPrefix(imp, "import static ") {
+			static = append(static, imp)
+		} else {
+			normal = append(normal, imp)
+		}
+	}
+	sort.Strings(static)
+	sort.Strings(normal)
+
+	block := []string{""}
+	if len(static) > 0 {
+		block = append(append(block, static...), "")
+	}
+	if len(normal) > 0 {
+		block = append(append(block, normal...), "")
+	}
+
+	at := 0
+	for i, line := range lines {
+		if strings.HasPrefix(line, "package ") {
+			at = i + 1
+			break
+		}
+	}
+	out := append([]string{}, lines[:at]...)
+	out = append(out, block...)
+	return append(out, lines[at:]...)
+}
+
+// javaFormatter tracks the brackets left open by the lines formatted so far.
+type javaFormatter struct {
+	open []bracket
+	line int
+}
+
+type bracket struct {
+	char rune
+	line int
+	// indent is the indentation the bracket adds to the lines it encloses.
+	indent int
+	// isSwitch marks the block of a switch, inCase that a case label of it
+	// has been seen: the statements of a case are indented below its label.
+	isSwitch, inCase bool
+}
+
+// scanBrackets returns line with a space before its opening braces and no
+// padding inside of parentheses, and its brackets outside of literals and
+// comments.
+func scanBrackets(line string) (string, []rune) {
+	var (
+		out     strings.Builder
+		ops     []rune
+		quote   rune
+		escaped bool
+		runes   = []rune(line)
+	)
+	for i, r := range runes {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '/' && i+1 < len(runes) && (runes[i+1] == '/' || runes[i+1] == '*'):
+			out.WriteString(string(runes[i:]))
+			return out.String(), ops
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ' ' && (i > 0 && runes[i-1] == '(' || i+1 < len(runes) && runes[i+1] == ')'):
+			// no padding inside of parentheses
+			continue
+		case strings.ContainsRune("{([})]", r):
+			if r == '{' && i > 0 && !strings.ContainsRune(" ({[", runes[i-1]) {
+				out.WriteByte(' ')
+			}
+			ops = append(ops, r)
+		}
+		out.WriteRune(r)
+	}
+	return out.String(), ops
+}
+
+// apply opens and closes the brackets ops of the current line.
+func (f *javaFormatter) apply(ops []rune, isSwitch bool) {
+	for _, r := range ops {
+		if strings.ContainsRune("{([", r) {
+			f.open = append(f.open, bracket{char: r, line: f.line, isSwitch: isSwitch && r == '{'})
+		} else if len(f.open) > 0 {
+			f.open = f.open[:len(f.open)-1]
+		}
+	}
+}
+
+// indent returns the indentation of the current line, a case label if label.
+func (f *javaFormatter) indent(label bool) int {
+	indent := 0
+	for _, b := range f.open {
+		indent += b.indent
+		if b.inCase {
+			indent += 2
+		}
+	}
+	if n := len(f.open); label && n > 0 && f.open[n-1].isSwitch {
+		if f.open[n-1].inCase {
+			indent -= 2
+		}
+		f.open[n-1].inCase = true
+	}
+	return indent
+}
+
+// endLine sets the indentation of the brackets the current line left open: a
+// block indents by two, and the line continues by four in its innermost open
+// parenthesis unless a block opens after it.
+func (f *javaFormatter) endLine() {
+	continued := false
+	for i := len(f.open) - 1; i >= 0 && f.open[i].line == f.line; i-- {
+		b := &f.open[i]
+		switch {
+		case b.char == '{':
+			b.indent = 2
+			continued = true
+		case !continued:
+			b.indent = 4
+			continued = true
+		}
+	}
+	f.line++
+}
@@ -0,0 +1,24 @@
+//go:build network
+
+package java
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// TestDownloadFormatTool downloads google-java-format from its release page,
+// run it with `go test -tags network`.
+func TestDownloadFormatTool(t *testing.T) {
+	if googleJavaFormatSum == "" {
+		t.Skip("the checksum of google-java-format is not pinned")
+	}
+	tool := filepath.Join(t.TempDir(), fmt.Sprintf("google-java-format-%s-all-deps.jar", googleJavaFormatVersion))
+	if err := downloadFormatTool(tool, googleJavaFormatSum); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkFormatTool(tool, googleJavaFormatSum); err != nil {
+		t.Fatal(err)
+	}
+}
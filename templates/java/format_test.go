@@ -0,0 +1,115 @@
+package java
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinCodeFormat(t *testing.T) {
+	in := `package com.acme;
+import java.util.List;
+import static java.util.Objects.requireNonNull;
+import java.util.List;
+
+/**
+* Validates {@code X}.
+*/
+public class XValidator{
+	private final String[] IN = new String[]{
+		"{a}",
+	};
+
+
+	public void validateAll(X proto) {
+		if ( proto.hasY() ) {
+			check("y", () -> required(Y_ERROR,
+				null));
+		}
+
+		list.forEach(item -> {
+			check('{');
+		});
+		switch (proto.getZCase()) {
+			case A:
+
+				break;
+			default:
+				fail();
+		}
+	}
+}
+`
+	want := `package com.acme;
+
+import static java.util.Objects.requireNonNull;
+
+import java.util.List;
+
+/**
+ * Validates {@code X}.
+ */
+public class XValidator {
+  private final String[] IN = new String[] {
+    "{a}",
+  };
+
+  public void validateAll(X proto) {
+    if (proto.hasY()) {
+      check("y", () -> required(Y_ERROR,
+          null));
+    }
+
+    list.forEach(item -> {
+      check('{');
+    });
+    switch (proto.getZCase()) {
+      case A:
+        break;
+      default:
+        fail();
+    }
+  }
+}
+`
+	var out bytes.Buffer
+	if err := BuiltinCodeFormat(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("BuiltinCodeFormat() =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestCheckFormatTool(t *testing.T) {
+	tool := filepath.Join(t.TempDir(), "format.jar")
+	if err := os.WriteFile(tool, []byte("jar"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkFormatTool(tool, ""); err == nil {
+		t.Error("checkFormatTool() without a checksum should fail")
+	}
+	if err := checkFormatTool(tool, strings.Repeat("0", 64)); !errors.Is(err, ErrFormatToolUnverified) {
+		t.Errorf("checkFormatTool() with a wrong checksum = %v; want ErrFormatToolUnverified", err)
+	}
+	format := formatTool{path: tool, sum: strings.Repeat("0", 64)}.format
+	if err := format(strings.NewReader(""), io.Discard); !errors.Is(err, ErrFormatToolUnverified) {
+		t.Errorf("format() with a wrong checksum = %v; want ErrFormatToolUnverified", err)
+	}
+	if err := os.WriteFile(tool+".sha256", []byte(jarSum+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkFormatTool(tool, ""); err == nil {
+		t.Error("checkFormatTool() should not trust a checksum recorded next to the tool")
+	}
+	if err := checkFormatTool(tool, strings.ToUpper(jarSum)); err != nil {
+		t.Errorf("checkFormatTool() with the checksum: %v", err)
+	}
+}
+
+// jarSum is the sha256 of "jar".
+const jarSum = "0163f1eea7894350060624d315234d40c508ab251ba121714e234503045faadd"
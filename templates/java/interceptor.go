@@ -0,0 +1,47 @@
+package java
+
+const interceptorTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .File.InputPath }}
+
+package {{ javaPackage .File }};
+
+/**
+ * Validates the requests of the {@code {{ serviceName . }}} service with their generated validators, and its
+ * responses if enabled, in the validation groups the method rules of the service declare. Invalid requests fail with {@code INVALID_ARGUMENT}, invalid responses with {@code INTERNAL},
+ * both with a {@code google.rpc.BadRequest} detail listing the field violations.
+ */
+@SuppressWarnings("all")
+public final class {{ interceptorName . }} implements io.grpc.ServerInterceptor {
+	private final cn.spaceli.pgv.ValidatorIndex index;
+	private final boolean validateResponses;
+
+	public {{ interceptorName . }}() {
+		this(false);
+	}
+
+	public {{ interceptorName . }}(boolean validateResponses) {
+		this(new cn.spaceli.pgv.ReflectiveValidatorIndex(), validateResponses);
+	}
+
+	public {{ interceptorName . }}(cn.spaceli.pgv.ValidatorIndex index, boolean validateResponses) {
+		this.index = index;
+		this.validateResponses = validateResponses;
+	}
+
+	@Override
+	public <ReqT, RespT> io.grpc.ServerCall.Listener<ReqT> interceptCall(io.grpc.ServerCall<ReqT, RespT> call, io.grpc.Metadata headers, io.grpc.ServerCallHandler<ReqT, RespT> next) {
+		switch (call.getMethodDescriptor().getFullMethodName()) {
+{{- range .Methods }}{{ $rules := methodRules . }}{{ if or (not $rules.GetSkip) (not $rules.ValidateResponse) $rules.GetValidateResponse }}
+			case "{{ methodName . }}":
+				return cn.spaceli.pgv.grpc.ValidatingServerCalls.intercept(call, headers, next,
+					{{ if $rules.GetSkip }}null{{ else }}index.validatorFor({{ qualifiedName .Input }}.class){{ end }},
+					{{ if not $rules.ValidateResponse }}validateResponses ? index.validatorFor({{ qualifiedName .Output }}.class) : null
+					{{- else if $rules.GetValidateResponse }}index.validatorFor({{ qualifiedName .Output }}.class){{ else }}null{{ end }}
+					{{- range $rules.GetGroups }}, {{ javaStringLit . }}{{ end }});
+{{- end }}{{ end }}
+			default:
+				return next.startCall(call, headers);
+		}
+	}
+}
+`
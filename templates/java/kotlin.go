@@ -0,0 +1,334 @@
+package java
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+)
+
+// RegisterKotlin registers the template of the Kotlin extension functions
+// validating the messages of a file. Their rules are rendered by the templates
+// of the Java validators, with Kotlin literals and lambdas, and call the same
+// runtime.
+func RegisterKotlin(tpl *template.Template, params pgs.Parameters) {
+	fns := javaFuncs{
+		Context:    pgsgo.InitContext(params),
+		lazyErrors: params.Str("error_mode") == "lazy",
+		kotlin:     true,
+	}
+
+	tpl.Funcs(fns.ruleFuncs(tpl))
+	tpl.Funcs(map[string]interface{}{
+		"imports":       fns.imports,
+		"kotlinPackage": kotlinPackage,
+		"rulesObject":   fns.rulesObject,
+	})
+
+	template.Must(tpl.Parse(kotlinFileTpl))
+	template.Must(tpl.New("msg").Parse(kotlinMsgTpl))
+	template.Must(tpl.New("oneOf").Parse(kotlinOneOfTpl))
+	registerRules(tpl)
+}
+
+// KotlinFilePath returns the path of the Kotlin validation extensions of f,
+// next to its Java validators, nil if f doesn't import PGV.
+func KotlinFilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	if !importsPvg(f) {
+		return nil
+	}
+
+	fullPath := strings.Replace(javaPackage(f), ".", string(os.PathSeparator), -1)
+	filePath := pgs.JoinPaths(fullPath, classNameFile(f)+"Validation.kt")
+	return &filePath
+}
+
+// kotlinKeywords are the hard keywords of Kotlin, which names must quote with
+// backticks.
+var kotlinKeywords = map[string]bool{
+	"as": true, "break": true, "class": true, "continue": true, "do": true, "else": true, "false": true,
+	"for": true, "fun": true, "if": true, "in": true, "interface": true, "is": true, "null": true,
+	"object": true, "package": true, "return": true, "super": true, "this": true, "throw": true,
+	"true": true, "try": true, "typealias": true, "typeof": true, "val": true, "var": true,
+	"when": true, "while": true,
+}
+
+// kotlinName quotes the parts of the dotted name that are Kotlin keywords.
+func kotlinName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		if kotlinKeywords[p] {
+			parts[i] = "`" + p + "`"
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// kotlinPackage returns the package of the Kotlin extensions of f, the Java
+// package of its messages.
+func kotlinPackage(f pgs.File) string {
+	return kotlinName(javaPackage(f))
+}
+
+// rulesObject returns the name of the private object holding the constants
+// and checks of the rules of m, unique within its file.
+func (fns javaFuncs) rulesObject(m pgs.Message) string {
+	name := strings.TrimPrefix(m.FullyQualifiedName(), ".")
+	if pkg := m.Package().ProtoName().String(); pkg != "" {
+		name = strings.TrimPrefix(name, pkg+".")
+	}
+	return strings.ReplaceAll(name, ".", "_") + "Rules"
+}
+
+// validates reports whether the message of ctx, or of its items or values,
+// has validation extensions to descend into: its file imports PGV and it is
+// not ignored.
+func (fns javaFuncs) validates(ctx shared.RuleContext) (bool, error) {
+	embed := embeddedMessage(ctx.Field)
+	if embed == nil || !importsPvg(embed.File()) {
+		return false, nil
+	}
+	ignored, err := shared.Ignored(embed)
+	return !ignored, err
+}
+
+// embeddedMessage returns the message type of f, or of its items or values,
+// nil if it has none.
+func embeddedMessage(f pgs.Field) pgs.Message {
+	t := f.Type()
+	if t.IsEmbed() {
+		return t.Embed()
+	}
+	if el := t.Element(); el != nil && el.IsEmbed() {
+		return el.Embed()
+	}
+	return nil
+}
+
+// imports returns the validateAll extensions of the other packages the
+// messages of f descend into.
+func (fns javaFuncs) imports(f pgs.File) ([]string, error) {
+	seen := map[string]bool{}
+	var out []string
+	for _, m := range f.AllMessages() {
+		for _, fld := range m.Fields() {
+			embed := embeddedMessage(fld)
+			if embed == nil {
+				continue
+			}
+			ok, err := fns.validates(shared.RuleContext{Field: fld})
+			if err != nil {
+				return nil, err
+			}
+			pkg := javaPackage(embed.File())
+			if !ok || pkg == "" || pkg == javaPackage(f) || seen[pkg] {
+				continue
+			}
+			seen[pkg] = true
+			out = append(out, kotlinName(pkg)+".validateAll")
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// kotlinNumLit renders the number v of a rule as a Kotlin literal of the type
+// Java reads the field as, unsigned ints being held in signed ones.
+func kotlinNumLit(v interface{}) string {
+	switch v := v.(type) {
+	case int32:
+		if v == math.MinInt32 {
+			return "Int.MIN_VALUE"
+		}
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		if v == math.MinInt64 {
+			return "Long.MIN_VALUE"
+		}
+		return strconv.FormatInt(v, 10) + "L"
+	case uint32:
+		if v > math.MaxInt32 {
+			return strconv.FormatUint(uint64(v), 10) + "u.toInt()"
+		}
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		if v > math.MaxInt64 {
+			return strconv.FormatUint(v, 10) + "uL.toLong()"
+		}
+		return strconv.FormatUint(v, 10) + "L"
+	case float32:
+		return kotlinFloatLit(float64(v), 32)
+	case float64:
+		return kotlinFloatLit(v, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// kotlinFloatLit renders f as a Kotlin Float or Double literal.
+func kotlinFloatLit(f float64, bitSize int) string {
+	typ := "Double"
+	if bitSize == 32 {
+		typ = "Float"
+	}
+	switch {
+	case math.IsNaN(f):
+		return typ + ".NaN"
+	case math.IsInf(f, 1):
+		return typ + ".POSITIVE_INFINITY"
+	case math.IsInf(f, -1):
+		return typ + ".NEGATIVE_INFINITY"
+	}
+	s := strconv.FormatFloat(f, 'g', -1, bitSize)
+	if bitSize == 32 {
+		return s + "F"
+	}
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// kotlinBytesLit renders b as a Kotlin byte array.
+func kotlinBytesLit(b []byte) string {
+	lits := make([]string, len(b))
+	for i, c := range b {
+		lits[i] = strconv.Itoa(int(int8(c)))
+	}
+	return "byteArrayOf(" + strings.Join(lits, ", ") + ")"
+}
+
+// kotlinStringLit quotes s as a Kotlin string literal, escaping the dollar
+// signs that would start templates.
+func kotlinStringLit(s string) string {
+	return strings.ReplaceAll(javaStringLit(s), "$", `\$`)
+}
+
+// kotlinArgLit renders arg as a Kotlin literal of the matching type.
+func kotlinArgLit(arg shared.ErrorArg) (string, error) {
+	switch arg.Kind {
+	case shared.IntArg:
+		if arg.Int >= math.MinInt32 && arg.Int <= math.MaxInt32 {
+			return strconv.FormatInt(arg.Int, 10), nil
+		}
+		return kotlinNumLit(arg.Int), nil
+	case shared.UintArg:
+		if arg.Uint <= math.MaxInt32 {
+			return strconv.FormatUint(arg.Uint, 10), nil
+		}
+		return kotlinNumLit(arg.Uint), nil
+	case shared.FloatArg:
+		return kotlinFloatLit(arg.Float, 64), nil
+	case shared.BoolArg:
+		return strconv.FormatBool(arg.Bool), nil
+	case shared.RefArg:
+		return arg.Str, nil
+	case shared.ValueArg:
+		return "", errors.New("$value is only known to errors built with error_mode=lazy")
+	default:
+		return kotlinStringLit(arg.Str), nil
+	}
+}
+
+// KotlinCodeFormat re-indents the rendered extensions by their braces with
+// four spaces. Blank lines are collapsed, and dropped within functions and at
+// the start and end of blocks.
+func KotlinCodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(src), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	var buf bytes.Buffer
+	depth := 0
+	prev := ""
+	for i, line := range lines {
+		if line == "" {
+			next := ""
+			for _, l := range lines[i+1:] {
+				if l != "" {
+					next = l
+					break
+				}
+			}
+			if prev == "" || next == "" || depth > 1 || strings.HasSuffix(prev, "{") || strings.HasPrefix(next, "}") {
+				continue
+			}
+			buf.WriteByte('\n')
+			prev = ""
+			continue
+		}
+
+		ops := kotlinBraces(line)
+		for len(ops) > 0 && ops[0] == '}' {
+			if depth > 0 {
+				depth--
+			}
+			ops = ops[1:]
+		}
+
+		buf.WriteString(strings.Repeat("    ", depth))
+		if strings.HasPrefix(line, "*") {
+			// continued doc comment
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+
+		for _, op := range ops {
+			if op == '{' {
+				depth++
+			} else if depth > 0 {
+				depth--
+			}
+		}
+		prev = line
+	}
+
+	_, err = out.Write(buf.Bytes())
+	return err
+}
+
+// kotlinBraces returns the braces of line outside of string and character
+// literals and comments.
+func kotlinBraces(line string) []rune {
+	var out []rune
+	var quote rune
+	escaped := false
+	prev := rune(0)
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '/' && prev == '/':
+			return out
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '{' || r == '}':
+			out = append(out, r)
+		}
+		prev = r
+	}
+	return out
+}
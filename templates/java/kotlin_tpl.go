@@ -0,0 +1,100 @@
+package java
+
+const kotlinFileTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+@file:Suppress("warnings")
+{{ with kotlinPackage . }}
+package {{ . }}
+{{ end }}
+{{ range imports . }}import {{ . }}
+{{ end }}
+{{ range .AllMessages -}}
+	{{- template "msg" . -}}
+{{- end }}
+`
+
+const kotlinMsgTpl = `
+{{ if not (ignored .) -}}
+{{ $ctx := . }}{{ $typ := qualifiedName . }}
+/**
+ * Returns the violations of the validation rules of this {{ .Name }}, empty if it is valid. The rules of
+ * [groups] apply along with the rules of no group.
+ */
+fun {{ $typ }}.validate(vararg groups: String): List<cn.spaceli.pgv.Violation> {
+	val violations = cn.spaceli.pgv.ViolationCollector(*groups)
+	validateAll(violations)
+	return violations.violations
+}
+
+/**
+ * Returns the violations of the validation rules of the fields of this {{ .Name }} that [mask] covers, empty
+ * if they are valid. The rules of [groups] apply along with the rules of no group; an empty mask covers every field.
+ */
+fun {{ $typ }}.validate(mask: com.google.protobuf.FieldMask, vararg groups: String): List<cn.spaceli.pgv.Violation> {
+	val violations = cn.spaceli.pgv.ViolationCollector(mask, *groups)
+	validateAll(violations)
+	return violations.violations
+}
+
+/**
+ * Throws the error of the first validation rule this {{ .Name }} violates. The rules of [groups] apply along
+ * with the rules of no group.
+ */
+fun {{ $typ }}.assertValid(vararg groups: String) {
+	validateAll(cn.spaceli.pgv.ViolationCollector.failFast(*groups))
+}
+
+/**
+ * Throws the error of the first validation rule the fields of this {{ .Name }} that [mask] covers violate.
+ * The rules of [groups] apply along with the rules of no group; an empty mask covers every field.
+ */
+fun {{ $typ }}.assertValid(mask: com.google.protobuf.FieldMask, vararg groups: String) {
+	validateAll(cn.spaceli.pgv.ViolationCollector.failFast(mask, *groups))
+}
+
+/**
+ * Checks every validation rule of this {{ .Name }}, recording each failure in [violations].
+ */
+fun {{ $typ }}.validateAll(violations: cn.spaceli.pgv.ViolationCollector) {
+	{{ rulesObject . }}.validateAll(this, violations)
+}
+
+private object {{ rulesObject . }} {
+{{- if not (disabled .) }}
+	{{- range validatedFields . }}
+	{{ renderConstants (context $ctx .) }}
+	{{- end }}
+	{{ template "oneOfConst" . }}{{ template "exprConst" . }}
+{{- end }}
+
+	fun validateAll(proto: {{ $typ }}, violations: cn.spaceli.pgv.ViolationCollector) {
+	{{- if disabled . }}
+		// validation is disabled for {{ .Name }}
+	{{- else }}
+	{{- range validatedFields . }}
+		{{ $mask := withMask . }}{{ if $mask }}{{ $mask }}
+		{{ end }}{{ render (context $ctx .) }}{{ if $mask }}
+		}{{ end }}
+	{{- end }}
+		{{ template "oneOf" . }}{{ template "expr" . }}
+	{{- end }}
+	}
+}
+{{ end -}}
+`
+
+const kotlinOneOfTpl = `{{ $msg := . }}{{ range .RealOneOfs }}{{ $oneof := . }}{{ $r := oneofRule . }}
+		when (proto.get{{ camelCase .Name }}Case()) {
+		{{- range .Fields }}
+			{{ qualifiedName $msg }}.{{ camelCase $oneof.Name }}Case.{{ oneof . }} -> {
+				{{ render (context $msg .) }}
+			}
+		{{- end }}
+			else -> {
+		{{- if $r.GetRequired }}
+				{{ if groups $r }}if (violations.inGroups({{ groups $r }})) {{ end }}violations.check("{{ $oneof.Name }}", "oneof.required") { cn.spaceli.pgv.RequiredValidation.required({{ errorOneOfRequiredName $msg $oneof }}, null) }
+		{{- end }}
+			}
+		}
+{{- end -}}
+`
@@ -2,41 +2,53 @@ package java
 
 const mapConstTpl = `
 {{- if or (ne (.Elem "" "").Typ "none") (ne (.Key "" "").Typ "none") }}
-		{{ renderConstants (.Key "key" "Key") }}
-		{{ renderConstants (.Elem "value" "Value") }}
+	{{ renderConstants (.Key "key" "Key") }}
+	{{ renderConstants (.Elem "value" "Value") }}
 {{- end -}}
-{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}
 `
 
-const mapTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const mapTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
 {{- if $r.GetIgnoreEmpty }}
-			if ( !{{ accessor $ctx }}.isEmpty() ) {
+		if (!{{ accessor $ctx }}.isEmpty()) {
 {{- end -}}
 {{- if $r.GetMinPairs }}
-			cn.spaceli.pgv.MapValidation.min({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinPairs }});
+			{{ check $ctx "min_pairs" }}cn.spaceli.pgv.MapValidation.min({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinPairs }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetMaxPairs }}
-			cn.spaceli.pgv.MapValidation.max({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxPairs }});
+			{{ check $ctx "max_pairs" }}cn.spaceli.pgv.MapValidation.max({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxPairs }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetNoSparse }}
-			cn.spaceli.pgv.MapValidation.noSparse({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "no_sparse" }}cn.spaceli.pgv.MapValidation.noSparse({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if and $r.GetKeys (ne ($ctx.Key "" "").Typ "none") }}
-			cn.spaceli.pgv.MapValidation.validateParts({{ accessor $ctx }}.keySet(), key -> {
+			cn.spaceli.pgv.MapValidation.validateKeys(violations, "{{ fieldPath $ctx }}", {{ accessor $ctx }}{{ lambda "key" }}
 				{{ render ($ctx.KeyWithErrIndex "key" "Key" $index) }}
-			});
+			{{ endLambda }}
 {{- end -}}
-{{ if and $r.GetValues (ne ($ctx.Key "" "").Typ "none") }}
-			cn.spaceli.pgv.MapValidation.validateParts({{ accessor $ctx }}.values(), value -> {
+{{- if and $r.GetValues (ne ($ctx.Key "" "").Typ "none") }}
+			cn.spaceli.pgv.MapValidation.validateValues(violations, "{{ fieldPath $ctx }}", {{ accessor $ctx }}{{ lambda "value" }}
 				{{ render ($ctx.ElemWithErrIndex "value" "Value" $index) }}
-			});
+			{{ endLambda }}
 {{- end -}}
 {{- if $r.GetIgnoreEmpty }}
-			}
+		}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
 {{- end -}}
 {{- end -}}
 `
@@ -2,7 +2,7 @@ package java
 
 const messageConstTpl = `{{- if .Rules }}{{ $r := .Rules -}}
 {{- if and $r.Error .DefineErr }}
-	private final RuntimeException {{ errorName . 0 }} = {{ error . $r.Error }};
+	{{ constant (errorName . 0) errorType (error . $r.Error) }}
 {{- end -}}
 {{- end -}}
 `
@@ -12,15 +12,23 @@ const messageTpl = `{{ $f := .Field }}{{ $r := .Rules }}
 			// skipping validation for {{ $f.Name }}
 	{{- else -}}
 		{{- if $r.GetRequired }}
-			if ({{ hasAccessor . }}) {
-				cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, {{ accessor . }});
-			} else {
-				cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, null);
-			};
+			{{- if groups $r }}
+			if (violations.inGroups({{ groups $r }})) {
+			{{- end }}
+			{{- if $r.GetWhen }}
+			if ({{ when . $r.GetWhen }}) {
+			{{- end }}
+			if (!{{ hasAccessor . }}) {{ check . "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, null){{ endCheck }}
+			{{- if $r.GetWhen }}
+			}
+			{{- end }}
+			{{- if groups $r }}
+			}
+			{{- end }}
 		{{- end -}}
-		{{- if (isOfMessageType $f) }}
+		{{- with validateMessage . }}
 			// Validate {{ $f.Name }}
-			if ({{ hasAccessor . }}) index.validatorFor({{ accessor . }}).assertValid({{ accessor . }});
+			{{ . }}
 		{{- end -}}
 	{{- end -}}
 `
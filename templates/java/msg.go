@@ -13,20 +13,34 @@ const msgTpl = `
 
 const msgInnerTpl = `
 	{{ $ctx := . }}
-	{{- range .NonOneOfFields }}
+	{{- range validatedFields . }}
 		{{ renderConstants (context $ctx .) }}
 	{{ end }}
-	{{ template "oneOfConst" . }}
+	{{ template "oneOfConst" . }}{{ template "exprConst" . }}
 
 	public void assertValid({{ qualifiedName . }} proto, cn.spaceli.pgv.ValidatorIndex index) throws RuntimeException {
+		validateAll(proto, index, cn.spaceli.pgv.ViolationCollector.FAIL_FAST);
+	}
+
+	public void assertValid({{ qualifiedName . }} proto, cn.spaceli.pgv.ValidatorIndex index, String... groups) throws RuntimeException {
+		validateAll(proto, index, cn.spaceli.pgv.ViolationCollector.failFast(groups));
+	}
+
+	public void assertValid({{ qualifiedName . }} proto, cn.spaceli.pgv.ValidatorIndex index, com.google.protobuf.FieldMask mask, String... groups) throws RuntimeException {
+		validateAll(proto, index, cn.spaceli.pgv.ViolationCollector.failFast(mask, groups));
+	}
+
+	public void validateAll({{ qualifiedName . }} proto, cn.spaceli.pgv.ValidatorIndex index, cn.spaceli.pgv.ViolationCollector violations) {
 	{{ if disabled . }}
 		// Validate is disabled for {{ simpleName . }}
 		return;
 	{{- else -}}
-	{{ range .NonOneOfFields -}}
-		{{ render (context $ctx .) }}
+	{{ range validatedFields . -}}
+		{{ $mask := withMask . }}{{ if $mask }}	{{ $mask }}
+		{{ end }}{{ render (context $ctx .) }}{{ if $mask }}
+		});{{ end }}
 	{{ end -}}
-	{{ template "oneOf" . }}
+	{{ template "oneOf" . }}{{ template "expr" . }}
 	{{- end }}
 	}
 `
@@ -1,71 +1,84 @@
 package java
 
-const numConstTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const numConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
 {{- if $r.Const }}
-	private final {{ javaTypeFor $ctx}} {{ constantName $ctx "Const" }} = {{ $r.GetConst }}{{ javaTypeLiteralSuffixFor $ctx }};
+	{{ constant (constantName $ctx "Const") (typeFor $ctx) (lit $r.GetConst) }}
 {{- end -}}
 {{- if $r.Lt }}
-	private final {{ javaTypeFor $ctx}} {{ constantName $ctx "Lt" }} = {{ $r.GetLt }}{{ javaTypeLiteralSuffixFor $ctx }};
+	{{ constant (constantName $ctx "Lt") (typeFor $ctx) (lit $r.GetLt) }}
 {{- end -}}
 {{- if $r.Lte }}
-	private final {{ javaTypeFor $ctx}} {{ constantName $ctx "Lte" }} = {{ $r.GetLte }}{{ javaTypeLiteralSuffixFor $ctx }};
+	{{ constant (constantName $ctx "Lte") (typeFor $ctx) (lit $r.GetLte) }}
 {{- end -}}
 {{- if $r.Gt }}
-	private final {{ javaTypeFor $ctx}} {{ constantName $ctx "Gt" }} = {{ $r.GetGt }}{{ javaTypeLiteralSuffixFor $ctx }};
+	{{ constant (constantName $ctx "Gt") (typeFor $ctx) (lit $r.GetGt) }}
 {{- end -}}
 {{- if $r.Gte }}
-	private final {{ javaTypeFor $ctx}} {{ constantName $ctx "Gte" }} = {{ $r.GetGte }}{{ javaTypeLiteralSuffixFor $ctx }};
+	{{ constant (constantName $ctx "Gte") (typeFor $ctx) (lit $r.GetGte) }}
 {{- end -}}
 {{- if $r.In }}
-	private final {{ javaTypeFor $ctx }}[] {{ constantName $ctx "In" }} = new {{ javaTypeFor $ctx }}[]{
-		{{- range $r.In -}}
-			{{- sprintf "%v" . -}}{{ javaTypeLiteralSuffixFor $ }},
-		{{- end -}}
-	};
+	{{ array (constantName $ctx "In") (typeFor $ctx) (lits $r.In) }}
 {{- end -}}
 {{- if $r.NotIn }}
-	private final {{ javaTypeFor $ctx }}[] {{ constantName $ctx "NotIn" }} = new {{ javaTypeFor $ctx }}[]{
-		{{- range $r.NotIn -}}
-			{{- sprintf "%v" . -}}{{ javaTypeLiteralSuffixFor $ }},
-		{{- end -}}
-	};
+	{{ array (constantName $ctx "NotIn") (typeFor $ctx) (lits $r.NotIn) }}
 {{- end -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}`
 
-const numTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const numTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
+{{- if $r.GetRequired }}
+		if (!{{ hasAccessor $ctx }}) {{ check $ctx "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null){{ endCheck }}
+{{- end -}}
+{{- if and (optional $ctx) (constrained $r) }}
+		if ({{ hasAccessor $ctx }}) {
+{{- end -}}
 {{- if $r.GetIgnoreEmpty }}
-		if ( {{ accessor $ctx }} != 0 ) {
+		if ({{ accessor $ctx }} != {{ zero $ctx }}) {
 {{- end -}}
 {{- if $r.Const }}
-			cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }});
+			{{ check $ctx "const" }}cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }}){{ endCheck }}
 {{- end -}}
 {{- if and (or $r.Lt $r.Lte) (or $r.Gt $r.Gte)}}
-			cn.spaceli.pgv.ComparativeValidation.range({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ if $r.Lt }}{{ constantName $ctx "Lt" }}{{ else }}null{{ end }}, {{ if $r.Lte }}{{ constantName $ctx "Lte" }}{{ else }}null{{ end }}, {{ if $r.Gt }}{{ constantName $ctx "Gt" }}{{ else }}null{{ end }}, {{ if $r.Gte }}{{ constantName $ctx "Gte" }}{{ else }}null{{ end }}, java.util.Comparator.naturalOrder());
+			{{ check $ctx "range" }}cn.spaceli.pgv.ComparativeValidation.range({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ if $r.Lt }}{{ constantName $ctx "Lt" }}{{ else }}null{{ end }}, {{ if $r.Lte }}{{ constantName $ctx "Lte" }}{{ else }}null{{ end }}, {{ if $r.Gt }}{{ constantName $ctx "Gt" }}{{ else }}null{{ end }}, {{ if $r.Gte }}{{ constantName $ctx "Gte" }}{{ else }}null{{ end }}, {{ naturalOrder }}){{ endCheck }}
 {{- else -}}
 {{- if $r.Lt }}
-			cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lt" }}, java.util.Comparator.naturalOrder());
+			{{ check $ctx "lt" }}cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lt" }}, {{ naturalOrder }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Lte }}
-			cn.spaceli.pgv.ComparativeValidation.lessThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lte" }}, java.util.Comparator.naturalOrder());
+			{{ check $ctx "lte" }}cn.spaceli.pgv.ComparativeValidation.lessThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lte" }}, {{ naturalOrder }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Gt }}
-			cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gt" }}, java.util.Comparator.naturalOrder());
+			{{ check $ctx "gt" }}cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gt" }}, {{ naturalOrder }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Gte }}
-			cn.spaceli.pgv.ComparativeValidation.greaterThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gte" }}, java.util.Comparator.naturalOrder());
+			{{ check $ctx "gte" }}cn.spaceli.pgv.ComparativeValidation.greaterThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gte" }}, {{ naturalOrder }}){{ endCheck }}
 {{- end -}}
 {{- end -}}
 {{- if $r.In }}
-			cn.spaceli.pgv.CollectiveValidation.in({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }});
+			{{ check $ctx "in" }}cn.spaceli.pgv.CollectiveValidation.{{ ident "in" }}({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.NotIn }}
-			cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }});
+			{{ check $ctx "not_in" }}cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIgnoreEmpty }}
 		}
 {{- end -}}
+{{- if and (optional $ctx) (constrained $r) }}
+		}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
+{{- end -}}
 {{- end -}}
 `
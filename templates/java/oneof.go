@@ -5,7 +5,7 @@ const oneOfConstTpl = `
 {{ range .RealOneOfs }}
 {{ $r := oneofRule .}}
 {{- if $r.GetError }}
-	private final RuntimeException {{ errorOneOfRequiredName $msg . }} = {{ error (context $msg (index .Fields 0)) $r.GetError }};
+	{{ constant (errorOneOfRequiredName $msg .) errorType (error (context $msg (index .Fields 0)) $r.GetError) }}
 {{- end -}}
 {{ range .Fields }}{{ renderConstants (context $msg .) }}{{- end -}}
 {{- end -}}
@@ -25,7 +25,7 @@ switch (proto.get{{camelCase .Name }}Case()) {
 	{{ end -}}
 	{{- if $r.GetRequired }}
 	default: 
-		cn.spaceli.pgv.RequiredValidation.required({{ errorOneOfRequiredName $msg $field }}, null);
+		{{ if groups $r }}if (violations.inGroups({{ groups $r }})) {{ end }}violations.check("{{ $field.Name }}", "oneof.required", () -> cn.spaceli.pgv.RequiredValidation.required({{ errorOneOfRequiredName $msg $field }}, null));
 	{{- end }}
 }
 {{- end -}}
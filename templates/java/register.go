@@ -2,21 +2,20 @@ package java
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
-	"io"
-	"io/ioutil"
-	"net"
-	"net/http"
+	"math"
 	"os"
-	"os/exec"
+	"reflect"
+	"strconv"
 	"strings"
 	"text/template"
-	"time"
 	"unicode"
 
 	"github.com/iancoleman/strcase"
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -24,10 +23,8 @@ import (
 	"github.com/curl-li/protoc-gen-validate/validate"
 )
 
-const googleJavaFormatVersion = "1.15.0"
-
 func RegisterIndex(tpl *template.Template, params pgs.Parameters) {
-	fns := javaFuncs{pgsgo.InitContext(params)}
+	fns := javaFuncs{Context: pgsgo.InitContext(params)}
 
 	tpl.Funcs(map[string]interface{}{
 		"classNameFile": classNameFile,
@@ -38,68 +35,127 @@ func RegisterIndex(tpl *template.Template, params pgs.Parameters) {
 	})
 }
 
+// BeanValidationParam generates the Jakarta Bean Validation constraints of
+// the messages of each file with `java_bean_validation=true`.
+const BeanValidationParam = "java_bean_validation"
+
+// RegisterConstraints registers the template of the Jakarta Bean Validation
+// constraints of a file.
+func RegisterConstraints(tpl *template.Template, params pgs.Parameters) {
+	fns := javaFuncs{Context: pgsgo.InitContext(params)}
+
+	tpl.Funcs(map[string]interface{}{
+		"binaryName":     fns.binaryName,
+		"classNameFile":  classNameFile,
+		"javaPackage":    javaPackage,
+		"simpleName":     fns.Name,
+		"qualifiedName":  fns.qualifiedName,
+		"validatorClass": fns.validatorClass,
+	})
+
+	template.Must(tpl.Parse(constraintsTpl))
+}
+
+// GrpcParam generates a gRPC server interceptor validating the messages of
+// each service with `java_grpc=true`.
+const GrpcParam = "java_grpc"
+
+// RegisterInterceptor registers the template of the gRPC server interceptor
+// of a service.
+func RegisterInterceptor(tpl *template.Template, params pgs.Parameters) {
+	fns := javaFuncs{Context: pgsgo.InitContext(params)}
+
+	tpl.Funcs(map[string]interface{}{
+		"interceptorName": interceptorName,
+		"javaPackage":     javaPackage,
+		"javaStringLit":   javaStringLit,
+		"methodName":      methodName,
+		"methodRules":     shared.MethodRules,
+		"qualifiedName":   fns.qualifiedName,
+		"serviceName":     serviceName,
+	})
+
+	template.Must(tpl.Parse(interceptorTpl))
+}
+
 func Register(tpl *template.Template, params pgs.Parameters) {
-	fns := javaFuncs{pgsgo.InitContext(params)}
+	fns := javaFuncs{
+		Context:    pgsgo.InitContext(params),
+		lazyErrors: params.Str("error_mode") == "lazy",
+	}
 
+	tpl.Funcs(fns.ruleFuncs(tpl))
 	tpl.Funcs(map[string]interface{}{
-		"accessor":                 fns.accessor,
-		"byteArrayLit":             fns.byteArrayLit,
-		"camelCase":                fns.camelCase,
-		"classNameFile":            classNameFile,
-		"classNameMessage":         classNameMessage,
-		"durLit":                   fns.durLit,
-		"fieldName":                fns.fieldName,
-		"javaPackage":              javaPackage,
-		"javaStringEscape":         fns.javaStringEscape,
-		"javaTypeFor":              fns.javaTypeFor,
-		"javaTypeLiteralSuffixFor": fns.javaTypeLiteralSuffixFor,
-		"hasAccessor":              fns.hasAccessor,
-		"oneof":                    fns.oneofTypeName,
-		"sprintf":                  fmt.Sprintf,
-		"simpleName":               fns.Name,
-		"tsLit":                    fns.tsLit,
-		"qualifiedName":            fns.qualifiedName,
-		"isOfFileType":             fns.isOfFileType,
-		"isOfMessageType":          fns.isOfMessageType,
-		"isOfStringType":           fns.isOfStringType,
-		"unwrap":                   fns.unwrap,
-		"renderConstants":          fns.renderConstants(tpl),
-		"constantName":             fns.constantName,
-		"error":                    fns.errorInitializer,
-		"errorName":                fns.errorName,
-		"errorOneOfRequiredName":   fns.errorNameOneofRequired,
+		"classNameFile":    classNameFile,
+		"classNameMessage": classNameMessage,
+		"isOfFileType":     fns.isOfFileType,
+		"isOfStringType":   fns.isOfStringType,
+		"javaPackage":      javaPackage,
 	})
 
 	template.Must(tpl.Parse(fileTpl))
 	template.Must(tpl.New("msg").Parse(msgTpl))
 	template.Must(tpl.New("msgInner").Parse(msgInnerTpl))
+	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
+	registerRules(tpl)
+}
 
+// ruleFuncs returns the functions of the templates of the rules, shared by the
+// Java validators and the Kotlin extensions.
+func (fns javaFuncs) ruleFuncs(tpl *template.Template) map[string]interface{} {
+	return map[string]interface{}{
+		"accessor":               fns.accessor,
+		"array":                  fns.array,
+		"byteArrayType":          fns.byteArrayType,
+		"bytesLit":               fns.bytesLit,
+		"camelCase":              fns.camelCase,
+		"check":                  fns.check,
+		"checkCall":              fns.checkCall,
+		"constant":               fns.constant,
+		"constantName":           fns.constantName,
+		"constrained":            shared.HasConstraints,
+		"endCheck":               fns.endCheck,
+		"endLambda":              fns.endLambda,
+		"enumLits":               fns.enumLits,
+		"error":                  fns.errorInitializer,
+		"errorName":              fns.errorName,
+		"errorOneOfRequiredName": fns.errorNameOneofRequired,
+		"errorType":              fns.errorType,
+		"exprRules":              fns.exprRules,
+		"fieldPath":              fns.fieldPath,
+		"groups":                 fns.groups,
+		"hasAccessor":            fns.hasAccessor,
+		"ident":                  fns.ident,
+		"lambda":                 fns.lambda,
+		"lit":                    fns.lit,
+		"lits":                   fns.lits,
+		"naturalOrder":           fns.naturalOrder,
+		"oneof":                  fns.oneofTypeName,
+		"optional":               fns.optional,
+		"qualifiedName":          fns.qualifiedName,
+		"renderConstants":        fns.renderConstants(tpl),
+		"requiredErrorName":      fns.requiredErrorName,
+		"simpleName":             fns.Name,
+		"stringLit":              fns.stringLit,
+		"typeFor":                fns.typeFor,
+		"unwrap":                 fns.unwrap,
+		"validateMessage":        fns.validateMessage,
+		"validatedFields":        fns.validatedFields,
+		"when":                   fns.when,
+		"withMask":               fns.withMask,
+		"zero":                   fns.zero,
+	}
+}
+
+// registerRules registers the templates of the rules of every type, which
+// render Java or Kotlin depending on the functions of tpl.
+func registerRules(tpl *template.Template) {
 	template.Must(tpl.New("none").Parse(noneTpl))
 
-	template.Must(tpl.New("float").Parse(numTpl))
-	template.Must(tpl.New("floatConst").Parse(numConstTpl))
-	template.Must(tpl.New("double").Parse(numTpl))
-	template.Must(tpl.New("doubleConst").Parse(numConstTpl))
-	template.Must(tpl.New("int32").Parse(numTpl))
-	template.Must(tpl.New("int32Const").Parse(numConstTpl))
-	template.Must(tpl.New("int64").Parse(numTpl))
-	template.Must(tpl.New("int64Const").Parse(numConstTpl))
-	template.Must(tpl.New("uint32").Parse(numTpl))
-	template.Must(tpl.New("uint32Const").Parse(numConstTpl))
-	template.Must(tpl.New("uint64").Parse(numTpl))
-	template.Must(tpl.New("uint64Const").Parse(numConstTpl))
-	template.Must(tpl.New("sint32").Parse(numTpl))
-	template.Must(tpl.New("sint32Const").Parse(numConstTpl))
-	template.Must(tpl.New("sint64").Parse(numTpl))
-	template.Must(tpl.New("sint64Const").Parse(numConstTpl))
-	template.Must(tpl.New("fixed32").Parse(numTpl))
-	template.Must(tpl.New("fixed32Const").Parse(numConstTpl))
-	template.Must(tpl.New("fixed64").Parse(numTpl))
-	template.Must(tpl.New("fixed64Const").Parse(numConstTpl))
-	template.Must(tpl.New("sfixed32").Parse(numTpl))
-	template.Must(tpl.New("sfixed32Const").Parse(numConstTpl))
-	template.Must(tpl.New("sfixed64").Parse(numTpl))
-	template.Must(tpl.New("sfixed64Const").Parse(numConstTpl))
+	for _, typ := range []string{"float", "double", "int32", "int64", "uint32", "uint64", "sint32", "sint64", "fixed32", "fixed64", "sfixed32", "sfixed64"} {
+		template.Must(tpl.New(typ).Parse(numTpl))
+		template.Must(tpl.New(typ + "Const").Parse(numConstTpl))
+	}
 
 	template.Must(tpl.New("bool").Parse(boolTpl))
 	template.Must(tpl.New("boolConst").Parse(boolConstTpl))
@@ -118,8 +174,9 @@ func Register(tpl *template.Template, params pgs.Parameters) {
 	template.Must(tpl.New("repeatedConst").Parse(repeatedConstTpl))
 	template.Must(tpl.New("map").Parse(mapTpl))
 	template.Must(tpl.New("mapConst").Parse(mapConstTpl))
-	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
 	template.Must(tpl.New("oneOfConst").Parse(oneOfConstTpl))
+	template.Must(tpl.New("expr").Parse(exprTpl))
+	template.Must(tpl.New("exprConst").Parse(exprConstTpl))
 
 	template.Must(tpl.New("required").Parse(requiredTpl))
 	template.Must(tpl.New("timestamp").Parse(timestampTpl))
@@ -130,81 +187,13 @@ func Register(tpl *template.Template, params pgs.Parameters) {
 	template.Must(tpl.New("wrapperConst").Parse(wrapperConstTpl))
 }
 
-type javaFuncs struct{ pgsgo.Context }
-
-func CodeFormat(in io.Reader, out io.Writer) error {
-	toolName := fmt.Sprintf("google-java-format-%s-all-deps.jar", googleJavaFormatVersion)
-	if _, err := os.Stat(toolName); err != nil {
-		if !os.IsNotExist(err) {
-			return fmt.Errorf("get format tool info failed, %w", err)
-		}
-		if err = downloadFormatTool(toolName); err != nil {
-			return fmt.Errorf("download format tool failed, %w", err)
-		}
-	}
-	tmp, err := os.CreateTemp("", "*.java")
-	if err != nil {
-		return fmt.Errorf("create temp file for formatter failed, %w", err)
-	}
-	if _, err = io.Copy(tmp, in); err != nil {
-		return fmt.Errorf("copy data to temp file failed, %w", err)
-	}
-	cmd := exec.Command("java", "-jar", toolName, tmp.Name())
-	stdout, err := cmd.StdoutPipe()
-	if err != nil {
-		return fmt.Errorf("get stdout failed, %w", err)
-	}
-	defer stdout.Close()
-	stderr, err := cmd.StderrPipe()
-	if err != nil {
-		return fmt.Errorf("get stderr failed, %w", err)
-	}
-	defer stderr.Close()
-
-	if err = cmd.Start(); err != nil {
-		return fmt.Errorf("start format command failed, %w", err)
-	}
-
-	if errBs, _ := ioutil.ReadAll(stderr); len(errBs) > 0 {
-		return fmt.Errorf("format code failed, %s", string(errBs))
-	}
-
-	if _, err = io.Copy(out, stdout); err != nil {
-		return fmt.Errorf("copy data from stdout failed, %w", err)
-	}
-	return nil
-}
-
-func downloadFormatTool(toolName string) (err error) {
-	tool, err := os.Create(toolName)
-	if err != nil {
-		return fmt.Errorf("create tool file failed, %w", err)
-	}
-	defer func() {
-		_ = tool.Close()
-		if err != nil {
-			_ = os.Remove(toolName)
-		}
-	}()
-	cli := &http.Client{
-		Transport: &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).DialContext,
-		},
-		Timeout: 30 * time.Second,
-	}
-	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("https://github.com/google/google-java-format/releases/download/v%s/%s", googleJavaFormatVersion, toolName), nil)
-	resp, err := cli.Do(req)
-	if err != nil {
-		return err
-	}
-	if _, err = io.Copy(tool, resp.Body); err != nil {
-		return fmt.Errorf("write tool data failed, %w", err)
-	}
-	return nil
+type javaFuncs struct {
+	pgsgo.Context
+	// lazyErrors builds errors when a rule fails instead of once per validator.
+	lazyErrors bool
+	// kotlin renders the rules as the Kotlin extensions instead of the Java
+	// validators.
+	kotlin bool
 }
 
 func JavaFilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
@@ -219,6 +208,32 @@ func JavaFilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.Fi
 	return &filePath
 }
 
+func ConstraintsFilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	if !importsPvg(f) {
+		return nil
+	}
+
+	fullPath := strings.Replace(javaPackage(f), ".", string(os.PathSeparator), -1)
+	filePath := pgs.JoinPaths(fullPath, classNameFile(f)+"Constraints.java")
+	return &filePath
+}
+
+// InterceptorsFilePath returns the directory of the gRPC server interceptors
+// of the services of f, nil if it has none.
+func InterceptorsFilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	if len(f.Services()) == 0 {
+		return nil
+	}
+
+	filePath := pgs.FilePath(strings.Replace(javaPackage(f), ".", string(os.PathSeparator), -1))
+	return &filePath
+}
+
+func InterceptorFilePath(f pgs.File, s pgs.Service) pgs.FilePath {
+	fullPath := strings.Replace(javaPackage(f), ".", string(os.PathSeparator), -1)
+	return pgs.JoinPaths(fullPath, interceptorName(s)+".java")
+}
+
 func JavaMultiFilePath(f pgs.File, m pgs.Message) pgs.FilePath {
 	fullPath := strings.Replace(javaPackage(f), ".", string(os.PathSeparator), -1)
 	fileName := classNameMessage(m) + "Validator.java"
@@ -280,6 +295,12 @@ func javaPackage(file pgs.File) string {
 }
 
 func (fns javaFuncs) qualifiedName(entity pgs.Entity) string {
+	if fns.kotlin {
+		// the Java name, its keywords quoted
+		fns.kotlin = false
+		return kotlinName(fns.qualifiedName(entity))
+	}
+
 	file, isFile := entity.(pgs.File)
 	if isFile {
 		name := javaPackage(file)
@@ -308,6 +329,46 @@ func (fns javaFuncs) qualifiedName(entity pgs.Entity) string {
 	return entity.Name().String()
 }
 
+func interceptorName(s pgs.Service) string {
+	return s.Name().String() + "ValidatingInterceptor"
+}
+
+func serviceName(s pgs.Service) string {
+	return strings.TrimPrefix(s.FullyQualifiedName(), ".")
+}
+
+// methodName returns the full name gRPC calls m by.
+func methodName(m pgs.Method) string {
+	return serviceName(m.Service()) + "/" + m.Name().String()
+}
+
+// binaryName returns the binary name of the class of m, nested classes are
+// separated by a $.
+func (fns javaFuncs) binaryName(m pgs.Message) string {
+	pkg := javaPackage(m.File())
+	name := strings.ReplaceAll(strings.TrimPrefix(fns.qualifiedName(m), pkg+"."), ".", "$")
+	if pkg == "" {
+		return name
+	}
+	return pkg + "." + name
+}
+
+// validatorClass returns the qualified name of the generated class whose
+// validatorFor returns the validator of m.
+func (fns javaFuncs) validatorClass(m pgs.Message) string {
+	f := m.File()
+	if !f.Descriptor().GetOptions().GetJavaMultipleFiles() {
+		return javaPackage(f) + "." + classNameFile(f) + "Validator"
+	}
+	for {
+		parent, ok := m.Parent().(pgs.Message)
+		if !ok {
+			return javaPackage(f) + "." + classNameMessage(m) + "Validator"
+		}
+		m = parent
+	}
+}
+
 // Replace invalid identifier characters with an underscore
 func makeInvalidClassnameCharactersUnderscores(name string) string {
 	var sb string
@@ -404,6 +465,22 @@ func (fns javaFuncs) fieldAccessor(f pgs.Field) string {
 	return fmt.Sprintf("proto.get%s()", fieldName)
 }
 
+// withMask opens the validation of f under the field mask of its message if
+// f is the target of its field mask rule, the template closes it. It is empty
+// for any other field.
+func (fns javaFuncs) withMask(f pgs.Field) (string, error) {
+	rule, err := shared.FieldMaskRule(f.Message())
+	if err != nil || rule.GetTarget() != f.Name().String() {
+		return "", err
+	}
+	for _, mask := range f.Message().Fields() {
+		if mask.Name().String() == rule.GetField() {
+			return fmt.Sprintf("violations.withMask(%s, \"%s\"%s", fns.fieldAccessor(mask), f.Name(), fns.lambda("")), nil
+		}
+	}
+	return "", fmt.Errorf("unknown field mask %s of %s", rule.GetField(), f.Message().Name())
+}
+
 func (fns javaFuncs) hasAccessor(ctx shared.RuleContext) string {
 	if ctx.AccessorOverride != "" {
 		return "true"
@@ -413,6 +490,23 @@ func (fns javaFuncs) hasAccessor(ctx shared.RuleContext) string {
 	return "proto.has" + fiedlName + "()"
 }
 
+// optional reports whether ctx is a proto3 optional field, whose rules only
+// apply once it is set.
+func (fns javaFuncs) optional(ctx shared.RuleContext) bool {
+	return ctx.AccessorOverride == "" && ctx.Field.Syntax() == pgs.Proto3 && ctx.Field.HasOptionalKeyword()
+}
+
+// validatedFields returns the fields of msg outside of a real oneof, proto3
+// optional fields included.
+func (fns javaFuncs) validatedFields(msg pgs.Message) (out []pgs.Field) {
+	for _, f := range msg.Fields() {
+		if !f.InRealOneOf() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
 func (fns javaFuncs) fieldName(ctx shared.RuleContext) string {
 	return ctx.Field.Name().String()
 }
@@ -488,72 +582,189 @@ func (fns javaFuncs) javaTypeForProtoType(t pgs.ProtoType) string {
 	}
 }
 
-func (fns javaFuncs) javaTypeLiteralSuffixFor(ctx shared.RuleContext) string {
-	t := ctx.Field.Type()
+// typeFor returns the type of the values of ctx, boxed in Java.
+func (fns javaFuncs) typeFor(ctx shared.RuleContext) string {
+	return fns.typeName(fns.javaTypeFor(ctx))
+}
 
-	if t.IsMap() {
-		switch ctx.AccessorOverride {
-		case "key":
-			return fns.javaTypeLiteralSuffixForPrototype(t.Key().ProtoType())
-		case "value":
-			return fns.javaTypeLiteralSuffixForPrototype(t.Element().ProtoType())
-		}
+// typeName returns the Kotlin counterpart of the boxed Java type t, or t in
+// Java.
+func (fns javaFuncs) typeName(t string) string {
+	if !fns.kotlin {
+		return t
 	}
+	switch t {
+	case "Integer":
+		return "Int"
+	case "Object":
+		return "Any"
+	}
+	return t
+}
 
-	if t.IsEmbed() {
-		if embed := t.Embed(); embed.IsWellKnown() {
-			switch embed.WellKnownType() {
-			case pgs.Int64ValueWKT, pgs.UInt64ValueWKT:
-				return "L"
-			case pgs.FloatValueWKT:
-				return "F"
-			case pgs.DoubleValueWKT:
-				return "D"
-			}
-		}
+// zero returns the literal of the zero value of the numbers of ctx, which
+// Kotlin only compares to numbers of the same type.
+func (fns javaFuncs) zero(ctx shared.RuleContext) string {
+	if !fns.kotlin {
+		return "0"
 	}
+	switch fns.typeFor(ctx) {
+	case "Long":
+		return "0L"
+	case "Float":
+		return "0.0F"
+	case "Double":
+		return "0.0"
+	default:
+		return "0"
+	}
+}
 
-	return fns.javaTypeLiteralSuffixForPrototype(t.ProtoType())
+// lit renders the value v of a rule as a literal of the type the field is
+// read as.
+func (fns javaFuncs) lit(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return fns.stringLit(v)
+	case []byte:
+		return "com.google.protobuf.ByteString.copyFrom(" + fns.bytesLit(v) + ")"
+	case *durationpb.Duration:
+		return fns.durLit(v)
+	case *timestamppb.Timestamp:
+		return fns.tsLit(v)
+	}
+	if fns.kotlin {
+		return kotlinNumLit(v)
+	}
+	return javaNumLit(v)
 }
 
-func (fns javaFuncs) javaTypeLiteralSuffixForPrototype(t pgs.ProtoType) string {
-	switch t {
-	case pgs.Int64T, pgs.UInt64T, pgs.SInt64, pgs.Fixed64T, pgs.SFixed64:
-		return "L"
-	case pgs.FloatT:
-		return "F"
-	case pgs.DoubleT:
-		return "D"
-	default:
-		return ""
+// lits renders the values of an in or not_in rule as literals.
+func (fns javaFuncs) lits(values interface{}) []string {
+	v := reflect.ValueOf(values)
+	out := make([]string, v.Len())
+	for i := range out {
+		out[i] = fns.lit(v.Index(i).Interface())
 	}
+	return out
 }
 
-func (fns javaFuncs) javaStringEscape(s string) string {
-	s = fmt.Sprintf("%q", s)
-	s = s[1 : len(s)-1]
-	s = strings.Replace(s, `\u00`, `\x`, -1)
-	s = strings.Replace(s, `\x`, `\\x`, -1)
-	// s = strings.Replace(s, `\`, `\\`, -1)
-	s = strings.Replace(s, `"`, `\"`, -1)
-	return `"` + s + `"`
+// enumLits renders the enum values of an in or not_in rule on ctx.
+func (fns javaFuncs) enumLits(ctx shared.RuleContext, values []int32) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = fmt.Sprintf("%s.forNumber(%d)", fns.typeFor(ctx), v)
+	}
+	return out
 }
 
-func (fns javaFuncs) camelCase(name pgs.Name) string {
-	return strcase.ToCamel(name.String())
+// javaNumLit renders the number v of a rule as a Java literal of the type Java
+// reads the field as, unsigned ints being held in signed ones.
+func javaNumLit(v interface{}) string {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10) + "L"
+	case uint32:
+		if v > math.MaxInt32 {
+			return fmt.Sprintf("Integer.parseUnsignedInt(\"%d\")", v)
+		}
+	case uint64:
+		if v > math.MaxInt64 {
+			return fmt.Sprintf("Long.parseUnsignedLong(\"%d\")", v)
+		}
+		return strconv.FormatUint(v, 10) + "L"
+	case float32:
+		return javaFloatLit(float64(v), 32)
+	case float64:
+		return javaFloatLit(v, 64)
+	}
+	return fmt.Sprint(v)
 }
 
-func (fns javaFuncs) byteArrayLit(bytes []uint8) string {
+// javaFloatLit renders f as a Java float or double literal.
+func javaFloatLit(f float64, bitSize int) string {
+	typ, suffix := "Double", "D"
+	if bitSize == 32 {
+		typ, suffix = "Float", "F"
+	}
+	switch {
+	case math.IsNaN(f):
+		return typ + ".NaN"
+	case math.IsInf(f, 1):
+		return typ + ".POSITIVE_INFINITY"
+	case math.IsInf(f, -1):
+		return typ + ".NEGATIVE_INFINITY"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bitSize) + suffix
+}
+
+// stringLit quotes s as a string literal.
+func (fns javaFuncs) stringLit(s string) string {
+	if fns.kotlin {
+		return kotlinStringLit(s)
+	}
+	return javaStringLit(s)
+}
+
+// bytesLit renders b as a byte array.
+func (fns javaFuncs) bytesLit(b []byte) string {
+	if fns.kotlin {
+		return kotlinBytesLit(b)
+	}
 	var sb string
 	sb += "new byte[]{"
-	for _, b := range bytes {
-		sb += fmt.Sprintf("(byte)%#x,", b)
+	for _, c := range b {
+		sb += fmt.Sprintf("(byte)%#x,", c)
 	}
 	sb += "}"
 
 	return sb
 }
 
+// byteArrayType is the type of the byte arrays of bytesLit.
+func (fns javaFuncs) byteArrayType() string {
+	if fns.kotlin {
+		return "ByteArray"
+	}
+	return "byte[]"
+}
+
+// constant declares the constant name of type typ, set to value.
+func (fns javaFuncs) constant(name, typ, value string) string {
+	if fns.kotlin {
+		return fmt.Sprintf("private val %s: %s = %s", name, typ, value)
+	}
+	return fmt.Sprintf("private final %s %s = %s;", typ, name, value)
+}
+
+// array declares the constant name, an array of values of type typ.
+func (fns javaFuncs) array(name, typ string, values []string) string {
+	if fns.kotlin {
+		return fmt.Sprintf("private val %s: Array<%s> = arrayOf(%s)", name, typ, strings.Join(values, ", "))
+	}
+	return fmt.Sprintf("private final %s[] %s = new %s[]{%s};", typ, name, typ, strings.Join(values, ", "))
+}
+
+// ident quotes the identifier name if it is a keyword.
+func (fns javaFuncs) ident(name string) string {
+	if fns.kotlin {
+		return kotlinName(name)
+	}
+	return name
+}
+
+// naturalOrder returns the comparator of Comparable values.
+func (fns javaFuncs) naturalOrder() string {
+	if fns.kotlin {
+		return "naturalOrder()"
+	}
+	return "java.util.Comparator.naturalOrder()"
+}
+
+func (fns javaFuncs) camelCase(name pgs.Name) string {
+	return strcase.ToCamel(name.String())
+}
+
 func (fns javaFuncs) durLit(dur *durationpb.Duration) string {
 	return fmt.Sprintf(
 		"cn.spaceli.pgv.TimestampValidation.toDuration(%d,%d)",
@@ -579,10 +790,6 @@ func (fns javaFuncs) isOfFileType(o interface{}) bool {
 	}
 }
 
-func (fns javaFuncs) isOfMessageType(f pgs.Field) bool {
-	return f.Type().ProtoType() == pgs.MessageT
-}
-
 func (fns javaFuncs) isOfStringType(f pgs.Field) bool {
 	return f.Type().ProtoType() == pgs.StringT
 }
@@ -621,12 +828,19 @@ func (fns javaFuncs) constantName(ctx shared.RuleContext, rule string) string {
 	return strcase.ToScreamingSnake(ctx.Field.Name().String() + "_" + ctx.Index + "_" + rule)
 }
 
-func (fns javaFuncs) errorInitializer(ctx shared.RuleContext, e *validate.Error) string {
-	var pkg, class string
-	if ctx.ErrBase != nil {
-		pkg = ctx.ErrBase.GetPkg()
-		class = ctx.ErrBase.GetClass()
+func (fns javaFuncs) errorInitializer(ctx shared.RuleContext, e *validate.Error) (string, error) {
+	args, err := shared.FieldErrorArgs(ctx.Field, e)
+	if err != nil {
+		return "", err
 	}
+	return fns.newError(ctx.ErrBase, e, args, fns.valueType(ctx))
+}
+
+// newError renders the initializer of the error e, inheriting the pkg and
+// class of base. Lazily built errors cast the checked value to valueType, if
+// known, for $value to keep its type.
+func (fns javaFuncs) newError(base *validate.ErrorBase, e *validate.Error, args []shared.ErrorArg, valueType string) (string, error) {
+	pkg, class := base.GetPkg(), base.GetClass()
 	if len(e.GetPkg()) > 0 {
 		pkg = e.GetPkg()
 	}
@@ -634,24 +848,181 @@ func (fns javaFuncs) errorInitializer(ctx shared.RuleContext, e *validate.Error)
 		class = e.GetClass()
 	}
 	buf := strings.Builder{}
+	if fns.lazyErrors && fns.kotlin {
+		buf.WriteString("cn.spaceli.pgv.LazyException { value -> ")
+	} else if fns.lazyErrors {
+		buf.WriteString("new cn.spaceli.pgv.LazyException(value -> ")
+	}
 	if len(pkg) > 0 {
-		buf.WriteString(pkg + ".")
+		buf.WriteString(fns.ident(pkg) + ".")
 	}
 	if len(class) > 0 {
 		buf.WriteString(class + ".")
 	}
 	buf.WriteString(e.GetMethod())
 	buf.WriteByte('(')
-	for i := range e.GetParams() {
+	for i, arg := range args {
 		if i != 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteByte('"')
-		buf.WriteString(e.GetParams()[i])
-		buf.WriteByte('"')
+		if arg.Kind == shared.ValueArg && fns.lazyErrors {
+			switch {
+			case valueType == "":
+				buf.WriteString("value")
+			case fns.kotlin:
+				buf.WriteString("value as " + valueType)
+			default:
+				buf.WriteString("(" + valueType + ") value")
+			}
+			continue
+		}
+		lit, err := fns.argLit(arg)
+		if err != nil {
+			return "", fmt.Errorf("error %q: %w", e.GetMethod(), err)
+		}
+		buf.WriteString(lit)
 	}
 	buf.WriteByte(')')
-	return buf.String()
+	if fns.lazyErrors && fns.kotlin {
+		buf.WriteString(" }")
+	} else if fns.lazyErrors {
+		buf.WriteByte(')')
+	}
+	return buf.String(), nil
+}
+
+// valueType returns the type of the value checked by the rules of ctx, the
+// one ViolationCollector passes to lazily built errors.
+func (fns javaFuncs) valueType(ctx shared.RuleContext) string {
+	t := ctx.Field.Type()
+	switch {
+	case ctx.Typ == "repeated" || ctx.Typ == "map":
+		return fns.fieldType(ctx.Field)
+	case t.IsMap() && ctx.AccessorOverride == "key":
+		return fns.elemType(t.Key())
+	case t.IsMap() || t.IsRepeated():
+		return fns.elemType(t.Element())
+	case ctx.AccessorOverride != "":
+		// the value of a wrapper
+		return fns.typeName(fns.javaTypeForProtoType(t.Embed().Fields()[0].Type().ProtoType()))
+	}
+	return fns.fieldType(ctx.Field)
+}
+
+// fieldType returns the type of the getter of f.
+func (fns javaFuncs) fieldType(f pgs.Field) string {
+	list, dict := "java.util.List", "java.util.Map"
+	if fns.kotlin {
+		list, dict = "List", "Map"
+	}
+	t := f.Type()
+	switch {
+	case t.IsMap():
+		return fmt.Sprintf("%s<%s, %s>", dict, fns.elemType(t.Key()), fns.elemType(t.Element()))
+	case t.IsRepeated():
+		return fmt.Sprintf("%s<%s>", list, fns.elemType(t.Element()))
+	case t.IsEmbed():
+		return fns.qualifiedName(t.Embed())
+	case t.IsEnum():
+		return fns.qualifiedName(t.Enum())
+	}
+	return fns.typeName(fns.javaTypeForProtoType(t.ProtoType()))
+}
+
+// elemType returns the type of the items of a repeated field, or of the keys
+// or values of a map field.
+func (fns javaFuncs) elemType(el pgs.FieldTypeElem) string {
+	switch {
+	case el.IsEmbed():
+		return fns.qualifiedName(el.Embed())
+	case el.IsEnum():
+		return fns.qualifiedName(el.Enum())
+	}
+	return fns.typeName(fns.javaTypeForProtoType(el.ProtoType()))
+}
+
+// errorType is the type of the fields holding the errors of rules.
+func (fns javaFuncs) errorType() string {
+	if fns.lazyErrors {
+		return "cn.spaceli.pgv.LazyException"
+	}
+	return "RuntimeException"
+}
+
+// argLit renders arg as a literal of the matching type.
+func (fns javaFuncs) argLit(arg shared.ErrorArg) (string, error) {
+	if fns.kotlin {
+		return kotlinArgLit(arg)
+	}
+	return javaArgLit(arg)
+}
+
+// javaArgLit renders arg as a Java literal of the matching type.
+func javaArgLit(arg shared.ErrorArg) (string, error) {
+	switch arg.Kind {
+	case shared.IntArg:
+		if arg.Int >= math.MinInt32 && arg.Int <= math.MaxInt32 {
+			return strconv.FormatInt(arg.Int, 10), nil
+		}
+		return strconv.FormatInt(arg.Int, 10) + "L", nil
+	case shared.UintArg:
+		switch {
+		case arg.Uint <= math.MaxInt32:
+			return strconv.FormatUint(arg.Uint, 10), nil
+		case arg.Uint <= math.MaxInt64:
+			return strconv.FormatUint(arg.Uint, 10) + "L", nil
+		}
+		return fmt.Sprintf("Long.parseUnsignedLong(\"%d\")", arg.Uint), nil
+	case shared.FloatArg:
+		return javaFloatLit(arg.Float, 64), nil
+	case shared.BoolArg:
+		return strconv.FormatBool(arg.Bool), nil
+	case shared.RefArg:
+		return arg.Str, nil
+	case shared.ValueArg:
+		return "", errors.New("$value is only known to errors built with error_mode=lazy")
+	default:
+		return javaStringLit(arg.Str), nil
+	}
+}
+
+// javaStringLit quotes s as a Java string literal.
+func javaStringLit(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// groups renders the validation groups of the rule r as the arguments of
+// ViolationCollector.inGroups, empty if it always applies.
+func (fns javaFuncs) groups(r proto.Message) string {
+	groups := shared.RuleGroups(r)
+	lits := make([]string, len(groups))
+	for i, g := range groups {
+		lits[i] = fns.stringLit(g)
+	}
+	return strings.Join(lits, ", ")
 }
 
 func (fns javaFuncs) errorName(ctx shared.RuleContext, index int) string {
@@ -664,3 +1035,98 @@ func (fns javaFuncs) errorName(ctx shared.RuleContext, index int) string {
 func (fns javaFuncs) errorNameOneofRequired(msg pgs.Message, field pgs.OneOf) string {
 	return strcase.ToScreamingSnake(fmt.Sprintf("%s_REQUIRED_ERROR", field.Name()))
 }
+
+// requiredErrorName returns the name of the error of the required rule of the
+// wrapper field of ctx.
+func (fns javaFuncs) requiredErrorName(ctx shared.RuleContext) string {
+	return strcase.ToScreamingSnake(ctx.Field.Name().String() + "_REQUIRED_ERROR")
+}
+
+// fieldPath returns the name a violation on ctx is reported under, relative to
+// the enclosing path. Repeated items and map entries are already tracked by the
+// runtime, so their rules report against the current path itself.
+func (fns javaFuncs) fieldPath(ctx shared.RuleContext) string {
+	switch ctx.AccessorOverride {
+	case "item", "key", "value":
+		return ""
+	}
+	return ctx.Field.Name().String()
+}
+
+// check opens a ViolationCollector.check call for the named rule on ctx;
+// the template closes it with endCheck after the validation call.
+func (fns javaFuncs) check(ctx shared.RuleContext, rule string) string {
+	value := fns.accessor(ctx)
+	if rule == "required" {
+		value = "null"
+	}
+	return fns.checkCall(fns.fieldPath(ctx), ctx.Typ+"."+rule, value)
+}
+
+// checkCall opens a ViolationCollector.check call for the rule at path, a
+// lambda in Java and a trailing lambda in Kotlin. Lazily built errors also
+// get the checked value.
+func (fns javaFuncs) checkCall(path, rule, value string) string {
+	args := fmt.Sprintf("\"%s\", \"%s\"", path, rule)
+	if fns.lazyErrors {
+		args += ", " + value
+	}
+	if fns.kotlin {
+		return "violations.check(" + args + ") { "
+	}
+	return "violations.check(" + args + ", () -> "
+}
+
+// endCheck closes the call opened by check.
+func (fns javaFuncs) endCheck() string {
+	if fns.kotlin {
+		return " }"
+	}
+	return ");"
+}
+
+// lambda opens the last argument of a call, a lambda of param, if any, whose
+// body endLambda closes.
+func (fns javaFuncs) lambda(param string) string {
+	switch {
+	case fns.kotlin && param == "":
+		return ") {"
+	case fns.kotlin:
+		return ") { " + param + " ->"
+	case param == "":
+		return ", () -> {"
+	}
+	return ", " + param + " -> {"
+}
+
+// endLambda closes the lambda and the call opened by lambda.
+func (fns javaFuncs) endLambda() string {
+	if fns.kotlin {
+		return "}"
+	}
+	return "});"
+}
+
+// validateMessage renders the validation of the message of ctx within its
+// path, empty if it has none to descend into. The Kotlin extensions only
+// descend into messages which have some.
+func (fns javaFuncs) validateMessage(ctx shared.RuleContext) (string, error) {
+	value := fns.accessor(ctx)
+	var call string
+	if fns.kotlin {
+		ok, err := fns.validates(ctx)
+		if !ok || err != nil {
+			return "", err
+		}
+		call = fmt.Sprintf("violations.within(\"%s\") { %s.validateAll(violations) }", fns.fieldPath(ctx), value)
+	} else {
+		if ctx.Field.Type().ProtoType() != pgs.MessageT {
+			return "", nil
+		}
+		call = fmt.Sprintf("violations.within(\"%s\", () -> index.validatorFor(%s).validateAll(%s, violations));", fns.fieldPath(ctx), value, value)
+	}
+	if has := fns.hasAccessor(ctx); has != "true" {
+		call = "if (" + has + ") " + call
+	}
+	return call, nil
+}
@@ -1,10 +1,112 @@
 package java
 
 import (
-	"fmt"
+	"bytes"
+	"math"
+	"strings"
 	"testing"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
 )
 
-func TestDownloadFormatTool(t *testing.T) {
-	t.Log(downloadFormatTool(fmt.Sprintf("google-java-format-%s-all-deps.jar", googleJavaFormatVersion)))
+func TestJavaArgLit(t *testing.T) {
+	tests := []struct {
+		arg  shared.ErrorArg
+		want string
+	}{
+		{shared.ErrorArg{Kind: shared.IntArg, Int: 42}, "42"},
+		{shared.ErrorArg{Kind: shared.IntArg, Int: 5000000000}, "5000000000L"},
+		{shared.ErrorArg{Kind: shared.UintArg, Uint: 1 << 63}, `Long.parseUnsignedLong("9223372036854775808")`},
+		{shared.ErrorArg{Kind: shared.FloatArg, Float: 2.5}, "2.5D"},
+		{shared.ErrorArg{Kind: shared.BoolArg, Bool: true}, "true"},
+		{shared.ErrorArg{Kind: shared.RefArg, Str: "com.acme.Codes.NOT_FOUND"}, "com.acme.Codes.NOT_FOUND"},
+		{shared.ErrorArg{Kind: shared.StringArg, Str: "a\"b\n"}, `"a\"b\n"`},
+	}
+	for _, tt := range tests {
+		got, err := javaArgLit(tt.arg)
+		if err != nil || got != tt.want {
+			t.Errorf("javaArgLit(%+v) = %q, %v; want %q", tt.arg, got, err, tt.want)
+		}
+	}
+
+	if _, err := javaArgLit(shared.ErrorArg{Kind: shared.ValueArg}); err == nil {
+		t.Error("javaArgLit($value) should fail for eagerly created errors")
+	}
+}
+
+func TestKotlinLiterals(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{kotlinNumLit(int32(math.MinInt32)), "Int.MIN_VALUE"},
+		{kotlinNumLit(int64(-3)), "-3L"},
+		{kotlinNumLit(uint32(math.MaxUint32)), "4294967295u.toInt()"},
+		{kotlinNumLit(uint64(math.MaxUint64)), "18446744073709551615uL.toLong()"},
+		{kotlinNumLit(float32(0.5)), "0.5F"},
+		{kotlinNumLit(float64(2)), "2.0"},
+		{kotlinNumLit(math.Inf(-1)), "Double.NEGATIVE_INFINITY"},
+		{kotlinStringLit("${a}\n"), `"\${a}\n"`},
+		{kotlinName("com.acme.in.v1"), "com.acme.`in`.v1"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %s; want %s", tt.got, tt.want)
+		}
+	}
+}
+
+func TestKotlinCodeFormat(t *testing.T) {
+	in := `package acme
+
+
+fun M.validateAll(violations: ViolationCollector) {
+MRules.validateAll(this, violations)
+}
+
+private object MRules {
+private val S__IN: Array<String> = arrayOf("{", "}")
+
+fun validateAll(proto: M, violations: ViolationCollector) {
+
+violations.check("s", "string.in") { CollectiveValidation.` + "`in`" + `(S_ERROR_0, proto.getS(), S__IN) }
+
+when (proto.getOCase()) {
+M.OCase.X -> {
+// no validation rules for x
+}
+
+else -> {
+}
+}
+}
+}
+`
+	want := `package acme
+
+fun M.validateAll(violations: ViolationCollector) {
+    MRules.validateAll(this, violations)
+}
+
+private object MRules {
+    private val S__IN: Array<String> = arrayOf("{", "}")
+
+    fun validateAll(proto: M, violations: ViolationCollector) {
+        violations.check("s", "string.in") { CollectiveValidation.` + "`in`" + `(S_ERROR_0, proto.getS(), S__IN) }
+        when (proto.getOCase()) {
+            M.OCase.X -> {
+                // no validation rules for x
+            }
+            else -> {
+            }
+        }
+    }
+}
+`
+	var out bytes.Buffer
+	if err := KotlinCodeFormat(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("KotlinCodeFormat:\n%s\nwant:\n%s", out.String(), want)
+	}
 }
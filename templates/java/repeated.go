@@ -3,31 +3,43 @@ package java
 const repeatedConstTpl = `{{ renderConstants (.Elem "" "") }}
 {{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}
 `
 
-const repeatedTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const repeatedTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
 {{- if $r.GetIgnoreEmpty }}
-		if ( !{{ accessor $ctx }}.isEmpty() ) {
+		if (!{{ accessor $ctx }}.isEmpty()) {
 {{- end -}}
 {{- if $r.GetMinItems }}
-			cn.spaceli.pgv.RepeatedValidation.minItems({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinItems }});
+			{{ check $ctx "min_items" }}cn.spaceli.pgv.RepeatedValidation.minItems({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinItems }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetMaxItems }}
-			cn.spaceli.pgv.RepeatedValidation.maxItems({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxItems }});
+			{{ check $ctx "max_items" }}cn.spaceli.pgv.RepeatedValidation.maxItems({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxItems }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetUnique }}
-			cn.spaceli.pgv.RepeatedValidation.unique({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "unique" }}cn.spaceli.pgv.RepeatedValidation.unique({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end }}
 {{- if $r.GetItems }}
-			cn.spaceli.pgv.RepeatedValidation.forEach({{ accessor $ctx }}, item -> {
+			cn.spaceli.pgv.RepeatedValidation.forEach(violations, "{{ fieldPath $ctx }}", {{ accessor $ctx }}{{ lambda "item" }}
 				{{ render ($ctx.ElemWithErrIndex "item" "" $index) }}
-			});
+			{{ endLambda }}
 {{- end }}
 {{- if $r.GetIgnoreEmpty }}
 		}
 {{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
+{{- end -}}
 {{- end -}}
 `
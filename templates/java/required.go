@@ -1,11 +1,7 @@
 package java
 
-const requiredTpl = `{{ $f := .Field }}
+const requiredTpl = `
 	{{- if .Rules.GetRequired }}
-		if ({{ hasAccessor . }}) {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, {{ accessor . }});
-		} else {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, null);
-		};
+		if (!{{ hasAccessor . }}) {{ check . "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName . 0 }}, null){{ endCheck }}
 	{{- end -}}
 `
@@ -1,104 +1,117 @@
 package java
 
-const stringConstTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const stringConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
 {{- if $r.In }}
-	private final {{ javaTypeFor $ctx }}[] {{ constantName $ctx "In" }} = new {{ javaTypeFor $ctx }}[]{
-		{{- range $r.In -}}
-			"{{- sprintf "%v" . -}}",
-		{{- end -}}
-	};
+	{{ array (constantName $ctx "In") "String" (lits $r.In) }}
 {{- end -}}
 {{- if $r.NotIn }}
-	private final {{ javaTypeFor $ctx }}[] {{ constantName $ctx "NotIn" }} = new {{ javaTypeFor $ctx }}[]{
-		{{- range $r.NotIn -}}
-			"{{- sprintf "%v" . -}}",
-		{{- end -}}
-	};
+	{{ array (constantName $ctx "NotIn") "String" (lits $r.NotIn) }}
 {{- end -}}
 {{- if $r.Pattern }}
-	com.google.re2j.Pattern {{ constantName $ctx "Pattern" }} = com.google.re2j.Pattern.compile({{ javaStringEscape $r.GetPattern }});
+	{{ constant (constantName $ctx "Pattern") "com.google.re2j.Pattern" (printf "com.google.re2j.Pattern.compile(%s)" (stringLit $r.GetPattern)) }}
 {{- end -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}
 `
 
-const stringTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const stringTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
+{{- if $r.GetRequired }}
+		if (!{{ hasAccessor $ctx }}) {{ check $ctx "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null){{ endCheck }}
+{{- end -}}
+{{- if and (optional $ctx) (constrained $r) }}
+		if ({{ hasAccessor $ctx }}) {
+{{- end -}}
 {{- if $r.GetIgnoreEmpty }}
-		if ( !{{ accessor $ctx }}.isEmpty() ) {
+		if (!{{ accessor $ctx }}.isEmpty()) {
 {{- end -}}
 {{- if $r.Const }}
-			cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, "{{ $r.GetConst }}");
+			{{ check $ctx "const" }}cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ stringLit $r.GetConst }}){{ endCheck }}
 {{- end -}}
 {{- if $r.In }}
-			cn.spaceli.pgv.CollectiveValidation.in({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }});
+			{{ check $ctx "in" }}cn.spaceli.pgv.CollectiveValidation.{{ ident "in" }}({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "In" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.NotIn }}
-			cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }});
+			{{ check $ctx "not_in" }}cn.spaceli.pgv.CollectiveValidation.notIn({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "NotIn" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Len }}
-			cn.spaceli.pgv.StringValidation.length({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetLen }});
+			{{ check $ctx "len" }}cn.spaceli.pgv.StringValidation.length({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetLen }}){{ endCheck }}
 {{- end -}}
 {{- if $r.MinLen }}
-			cn.spaceli.pgv.StringValidation.minLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinLen }});
+			{{ check $ctx "min_len" }}cn.spaceli.pgv.StringValidation.minLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinLen }}){{ endCheck }}
 {{- end -}}
 {{- if $r.MaxLen }}
-			cn.spaceli.pgv.StringValidation.maxLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxLen }});
+			{{ check $ctx "max_len" }}cn.spaceli.pgv.StringValidation.maxLength({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxLen }}){{ endCheck }}
 {{- end -}}
 {{- if $r.LenBytes }}
-			cn.spaceli.pgv.StringValidation.lenBytes({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetLenBytes }});
+			{{ check $ctx "len_bytes" }}cn.spaceli.pgv.StringValidation.lenBytes({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetLenBytes }}){{ endCheck }}
 {{- end -}}
 {{- if $r.MinBytes }}
-			cn.spaceli.pgv.StringValidation.minBytes({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinBytes }});
+			{{ check $ctx "min_bytes" }}cn.spaceli.pgv.StringValidation.minBytes({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMinBytes }}){{ endCheck }}
 {{- end -}}
 {{- if $r.MaxBytes }}
-			cn.spaceli.pgv.StringValidation.maxBytes({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxBytes }});
+			{{ check $ctx "max_bytes" }}cn.spaceli.pgv.StringValidation.maxBytes({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ $r.GetMaxBytes }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Pattern }}
-			cn.spaceli.pgv.StringValidation.pattern({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Pattern" }});
+			{{ check $ctx "pattern" }}cn.spaceli.pgv.StringValidation.pattern({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Pattern" }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Prefix }}
-			cn.spaceli.pgv.StringValidation.prefix({{ errorName $ctx $index }}, {{ accessor $ctx }}, "{{ $r.GetPrefix }}");
+			{{ check $ctx "prefix" }}cn.spaceli.pgv.StringValidation.prefix({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ stringLit $r.GetPrefix }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Contains }}
-			cn.spaceli.pgv.StringValidation.contains({{ errorName $ctx $index }}, {{ accessor $ctx }}, "{{ $r.GetContains }}");
+			{{ check $ctx "contains" }}cn.spaceli.pgv.StringValidation.contains({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ stringLit $r.GetContains }}){{ endCheck }}
 {{- end -}}
 {{- if $r.NotContains }}
-			cn.spaceli.pgv.StringValidation.notContains({{ errorName $ctx $index }}, {{ accessor $ctx }}, "{{ $r.GetNotContains }}");
+			{{ check $ctx "not_contains" }}cn.spaceli.pgv.StringValidation.notContains({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ stringLit $r.GetNotContains }}){{ endCheck }}
 {{- end -}}
 {{- if $r.Suffix }}
-			cn.spaceli.pgv.StringValidation.suffix({{ errorName $ctx $index }}, {{ accessor $ctx }}, "{{ $r.GetSuffix }}");
+			{{ check $ctx "suffix" }}cn.spaceli.pgv.StringValidation.suffix({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ stringLit $r.GetSuffix }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetEmail }}
-			cn.spaceli.pgv.StringValidation.email({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "email" }}cn.spaceli.pgv.StringValidation.email({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetAddress }}
-			cn.spaceli.pgv.StringValidation.address({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "address" }}cn.spaceli.pgv.StringValidation.address({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetHostname }}
-			cn.spaceli.pgv.StringValidation.hostName({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "hostname" }}cn.spaceli.pgv.StringValidation.hostName({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIp }}
-			cn.spaceli.pgv.StringValidation.ip({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "ip" }}cn.spaceli.pgv.StringValidation.ip({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIpv4 }}
-			cn.spaceli.pgv.StringValidation.ipv4({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "ipv4" }}cn.spaceli.pgv.StringValidation.ipv4({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIpv6 }}
-			cn.spaceli.pgv.StringValidation.ipv6({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "ipv6" }}cn.spaceli.pgv.StringValidation.ipv6({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetUri }}
-			cn.spaceli.pgv.StringValidation.uri({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "uri" }}cn.spaceli.pgv.StringValidation.uri({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetUriRef }}
-			cn.spaceli.pgv.StringValidation.uriRef({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "uri_ref" }}cn.spaceli.pgv.StringValidation.uriRef({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetUuid }}
-			cn.spaceli.pgv.StringValidation.uuid({{ errorName $ctx $index }}, {{ accessor $ctx }});
+			{{ check $ctx "uuid" }}cn.spaceli.pgv.StringValidation.uuid({{ errorName $ctx $index }}, {{ accessor $ctx }}){{ endCheck }}
 {{- end -}}
 {{- if $r.GetIgnoreEmpty }}
 		}
 {{- end -}}
+{{- if and (optional $ctx) (constrained $r) }}
+		}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
+{{- end -}}
 {{- end -}}
 `
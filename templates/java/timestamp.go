@@ -1,65 +1,73 @@
 package java
 
-const timestampConstTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const timestampConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
 {{- if $r.Const }}
-		private final com.google.protobuf.Timestamp {{ constantName $ctx "Const" }} = {{ tsLit $r.GetConst }};
+	{{ constant (constantName $ctx "Const") "com.google.protobuf.Timestamp" (lit $r.GetConst) }}
 {{- end -}}
 {{- if $r.Lt }}
-		private final com.google.protobuf.Timestamp {{ constantName $ctx "Lt" }} = {{ tsLit $r.GetLt }};
+	{{ constant (constantName $ctx "Lt") "com.google.protobuf.Timestamp" (lit $r.GetLt) }}
 {{- end -}}
 {{- if $r.Lte }}
-		private final com.google.protobuf.Timestamp {{ constantName $ctx "Lte" }} = {{ tsLit $r.Lte }};
+	{{ constant (constantName $ctx "Lte") "com.google.protobuf.Timestamp" (lit $r.GetLte) }}
 {{- end -}}
 {{- if $r.Gt }}
-		private final com.google.protobuf.Timestamp {{ constantName $ctx "Gt" }} = {{ tsLit $r.GetGt }};
+	{{ constant (constantName $ctx "Gt") "com.google.protobuf.Timestamp" (lit $r.GetGt) }}
 {{- end -}}
 {{- if $r.Gte }}
-		private final com.google.protobuf.Timestamp {{ constantName $ctx "Gte" }} = {{ tsLit $r.GetGte }};
+	{{ constant (constantName $ctx "Gte") "com.google.protobuf.Timestamp" (lit $r.GetGte) }}
 {{- end -}}
 {{- if $r.Within }}
-		private final com.google.protobuf.Duration {{ constantName $ctx "Within" }} = {{ durLit $r.GetWithin }};
+	{{ constant (constantName $ctx "Within") "com.google.protobuf.Duration" (lit $r.GetWithin) }}
 {{- end -}}
 {{- if and $r.Error $ctx.DefineErr }}
-	private final RuntimeException {{ errorName $ctx $index }} = {{ error $ctx $r.GetError }};
+	{{ constant (errorName $ctx $index) errorType (error $ctx $r.GetError) }}
 {{- end -}}
 {{- end -}}
 `
 
-const timestampTpl = `{{ $ctx := . }}{{ $f := .Field }}{{ range $index, $r := .Rules.Rules -}}
+const timestampTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if groups $r }}
+		if (violations.inGroups({{ groups $r }})) {
+{{- end -}}
+{{- if $r.GetWhen }}
+		if ({{ when $ctx $r.GetWhen }}) {
+{{- end -}}
 {{- if $r.GetRequired }}
-		if ({{ hasAccessor $ctx }}) {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, {{ accessor $ctx }});
-		} else {
-			cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null);
-		};
+		if (!{{ hasAccessor $ctx }}) {{ check $ctx "required" }}cn.spaceli.pgv.RequiredValidation.required({{ errorName $ctx $index }}, null){{ endCheck }}
 {{- end -}}
 {{- if $r.Const }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }});
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "const" }}cn.spaceli.pgv.ConstantValidation.constant({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Const" }}){{ endCheck }}
 {{- end -}}
 {{- if and (or $r.Lt $r.Lte) (or $r.Gt $r.Gte)}}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.range({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ if $r.Lt }}{{ constantName $ctx "Lt" }}{{ else }}null{{ end }}, {{ if $r.Lte }}{{ constantName $ctx "Lte" }}{{ else }}null{{ end }}, {{ if $r.Gt }}{{ constantName $ctx "Gt" }}{{ else }}null{{ end }}, {{ if $r.Gte }}{{ constantName $ctx "Gte" }}{{ else }}null{{ end }}, com.google.protobuf.util.Timestamps.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "range" }}cn.spaceli.pgv.ComparativeValidation.range({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ if $r.Lt }}{{ constantName $ctx "Lt" }}{{ else }}null{{ end }}, {{ if $r.Lte }}{{ constantName $ctx "Lte" }}{{ else }}null{{ end }}, {{ if $r.Gt }}{{ constantName $ctx "Gt" }}{{ else }}null{{ end }}, {{ if $r.Gte }}{{ constantName $ctx "Gte" }}{{ else }}null{{ end }}, com.google.protobuf.util.Timestamps.comparator()){{ endCheck }}
 {{- else -}}
 {{- if $r.Lt }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lt" }}, com.google.protobuf.util.Timestamps.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "lt" }}cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lt" }}, com.google.protobuf.util.Timestamps.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.Lte }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.lessThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lte" }}, com.google.protobuf.util.Timestamps.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "lte" }}cn.spaceli.pgv.ComparativeValidation.lessThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Lte" }}, com.google.protobuf.util.Timestamps.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.Gt }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gt" }}, com.google.protobuf.util.Timestamps.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "gt" }}cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gt" }}, com.google.protobuf.util.Timestamps.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.Gte }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.greaterThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gte" }}, com.google.protobuf.util.Timestamps.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "gte" }}cn.spaceli.pgv.ComparativeValidation.greaterThanOrEqual({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Gte" }}, com.google.protobuf.util.Timestamps.comparator()){{ endCheck }}
 {{- end -}}
 {{- end -}}
 {{- if $r.LtNow }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, cn.spaceli.pgv.TimestampValidation.currentTimestamp(), com.google.protobuf.util.Timestamps.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "lt_now" }}cn.spaceli.pgv.ComparativeValidation.lessThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, cn.spaceli.pgv.TimestampValidation.currentTimestamp(), com.google.protobuf.util.Timestamps.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.GtNow }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, cn.spaceli.pgv.TimestampValidation.currentTimestamp(), com.google.protobuf.util.Timestamps.comparator());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "gt_now" }}cn.spaceli.pgv.ComparativeValidation.greaterThan({{ errorName $ctx $index }}, {{ accessor $ctx }}, cn.spaceli.pgv.TimestampValidation.currentTimestamp(), com.google.protobuf.util.Timestamps.comparator()){{ endCheck }}
 {{- end -}}
 {{- if $r.Within }}
-			if ({{ hasAccessor $ctx }}) cn.spaceli.pgv.TimestampValidation.within({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Within" }}, cn.spaceli.pgv.TimestampValidation.currentTimestamp());
+		if ({{ hasAccessor $ctx }}) {{ check $ctx "within" }}cn.spaceli.pgv.TimestampValidation.within({{ errorName $ctx $index }}, {{ accessor $ctx }}, {{ constantName $ctx "Within" }}, cn.spaceli.pgv.TimestampValidation.currentTimestamp()){{ endCheck }}
+{{- end -}}
+{{- if $r.GetWhen }}
+		}
+{{- end -}}
+{{- if groups $r }}
+		}
 {{- end -}}
 {{- end -}}
 `
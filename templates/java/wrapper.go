@@ -1,12 +1,16 @@
 package java
 
-const wrapperConstTpl = `{{ $f := .Field }}{{ $r := .Rules }}			
-			{{- renderConstants (unwrap .) }}`
+const wrapperConstTpl = `{{ renderConstants (unwrap .) }}
+{{- if and .MessageRules.GetRequired .MessageRules.GetError }}
+	{{ constant (requiredErrorName .) errorType (error . .MessageRules.GetError) }}
+{{- end -}}
+`
 
-const wrapperTpl = `{{ $f := .Field }}{{ $r := .Rules }}			
-			if ({{ hasAccessor . }}) {
-				{{- render (unwrap .) }}
-			}
-			{{ if .MessageRules.GetRequired }} else {
-				throw new cn.spaceli.pgv.ValidationException("{{ $f }}", "null", "is required");
-			} {{ end }}`
+const wrapperTpl = `
+		if ({{ hasAccessor . }}) {
+			{{ render (unwrap .) }}
+		}
+		{{- if and .MessageRules.GetRequired .MessageRules.GetError }} else {
+			{{ checkCall (fieldPath .) "message.required" "null" }}cn.spaceli.pgv.RequiredValidation.required({{ requiredErrorName . }}, null){{ endCheck }}
+		}
+		{{- end }}`
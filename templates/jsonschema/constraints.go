@@ -0,0 +1,611 @@
+package jsonschema
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strconv"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+const (
+	// errorsKeyword maps the keywords of a schema to the errors of the rules
+	// they come from, required to the error of the rule requiring the field.
+	errorsKeyword = "x-validate-errors"
+	// errorKeyword is the error of the rule a subschema stands for.
+	errorKeyword = "x-validate-error"
+)
+
+// The patterns of the well-known regexes, strict and loose.
+var (
+	headerNamePattern       = `^:?[0-9a-zA-Z!#$%&'*+-.^_|~` + "`" + `]+$`
+	headerValuePattern      = `^[^\x00-\x08\x0A-\x1F\x7F]*$`
+	looseHeaderValuePattern = `^[^\x00\x0A\x0D]*$`
+)
+
+// keyword is a keyword of a schema, with its value.
+type keyword struct {
+	name  string
+	value interface{}
+}
+
+// constrained is the schema of a field, or of its items, keys or values,
+// being intersected with the rules of the field. Rules without an equivalent
+// keyword, conditional rules and rules of validation groups are left out.
+type constrained struct {
+	b      *builder
+	schema *object
+	field  string
+	base   *validate.ErrorBase
+	errors *object
+	// the constraints that cannot be merged into the keywords of schema
+	allOf []interface{}
+	// the constrained items, keys and values
+	nested map[string]*constrained
+}
+
+func (b *builder) constrain(schema *object, field string, base *validate.ErrorBase) *constrained {
+	return &constrained{b: b, schema: schema, field: field, base: base, errors: newObject(), nested: map[string]*constrained{}}
+}
+
+// sub returns the constrained subschema of c at key, adding it if needed.
+func (c *constrained) sub(key string) *constrained {
+	if n, ok := c.nested[key]; ok {
+		return n
+	}
+	s, ok := c.schema.values[key].(*object)
+	if !ok {
+		s = newObject()
+		c.schema.set(key, s)
+	}
+	n := c.b.constrain(s, c.field, c.base)
+	c.nested[key] = n
+	return n
+}
+
+// finish adds the constraints that are not keywords of the schemas of c.
+func (c *constrained) finish() {
+	for _, n := range c.nested {
+		n.finish()
+	}
+	if len(c.allOf) > 0 {
+		c.schema.set("allOf", c.allOf)
+	}
+	if c.errors.len() > 0 {
+		c.schema.set(errorsKeyword, c.errors)
+	}
+}
+
+// apply intersects c with rules, the rules of values of type t. Rules without
+// an error of their own fail with inherited. It returns whether a rule requires
+// the field.
+func (c *constrained) apply(t fieldType, rules *validate.FieldRules, inherited *errorFactory) (required bool, err error) {
+	if mr := rules.GetMessage(); mr.GetRequired() && !conditional(mr.ProtoReflect()) {
+		e, err := c.ruleError(mr.ProtoReflect(), inherited)
+		if err != nil {
+			return false, err
+		}
+		required = true
+		c.setError("required", e)
+	}
+
+	rr := rules.ProtoReflect()
+	typ := rr.WhichOneof(rr.Descriptor().Oneofs().ByName("type"))
+	if typ == nil {
+		return required, nil
+	}
+	kind := string(typ.Name())
+
+	// Most rule types hold a list of rules, bool and enum rules are a single one.
+	typed := rr.Get(typ).Message()
+	var list []protoreflect.Message
+	if fd := typed.Descriptor().Fields().ByName("rules"); fd != nil && fd.IsList() {
+		for i := 0; i < typed.Get(fd).List().Len(); i++ {
+			list = append(list, typed.Get(fd).List().Get(i).Message())
+		}
+	} else {
+		list = append(list, typed)
+	}
+
+	for _, r := range list {
+		if conditional(r) {
+			continue
+		}
+		e, err := c.ruleError(r, inherited)
+		if err != nil {
+			return false, err
+		}
+		if fd := r.Descriptor().Fields().ByName("required"); fd != nil && r.Get(fd).Bool() {
+			required = true
+			c.setError("required", e)
+		}
+		if err = c.applyNested(t, r.Interface(), e); err != nil {
+			return false, err
+		}
+
+		kws := keywords(kind, t, r)
+		if len(kws) == 0 {
+			continue
+		}
+		if fd := r.Descriptor().Fields().ByName("ignore_empty"); fd != nil && r.Get(fd).Bool() {
+			s := newObject().set("anyOf", []interface{}{emptySchema(kind), fragment(kws)})
+			if e != nil {
+				s.set(errorKeyword, e)
+			}
+			c.allOf = append(c.allOf, s)
+			continue
+		}
+		c.merge(kws, e)
+	}
+	return required, nil
+}
+
+// applyNested intersects the schemas of the items, keys and values of c with
+// the nested rules of r.
+func (c *constrained) applyNested(t fieldType, r interface{}, e *errorFactory) error {
+	var err error
+	switch r := r.(type) {
+	case *validate.RepeatedRule:
+		if ft, ok := t.(interface{ Element() pgs.FieldTypeElem }); ok && r.GetItems() != nil {
+			_, err = c.sub("items").apply(ft.Element(), r.GetItems(), e)
+		}
+	case *validate.MapRule:
+		ft, ok := t.(interface {
+			Key() pgs.FieldTypeElem
+			Element() pgs.FieldTypeElem
+		})
+		if !ok {
+			return nil
+		}
+		// keys are strings in JSON, only string rules apply to them
+		if r.GetKeys() != nil && ft.Key().ProtoType() == pgs.StringT {
+			if _, err = c.sub("propertyNames").apply(ft.Key(), r.GetKeys(), e); err != nil {
+				return err
+			}
+		}
+		if r.GetValues() != nil {
+			_, err = c.sub("additionalProperties").apply(ft.Element(), r.GetValues(), e)
+		}
+	}
+	return err
+}
+
+// merge intersects the schema of c with kws, the keywords of a rule failing
+// with e. Keywords the schema has already are kept if they are tighter, and
+// left to allOf if they cannot be compared.
+func (c *constrained) merge(kws []keyword, e *errorFactory) {
+	for _, kw := range kws {
+		prev, ok := c.schema.get(kw.name)
+		if !ok {
+			c.schema.set(kw.name, kw.value)
+			c.setError(kw.name, e)
+			continue
+		}
+
+		v, replace, ok := intersect(kw.name, prev, kw.value)
+		if !ok {
+			s := newObject().set(kw.name, kw.value)
+			if e != nil {
+				s.set(errorKeyword, e)
+			}
+			c.allOf = append(c.allOf, s)
+			continue
+		}
+		if replace {
+			c.schema.set(kw.name, v)
+			c.setError(kw.name, e)
+		}
+	}
+}
+
+func (c *constrained) setError(keyword string, e *errorFactory) {
+	if e == nil {
+		c.errors.delete(keyword)
+		return
+	}
+	c.errors.set(keyword, e)
+}
+
+func (c *constrained) ruleError(r protoreflect.Message, inherited *errorFactory) (*errorFactory, error) {
+	fd := r.Descriptor().Fields().ByName("error")
+	if fd == nil || !r.Has(fd) {
+		return inherited, nil
+	}
+	return buildError(c.field, c.base, r, r.Get(fd).Message().Interface().(*validate.Error))
+}
+
+// conditional reports whether r only applies when its condition holds or in
+// its validation groups.
+func conditional(r protoreflect.Message) bool {
+	if fd := r.Descriptor().Fields().ByName("when"); fd != nil && r.Has(fd) {
+		return true
+	}
+	return len(shared.RuleGroups(r.Interface())) > 0
+}
+
+// intersect returns the intersection of the values a and b of the keyword
+// name, and whether it replaces a, or false if a schema cannot hold both.
+func intersect(name string, a, b interface{}) (v interface{}, replace, ok bool) {
+	switch name {
+	case "minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties":
+		if number(b) > number(a) {
+			return b, true, true
+		}
+		return a, false, true
+	case "maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties":
+		if number(b) < number(a) {
+			return b, true, true
+		}
+		return a, false, true
+	case "enum":
+		var both []interface{}
+		for _, x := range values(a) {
+			for _, y := range values(b) {
+				if equal(x, y) {
+					both = append(both, x)
+					break
+				}
+			}
+		}
+		if both == nil {
+			both = []interface{}{}
+		}
+		return both, true, true
+	}
+	if equal(a, b) {
+		return a, false, true
+	}
+	return nil, false, false
+}
+
+func number(v interface{}) float64 {
+	n, _ := v.(json.Number).Float64()
+	return n
+}
+
+func values(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case []string:
+		out := make([]interface{}, len(v))
+		for i, s := range v {
+			out[i] = s
+		}
+		return out
+	case []json.Number:
+		out := make([]interface{}, len(v))
+		for i, n := range v {
+			out[i] = n
+		}
+		return out
+	}
+	return nil
+}
+
+// fragment returns the schema of kws, a keyword repeated in kws being added
+// to its allOf.
+func fragment(kws []keyword) *object {
+	s := newObject()
+	var allOf []interface{}
+	for _, kw := range kws {
+		if _, ok := s.get(kw.name); ok {
+			allOf = append(allOf, newObject().set(kw.name, kw.value))
+			continue
+		}
+		s.set(kw.name, kw.value)
+	}
+	if len(allOf) > 0 {
+		s.set("allOf", allOf)
+	}
+	return s
+}
+
+// emptySchema returns the schema of the empty values the rules of kind ignore
+// with ignore_empty.
+func emptySchema(kind string) *object {
+	switch kind {
+	case "repeated":
+		return newObject().set("maxItems", json.Number("0"))
+	case "map":
+		return newObject().set("maxProperties", json.Number("0"))
+	case "string", "bytes":
+		return newObject().set("const", "")
+	default:
+		return newObject().set("const", json.Number("0"))
+	}
+}
+
+// keywords returns the keywords of r, a rule of kind for values of type t.
+func keywords(kind string, t fieldType, r protoreflect.Message) []keyword {
+	switch r := r.Interface().(type) {
+	case *validate.StringRule:
+		return stringKeywords(r)
+	case *validate.BytesRule:
+		return bytesKeywords(r)
+	case *validate.BoolRules:
+		if r.Const != nil {
+			return []keyword{{"const", r.GetConst()}}
+		}
+		return nil
+	case *validate.EnumRules:
+		return enumKeywords(t, r)
+	case *validate.RepeatedRule:
+		var kws []keyword
+		if r.MinItems != nil {
+			kws = append(kws, keyword{"minItems", uintNumber(r.GetMinItems())})
+		}
+		if r.MaxItems != nil {
+			kws = append(kws, keyword{"maxItems", uintNumber(r.GetMaxItems())})
+		}
+		if r.GetUnique() {
+			kws = append(kws, keyword{"uniqueItems", true})
+		}
+		return kws
+	case *validate.MapRule:
+		var kws []keyword
+		if r.MinPairs != nil {
+			kws = append(kws, keyword{"minProperties", uintNumber(r.GetMinPairs())})
+		}
+		if r.MaxPairs != nil {
+			kws = append(kws, keyword{"maxProperties", uintNumber(r.GetMaxPairs())})
+		}
+		return kws
+	case *validate.AnyRule:
+		return anyKeywords(r)
+	case *validate.DurationRule, *validate.TimestampRule:
+		// durations and timestamps are strings, their bounds are not keywords
+		return nil
+	}
+	return numberKeywords(r)
+}
+
+func stringKeywords(r *validate.StringRule) []keyword {
+	var kws []keyword
+	if r.Const != nil {
+		kws = append(kws, keyword{"const", r.GetConst()})
+	}
+	if r.Len != nil {
+		kws = append(kws, keyword{"minLength", uintNumber(r.GetLen())}, keyword{"maxLength", uintNumber(r.GetLen())})
+	}
+	if r.MinLen != nil {
+		kws = append(kws, keyword{"minLength", uintNumber(r.GetMinLen())})
+	}
+	if r.MaxLen != nil {
+		kws = append(kws, keyword{"maxLength", uintNumber(r.GetMaxLen())})
+	}
+	if r.Pattern != nil {
+		kws = append(kws, keyword{"pattern", r.GetPattern()})
+	}
+	if r.Prefix != nil {
+		kws = append(kws, keyword{"pattern", "^" + regexp.QuoteMeta(r.GetPrefix())})
+	}
+	if r.Suffix != nil {
+		kws = append(kws, keyword{"pattern", regexp.QuoteMeta(r.GetSuffix()) + "$"})
+	}
+	if r.Contains != nil {
+		kws = append(kws, keyword{"pattern", regexp.QuoteMeta(r.GetContains())})
+	}
+	if r.NotContains != nil {
+		kws = append(kws, keyword{"not", newObject().set("pattern", regexp.QuoteMeta(r.GetNotContains()))})
+	}
+	if len(r.In) > 0 {
+		kws = append(kws, keyword{"enum", r.GetIn()})
+	}
+	if len(r.NotIn) > 0 {
+		kws = append(kws, keyword{"not", newObject().set("enum", r.GetNotIn())})
+	}
+
+	format := func(formats ...string) {
+		if len(formats) == 1 {
+			kws = append(kws, keyword{"format", formats[0]})
+			return
+		}
+		var anyOf []interface{}
+		for _, f := range formats {
+			anyOf = append(anyOf, newObject().set("format", f))
+		}
+		kws = append(kws, keyword{"anyOf", anyOf})
+	}
+	switch {
+	case r.GetEmail():
+		format("email")
+	case r.GetHostname():
+		format("hostname")
+	case r.GetIp():
+		format("ipv4", "ipv6")
+	case r.GetIpv4():
+		format("ipv4")
+	case r.GetIpv6():
+		format("ipv6")
+	case r.GetUri():
+		format("uri")
+	case r.GetUriRef():
+		format("uri-reference")
+	case r.GetAddress():
+		format("hostname", "ipv4", "ipv6")
+	case r.GetUuid():
+		format("uuid")
+	case r.GetWellKnownRegex() == validate.KnownRegex_HTTP_HEADER_NAME && r.GetStrict():
+		kws = append(kws, keyword{"pattern", headerNamePattern})
+	case r.GetWellKnownRegex() == validate.KnownRegex_HTTP_HEADER_VALUE && r.GetStrict():
+		kws = append(kws, keyword{"pattern", headerValuePattern})
+	case r.GetWellKnownRegex() != validate.KnownRegex_UNKNOWN:
+		kws = append(kws, keyword{"pattern", looseHeaderValuePattern})
+	}
+	return kws
+}
+
+// bytesKeywords returns the keywords of r on the base64 encoding of bytes,
+// only the ones on whole values.
+func bytesKeywords(r *validate.BytesRule) []keyword {
+	encode := func(values [][]byte) []string {
+		out := make([]string, len(values))
+		for i, v := range values {
+			out[i] = base64.StdEncoding.EncodeToString(v)
+		}
+		return out
+	}
+
+	// n bytes encode to 4*ceil(n/3) characters, so the lengths bound the
+	// encoded length without being exact.
+	encodedLen := func(n uint64) uint64 {
+		return (n + 2) / 3 * 4
+	}
+
+	var kws []keyword
+	if r.Const != nil {
+		kws = append(kws, keyword{"const", base64.StdEncoding.EncodeToString(r.GetConst())})
+	}
+	if r.Len != nil {
+		kws = append(kws, keyword{"minLength", uintNumber(encodedLen(r.GetLen()))}, keyword{"maxLength", uintNumber(encodedLen(r.GetLen()))})
+	}
+	if r.MinLen != nil {
+		kws = append(kws, keyword{"minLength", uintNumber(encodedLen(r.GetMinLen()))})
+	}
+	if r.MaxLen != nil {
+		kws = append(kws, keyword{"maxLength", uintNumber(encodedLen(r.GetMaxLen()))})
+	}
+	if len(r.In) > 0 {
+		kws = append(kws, keyword{"enum", encode(r.GetIn())})
+	}
+	if len(r.NotIn) > 0 {
+		kws = append(kws, keyword{"not", newObject().set("enum", encode(r.GetNotIn()))})
+	}
+	return kws
+}
+
+// enumKeywords returns the keywords of r on the names of the values of t.
+func enumKeywords(t fieldType, r *validate.EnumRules) []keyword {
+	if !t.IsEnum() {
+		return nil
+	}
+	names := func(numbers ...int32) []string {
+		out := []string{}
+		for _, n := range numbers {
+			for _, v := range t.Enum().Values() {
+				if v.Value() == n {
+					out = append(out, v.Name().String())
+				}
+			}
+		}
+		return out
+	}
+
+	var kws []keyword
+	if r.Const != nil {
+		kws = append(kws, keyword{"enum", names(r.GetConst())})
+	}
+	if len(r.In) > 0 {
+		kws = append(kws, keyword{"enum", names(r.GetIn()...)})
+	}
+	if len(r.NotIn) > 0 {
+		kws = append(kws, keyword{"not", newObject().set("enum", names(r.GetNotIn()...))})
+	}
+	return kws
+}
+
+// anyKeywords returns the keywords of r on the type URL of an Any.
+func anyKeywords(r *validate.AnyRule) []keyword {
+	var kws []keyword
+	if len(r.In) > 0 {
+		kws = append(kws, keyword{"properties", newObject().set("@type", newObject().set("enum", r.GetIn()))})
+	}
+	if len(r.NotIn) > 0 {
+		kws = append(kws, keyword{"not", newObject().
+			set("properties", newObject().set("@type", newObject().set("enum", r.GetNotIn()))).
+			set("required", []string{"@type"})})
+	}
+	return kws
+}
+
+// numberKeywords returns the keywords of r, a rule of one of the numeric
+// types, which all have the same fields.
+func numberKeywords(r protoreflect.Message) []keyword {
+	fields := r.Descriptor().Fields()
+	get := func(name protoreflect.Name) (json.Number, bool) {
+		fd := fields.ByName(name)
+		if fd == nil || !r.Has(fd) {
+			return "", false
+		}
+		return numberOf(r.Get(fd)), true
+	}
+	list := func(name protoreflect.Name) []json.Number {
+		fd := fields.ByName(name)
+		if fd == nil || !fd.IsList() {
+			return nil
+		}
+		var out []json.Number
+		for i := 0; i < r.Get(fd).List().Len(); i++ {
+			out = append(out, numberOf(r.Get(fd).List().Get(i)))
+		}
+		return out
+	}
+
+	var kws []keyword
+	if v, ok := get("const"); ok {
+		kws = append(kws, keyword{"const", v})
+	}
+
+	var lower, upper *keyword
+	if v, ok := get("gt"); ok {
+		lower = &keyword{"exclusiveMinimum", v}
+	} else if v, ok := get("gte"); ok {
+		lower = &keyword{"minimum", v}
+	}
+	if v, ok := get("lt"); ok {
+		upper = &keyword{"exclusiveMaximum", v}
+	} else if v, ok := get("lte"); ok {
+		upper = &keyword{"maximum", v}
+	}
+	switch {
+	case lower != nil && upper != nil && number(lower.value) > number(upper.value):
+		// an exclusive range, the value is outside of [upper, lower]
+		kws = append(kws, keyword{"anyOf", []interface{}{
+			newObject().set(upper.name, upper.value),
+			newObject().set(lower.name, lower.value),
+		}})
+	default:
+		if lower != nil {
+			kws = append(kws, *lower)
+		}
+		if upper != nil {
+			kws = append(kws, *upper)
+		}
+	}
+
+	if in := list("in"); len(in) > 0 {
+		kws = append(kws, keyword{"enum", in})
+	}
+	if notIn := list("not_in"); len(notIn) > 0 {
+		kws = append(kws, keyword{"not", newObject().set("enum", notIn)})
+	}
+	return kws
+}
+
+func numberOf(v protoreflect.Value) json.Number {
+	switch n := v.Interface().(type) {
+	case int32:
+		return json.Number(strconv.FormatInt(int64(n), 10))
+	case int64:
+		return json.Number(strconv.FormatInt(n, 10))
+	case uint32:
+		return uintNumber(uint64(n))
+	case uint64:
+		return uintNumber(n)
+	case float32:
+		return json.Number(strconv.FormatFloat(float64(n), 'g', -1, 32))
+	case float64:
+		return json.Number(strconv.FormatFloat(n, 'g', -1, 64))
+	}
+	return "0"
+}
+
+func uintNumber(n uint64) json.Number {
+	return json.Number(strconv.FormatUint(n, 10))
+}
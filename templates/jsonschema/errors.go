@@ -0,0 +1,58 @@
+package jsonschema
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+// errorFactory is an Error after ErrorBase inheritance, with its args resolved
+// against the declaring rule.
+type errorFactory struct {
+	Pkg    string        `json:"pkg,omitempty"`
+	Class  string        `json:"class,omitempty"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+func buildError(field string, base *validate.ErrorBase, r protoreflect.Message, e *validate.Error) (*errorFactory, error) {
+	out := &errorFactory{
+		Pkg:    base.GetPkg(),
+		Class:  base.GetClass(),
+		Method: e.GetMethod(),
+		Params: []interface{}{},
+	}
+	if e.GetPkg() != "" {
+		out.Pkg = e.GetPkg()
+	}
+	if e.GetClass() != "" {
+		out.Class = e.GetClass()
+	}
+
+	args, err := shared.ErrorArgs(field, r, e)
+	if err != nil {
+		return nil, err
+	}
+	for _, arg := range args {
+		var v interface{}
+		switch arg.Kind {
+		case shared.IntArg:
+			v = arg.Int
+		case shared.UintArg:
+			v = arg.Uint
+		case shared.FloatArg:
+			v = arg.Float
+		case shared.BoolArg:
+			v = arg.Bool
+		case shared.RefArg:
+			v = map[string]string{"ref": arg.Str}
+		case shared.ValueArg:
+			v = map[string]string{"placeholder": "$value"}
+		default:
+			v = arg.Str
+		}
+		out.Params = append(out.Params, v)
+	}
+	return out, nil
+}
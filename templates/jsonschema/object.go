@@ -0,0 +1,92 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// object is a JSON object rendering its members in the order they are set, so
+// that schemas list their keywords, and properties, the way they are built.
+type object struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newObject() *object {
+	return &object{values: map[string]interface{}{}}
+}
+
+func (o *object) set(key string, v interface{}) *object {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = v
+	return o
+}
+
+func (o *object) get(key string) (interface{}, bool) {
+	v, ok := o.values[key]
+	return v, ok
+}
+
+func (o *object) delete(key string) {
+	if _, ok := o.values[key]; !ok {
+		return
+	}
+	delete(o.values, key)
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+func (o *object) len() int {
+	return len(o.keys)
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := marshal(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshal renders v as compact JSON without escaping HTML, which patterns are
+// full of.
+func marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// equal reports whether a and b render the same JSON.
+func equal(a, b interface{}) bool {
+	ja, err := marshal(a)
+	if err != nil {
+		return false
+	}
+	jb, err := marshal(b)
+	return err == nil && bytes.Equal(ja, jb)
+}
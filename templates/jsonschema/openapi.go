@@ -0,0 +1,90 @@
+package jsonschema
+
+// bounds are the inclusive and exclusive bound keywords, with whether the
+// exclusive bound is the tighter one.
+var bounds = []struct {
+	inclusive, exclusive string
+	tighter              func(inclusive, exclusive float64) bool
+}{
+	{"minimum", "exclusiveMinimum", func(inclusive, exclusive float64) bool { return exclusive >= inclusive }},
+	{"maximum", "exclusiveMaximum", func(inclusive, exclusive float64) bool { return exclusive <= inclusive }},
+}
+
+// toOpenAPI rewrites the JSON Schema 2020-12 keywords of s, and of its
+// subschemas, that OpenAPI 3.0 schemas spell differently or lack: const is a
+// single value enum, exclusive bounds are flags of the inclusive ones and
+// propertyNames is left out. The errors of the keywords follow them.
+func toOpenAPI(s *object) {
+	for _, key := range s.keys {
+		switch v := s.values[key].(type) {
+		case *object:
+			switch key {
+			case "properties":
+				for _, name := range v.keys {
+					if p, ok := v.values[name].(*object); ok {
+						toOpenAPI(p)
+					}
+				}
+			case "items", "additionalProperties", "not":
+				toOpenAPI(v)
+			}
+		case []interface{}:
+			if key == "allOf" || key == "anyOf" {
+				for _, sub := range v {
+					if sub, ok := sub.(*object); ok {
+						toOpenAPI(sub)
+					}
+				}
+			}
+		}
+	}
+
+	// renamed maps the keywords to their OpenAPI names, the dropped ones to ""
+	renamed := map[string]string{"const": "enum", "propertyNames": ""}
+	for _, b := range bounds {
+		ex, ok := s.get(b.exclusive)
+		if !ok {
+			continue
+		}
+		if in, ok := s.get(b.inclusive); ok && !b.tighter(number(in), number(ex)) {
+			renamed[b.exclusive] = ""
+			continue
+		}
+		renamed[b.inclusive] = ""
+		renamed[b.exclusive] = b.inclusive
+	}
+
+	out := newObject()
+	for _, key := range s.keys {
+		v := s.values[key]
+		name, ok := renamed[key]
+		switch {
+		case !ok:
+			out.set(key, v)
+		case name == "":
+		case key == "const":
+			out.set(name, []interface{}{v})
+		default:
+			// the exclusive bound
+			out.set(name, v).set(key, true)
+		}
+	}
+	if errs, ok := out.get(errorsKeyword); ok {
+		out.set(errorsKeyword, renameKeys(errs.(*object), renamed))
+	}
+	*s = *out
+}
+
+func renameKeys(o *object, renamed map[string]string) *object {
+	out := newObject()
+	for _, key := range o.keys {
+		name, ok := renamed[key]
+		switch {
+		case !ok:
+			out.set(key, o.values[key])
+		case name != "":
+			out.set(name, o.values[key])
+		}
+	}
+	return out
+}
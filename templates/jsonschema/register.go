@@ -0,0 +1,87 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+)
+
+const (
+	// SchemaTemplate renders a JSON Schema 2020-12 document.
+	SchemaTemplate = "schema"
+	// OpenAPITemplate renders an OpenAPI 3.0 document with the schemas as
+	// components.
+	OpenAPITemplate = "openapi"
+)
+
+const fileTpl = `{{ document . }}
+`
+
+// Register registers the template rendering the JSON Schema of the messages of
+// a proto file.
+func Register(tpl *template.Template, params pgs.Parameters) {
+	register(tpl, false)
+}
+
+// RegisterOpenAPI registers the template rendering the OpenAPI schemas of the
+// messages of a proto file.
+func RegisterOpenAPI(tpl *template.Template, params pgs.Parameters) {
+	register(tpl, true)
+}
+
+func register(tpl *template.Template, openAPI bool) {
+	tpl.Funcs(map[string]interface{}{
+		"document": func(f pgs.File) (string, error) {
+			return documentJSON(f, openAPI)
+		},
+	})
+
+	template.Must(tpl.Parse(fileTpl))
+}
+
+// CodeFormat re-indents the generated document.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated schema failed, %w", err)
+	}
+	var buf bytes.Buffer
+	if err = json.Indent(&buf, src, "", "  "); err != nil {
+		return fmt.Errorf("indent generated schema failed, %w", err)
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// FilePath places the document next to the proto file it describes, as
+// <file>.validate.schema.json or <file>.validate.openapi.json. Every file gets
+// one, as the schemas of other files refer to its messages.
+func FilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	out := documentPath(f, tpl.Name())
+	return &out
+}
+
+func documentPath(f pgs.File, name string) pgs.FilePath {
+	return f.InputPath().SetExt(".validate." + name + ".json")
+}
+
+func documentJSON(f pgs.File, openAPI bool) (string, error) {
+	doc, err := buildDocument(f, openAPI)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(doc); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,253 @@
+package jsonschema
+
+import (
+	"path/filepath"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+// The schemas describe the proto3 JSON mapping of the messages, with the
+// properties named after the JSON names of the fields. 64-bit integers are
+// integers, as most OpenAPI tooling has them, although the mapping allows
+// strings.
+
+// builder builds the schemas of the messages of a proto file.
+type builder struct {
+	file    pgs.File
+	openAPI bool
+}
+
+// fieldType is the type of a field, or of its elements.
+type fieldType interface {
+	ProtoType() pgs.ProtoType
+	IsEmbed() bool
+	Embed() pgs.Message
+	IsEnum() bool
+	Enum() pgs.Enum
+}
+
+func buildDocument(f pgs.File, openAPI bool) (*object, error) {
+	b := builder{file: f, openAPI: openAPI}
+	schemas := newObject()
+	for _, msg := range f.AllMessages() {
+		s, err := b.message(msg)
+		if err != nil {
+			return nil, err
+		}
+		schemas.set(fullName(msg), s)
+	}
+
+	if !openAPI {
+		return newObject().
+			set("$schema", "https://json-schema.org/draft/2020-12/schema").
+			set("title", f.InputPath().String()).
+			set("$defs", schemas), nil
+	}
+
+	for _, name := range schemas.keys {
+		toOpenAPI(schemas.values[name].(*object))
+	}
+	return newObject().
+		set("openapi", "3.0.3").
+		set("info", newObject().set("title", f.InputPath().String()).set("version", f.Package().ProtoName().String())).
+		set("paths", newObject()).
+		set("components", newObject().set("schemas", schemas)), nil
+}
+
+func (b *builder) message(msg pgs.Message) (*object, error) {
+	out := newObject().set("type", "object")
+	disabled, err := shared.Disabled(msg)
+	if err != nil {
+		return nil, err
+	}
+	ignored, err := shared.Ignored(msg)
+	if err != nil {
+		return nil, err
+	}
+	withRules := !disabled && !ignored
+	var base *validate.ErrorBase
+	if _, err = msg.Extension(validate.E_ErrorBase, &base); err != nil {
+		return nil, err
+	}
+
+	props := newObject()
+	var required []string
+	for _, f := range msg.Fields() {
+		s, req, err := b.field(f, base, withRules)
+		if err != nil {
+			return nil, err
+		}
+		props.set(jsonName(f), s)
+		if req {
+			required = append(required, jsonName(f))
+		}
+	}
+	out.set("properties", props)
+	if len(required) > 0 {
+		out.set("required", required)
+	}
+	if !withRules {
+		return out, nil
+	}
+
+	// a required oneof has one of its fields set
+	var allOf []interface{}
+	for _, oo := range msg.RealOneOfs() {
+		rule, err := shared.OneOfRule(oo)
+		if err != nil {
+			return nil, err
+		}
+		if !rule.GetRequired() || len(shared.RuleGroups(rule)) > 0 {
+			continue
+		}
+		var anyOf []interface{}
+		for _, f := range oo.Fields() {
+			anyOf = append(anyOf, newObject().set("required", []string{jsonName(f)}))
+		}
+		s := newObject().set("anyOf", anyOf)
+		if rule.GetError() != nil {
+			e, err := buildError(oo.Name().String(), base, rule.ProtoReflect(), rule.GetError())
+			if err != nil {
+				return nil, err
+			}
+			s.set(errorKeyword, e)
+		}
+		allOf = append(allOf, s)
+	}
+	if len(allOf) > 0 {
+		out.set("allOf", allOf)
+	}
+	return out, nil
+}
+
+// field returns the schema of f, intersected with its rules if withRules, and
+// whether they require f.
+func (b *builder) field(f pgs.Field, base *validate.ErrorBase, withRules bool) (*object, bool, error) {
+	typ := f.Type()
+	var s *object
+	switch {
+	case typ.IsMap():
+		s = newObject().set("type", "object").set("additionalProperties", b.typeSchema(typ.Element()))
+	case typ.IsRepeated():
+		s = newObject().set("type", "array").set("items", b.typeSchema(typ.Element()))
+	default:
+		s = b.typeSchema(typ)
+	}
+	if !withRules {
+		return s, false, nil
+	}
+
+	var rules validate.FieldRules
+	if ok, err := f.Extension(validate.E_Rules, &rules); err != nil || !ok {
+		return s, false, err
+	}
+	c := b.constrain(s, f.Name().String(), base)
+	required, err := c.apply(typ, &rules, nil)
+	c.finish()
+	return s, required, err
+}
+
+func (b *builder) typeSchema(t fieldType) *object {
+	switch {
+	case t.IsEnum():
+		var names []string
+		for _, v := range t.Enum().Values() {
+			names = append(names, v.Name().String())
+		}
+		return newObject().set("type", "string").set("enum", names)
+	case t.IsEmbed():
+		return b.messageSchema(t.Embed())
+	default:
+		return scalarSchema(t.ProtoType())
+	}
+}
+
+func scalarSchema(typ pgs.ProtoType) *object {
+	s := newObject()
+	switch typ {
+	case pgs.DoubleT:
+		s.set("type", "number").set("format", "double")
+	case pgs.FloatT:
+		s.set("type", "number").set("format", "float")
+	case pgs.Int64T, pgs.SInt64, pgs.SFixed64:
+		s.set("type", "integer").set("format", "int64")
+	case pgs.UInt64T, pgs.Fixed64T:
+		s.set("type", "integer").set("format", "uint64")
+	case pgs.Int32T, pgs.SInt32, pgs.SFixed32:
+		s.set("type", "integer").set("format", "int32")
+	case pgs.UInt32T, pgs.Fixed32T:
+		s.set("type", "integer").set("format", "uint32")
+	case pgs.BoolT:
+		s.set("type", "boolean")
+	case pgs.StringT:
+		s.set("type", "string")
+	case pgs.BytesT:
+		s.set("type", "string").set("format", "byte")
+	}
+	return s
+}
+
+// messageSchema returns the schema of the well-known types, and a reference
+// to the schema of other messages.
+func (b *builder) messageSchema(msg pgs.Message) *object {
+	switch msg.WellKnownType() {
+	case pgs.AnyWKT:
+		return newObject().
+			set("type", "object").
+			set("properties", newObject().set("@type", newObject().set("type", "string"))).
+			set("required", []string{"@type"})
+	case pgs.DurationWKT:
+		return newObject().set("type", "string").set("pattern", `^-?[0-9]+(\.[0-9]{1,9})?s$`)
+	case pgs.TimestampWKT:
+		return newObject().set("type", "string").set("format", "date-time")
+	case pgs.EmptyWKT, pgs.StructWKT:
+		return newObject().set("type", "object")
+	case pgs.ListValueWKT:
+		return newObject().set("type", "array")
+	case pgs.ValueWKT:
+		return newObject()
+	case pgs.DoubleValueWKT, pgs.FloatValueWKT, pgs.Int64ValueWKT, pgs.UInt64ValueWKT, pgs.Int32ValueWKT,
+		pgs.UInt32ValueWKT, pgs.BoolValueWKT, pgs.StringValueWKT, pgs.BytesValueWKT:
+		return scalarSchema(msg.Fields()[0].Type().ProtoType())
+	}
+	if msg.FullyQualifiedName() == ".google.protobuf.FieldMask" {
+		return newObject().set("type", "string")
+	}
+	return newObject().set("$ref", b.ref(msg))
+}
+
+// ref returns the reference to the schema of msg, in the document of its file.
+func (b *builder) ref(msg pgs.Message) string {
+	name := SchemaTemplate
+	pointer := "#/$defs/"
+	if b.openAPI {
+		name, pointer = OpenAPITemplate, "#/components/schemas/"
+	}
+	pointer += fullName(msg)
+	if msg.File().Name() == b.file.Name() {
+		return pointer
+	}
+
+	from := filepath.Dir(documentPath(b.file, name).String())
+	to, err := filepath.Rel(from, documentPath(msg.File(), name).String())
+	if err != nil {
+		to = documentPath(msg.File(), name).String()
+	}
+	return filepath.ToSlash(to) + pointer
+}
+
+func fullName(msg pgs.Message) string {
+	return strings.TrimPrefix(msg.FullyQualifiedName(), ".")
+}
+
+// jsonName returns the name of f in the JSON mapping.
+func jsonName(f pgs.Field) string {
+	if name := f.Descriptor().GetJsonName(); name != "" {
+		return name
+	}
+	return f.Name().LowerCamelCase().String()
+}
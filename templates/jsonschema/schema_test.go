@@ -0,0 +1,97 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+func TestObjectMarshal(t *testing.T) {
+	o := newObject().set("pattern", "^<a&b>$").set("minLength", json.Number("1")).set("type", "string")
+	o.set("minLength", json.Number("2"))
+	o.delete("type")
+	o.delete("missing")
+	got, err := marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"pattern":"^<a&b>$","minLength":2}`; string(got) != want {
+		t.Errorf("marshal = %s; want %s", got, want)
+	}
+}
+
+func TestNumberKeywords(t *testing.T) {
+	tests := []struct {
+		rule proto.Message
+		want string
+	}{
+		{&validate.Int32Rule{Gt: proto.Int32(0), Lte: proto.Int32(10)}, `{"exclusiveMinimum":0,"maximum":10}`},
+		{&validate.Int64Rule{Gte: proto.Int64(10), Lt: proto.Int64(0)}, `{"anyOf":[{"exclusiveMaximum":0},{"minimum":10}]}`},
+		{&validate.UInt64Rule{In: []uint64{1, math.MaxUint64}}, `{"enum":[1,18446744073709551615]}`},
+		{&validate.DoubleRule{Const: proto.Float64(2.5), NotIn: []float64{1e21}}, `{"const":2.5,"not":{"enum":[1e+21]}}`},
+		{&validate.FloatRule{Gt: proto.Float32(0.1)}, `{"exclusiveMinimum":0.1}`},
+	}
+	for _, tt := range tests {
+		got, err := marshal(fragment(numberKeywords(tt.rule.ProtoReflect())))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("numberKeywords(%v) = %s; want %s", tt.rule, got, tt.want)
+		}
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    interface{}
+		want    string
+		replace bool
+		ok      bool
+	}{
+		{"minimum", json.Number("1"), json.Number("3"), `3`, true, true},
+		{"maxLength", json.Number("1"), json.Number("3"), `1`, false, true},
+		{"enum", []string{"a", "b"}, []interface{}{"b", "c"}, `["b"]`, true, true},
+		{"enum", []json.Number{"1"}, []json.Number{"2"}, `[]`, true, true},
+		{"pattern", "^a", "^a", `"^a"`, false, true},
+		{"const", "a", "b", `null`, false, false},
+	}
+	for _, tt := range tests {
+		v, replace, ok := intersect(tt.name, tt.a, tt.b)
+		got, err := marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want || replace != tt.replace || ok != tt.ok {
+			t.Errorf("intersect(%s, %v, %v) = %s, %v, %v; want %s, %v, %v", tt.name, tt.a, tt.b, got, replace, ok, tt.want, tt.replace, tt.ok)
+		}
+	}
+}
+
+func TestToOpenAPI(t *testing.T) {
+	errs := newObject().set("const", "e1").set("exclusiveMinimum", "e2").set("minimum", "e3").set("propertyNames", "e4")
+	s := newObject().
+		set("const", "a").
+		set("minimum", json.Number("1")).
+		set("exclusiveMinimum", json.Number("5")).
+		set("maximum", json.Number("5")).
+		set("exclusiveMaximum", json.Number("9")).
+		set("propertyNames", newObject().set("minLength", json.Number("1"))).
+		set("properties", newObject().set("x", newObject().set("const", json.Number("0")))).
+		set(errorsKeyword, errs)
+	toOpenAPI(s)
+	got, err := marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"enum":["a"],"minimum":5,"exclusiveMinimum":true,"maximum":5,` +
+		`"properties":{"x":{"enum":[0]}},"` + errorsKeyword + `":{"enum":"e1","minimum":"e2"}}`
+	if string(got) != want {
+		t.Errorf("toOpenAPI = %s; want %s", got, want)
+	}
+}
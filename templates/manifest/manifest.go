@@ -0,0 +1,407 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+// The manifest describes the rules of a proto file in a form other tools can
+// consume without parsing descriptors.
+type (
+	fileManifest struct {
+		File     string            `json:"file"`
+		Package  string            `json:"package"`
+		Groups   []string          `json:"groups,omitempty"`
+		Messages []messageManifest `json:"messages"`
+	}
+
+	messageManifest struct {
+		Name     string          `json:"name"`
+		Disabled bool            `json:"disabled,omitempty"`
+		Ignored  bool            `json:"ignored,omitempty"`
+		Fields   []fieldManifest `json:"fields"`
+		OneOfs   []oneOfManifest `json:"oneofs,omitempty"`
+		Exprs    []exprManifest  `json:"exprs,omitempty"`
+		Mask     *maskManifest   `json:"field_mask,omitempty"`
+	}
+
+	maskManifest struct {
+		Field  string `json:"field"`
+		Target string `json:"target"`
+	}
+
+	fieldManifest struct {
+		Name     string   `json:"name"`
+		Number   int32    `json:"number"`
+		Type     string   `json:"type"`
+		Repeated bool     `json:"repeated,omitempty"`
+		Map      bool     `json:"map,omitempty"`
+		OneOf    string   `json:"oneof,omitempty"`
+		Rules    *ruleSet `json:"rules,omitempty"`
+	}
+
+	oneOfManifest struct {
+		Name     string        `json:"name"`
+		Fields   []string      `json:"fields"`
+		Required bool          `json:"required,omitempty"`
+		Groups   []string      `json:"groups,omitempty"`
+		Error    *errorFactory `json:"error,omitempty"`
+	}
+
+	exprManifest struct {
+		Expression string        `json:"expression"`
+		Field      string        `json:"field,omitempty"`
+		Groups     []string      `json:"groups,omitempty"`
+		Error      *errorFactory `json:"error,omitempty"`
+	}
+
+	// ruleSet is a FieldRules: the rules of one type plus the message rules.
+	ruleSet struct {
+		Type    string        `json:"type,omitempty"`
+		Message *messageRules `json:"message,omitempty"`
+		Rules   []rule        `json:"rules,omitempty"`
+	}
+
+	messageRules struct {
+		Skip     bool            `json:"skip,omitempty"`
+		Required bool            `json:"required,omitempty"`
+		When     json.RawMessage `json:"when,omitempty"`
+		Groups   []string        `json:"groups,omitempty"`
+		Error    *errorFactory   `json:"error,omitempty"`
+	}
+
+	rule struct {
+		Index       int             `json:"index"`
+		Constraints json.RawMessage `json:"constraints"`
+		IgnoreEmpty bool            `json:"ignore_empty,omitempty"`
+		When        json.RawMessage `json:"when,omitempty"`
+		Groups      []string        `json:"groups,omitempty"`
+		Error       *errorFactory   `json:"error,omitempty"`
+		Items       *ruleSet        `json:"items,omitempty"`
+		Keys        *ruleSet        `json:"keys,omitempty"`
+		Values      *ruleSet        `json:"values,omitempty"`
+	}
+
+	// errorFactory is an Error after ErrorBase inheritance, with its args
+	// resolved against the declaring rule.
+	errorFactory struct {
+		Pkg    string        `json:"pkg,omitempty"`
+		Class  string        `json:"class,omitempty"`
+		Method string        `json:"method"`
+		Params []interface{} `json:"params"`
+	}
+)
+
+func buildFile(f pgs.File) (*fileManifest, error) {
+	out := &fileManifest{
+		File:     f.InputPath().String(),
+		Package:  f.Package().ProtoName().String(),
+		Messages: []messageManifest{},
+	}
+	groups, err := shared.DeclaredGroups(f)
+	if err != nil {
+		return nil, err
+	}
+	out.Groups = groups
+	for _, msg := range f.AllMessages() {
+		m, err := buildMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+		out.Messages = append(out.Messages, m)
+	}
+	return out, nil
+}
+
+func buildMessage(msg pgs.Message) (out messageManifest, err error) {
+	out.Name = strings.TrimPrefix(msg.FullyQualifiedName(), ".")
+	out.Fields = []fieldManifest{}
+	if out.Disabled, err = shared.Disabled(msg); err != nil {
+		return
+	}
+	if out.Ignored, err = shared.Ignored(msg); err != nil {
+		return
+	}
+	var base *validate.ErrorBase
+	if _, err = msg.Extension(validate.E_ErrorBase, &base); err != nil {
+		return
+	}
+
+	for _, f := range msg.Fields() {
+		fm := fieldManifest{
+			Name:     f.Name().String(),
+			Number:   f.Descriptor().GetNumber(),
+			Type:     strings.ToLower(strings.TrimPrefix(f.Descriptor().GetType().String(), "TYPE_")),
+			Repeated: f.Type().IsRepeated(),
+			Map:      f.Type().IsMap(),
+		}
+		if f.InRealOneOf() {
+			fm.OneOf = f.OneOf().Name().String()
+		}
+
+		var rules validate.FieldRules
+		var ok bool
+		if ok, err = f.Extension(validate.E_Rules, &rules); err != nil {
+			return
+		}
+		if ok {
+			if fm.Rules, err = buildRuleSet(f.Name().String(), base, &rules, nil); err != nil {
+				return
+			}
+		}
+		out.Fields = append(out.Fields, fm)
+	}
+
+	for _, oo := range msg.RealOneOfs() {
+		om := oneOfManifest{Name: oo.Name().String()}
+		for _, f := range oo.Fields() {
+			om.Fields = append(om.Fields, f.Name().String())
+		}
+		var r *validate.OneOf
+		if r, err = shared.OneOfRule(oo); err != nil {
+			return
+		}
+		om.Required = r.GetRequired()
+		om.Groups = shared.RuleGroups(r)
+		if r.GetError() != nil {
+			if om.Error, err = buildError(oo.Name().String(), base, r.ProtoReflect(), r.GetError()); err != nil {
+				return
+			}
+		}
+		out.OneOfs = append(out.OneOfs, om)
+	}
+
+	var exprs []*validate.ExprRule
+	if exprs, err = shared.ExprRules(msg); err != nil {
+		return
+	}
+	for _, r := range exprs {
+		em := exprManifest{Expression: r.GetExpression(), Field: r.GetField(), Groups: shared.RuleGroups(r)}
+		name := msg.Name().String()
+		if r.Field != nil {
+			name = r.GetField()
+		}
+		if r.GetError() != nil {
+			if em.Error, err = buildError(name, base, r.ProtoReflect(), r.GetError()); err != nil {
+				return
+			}
+		}
+		out.Exprs = append(out.Exprs, em)
+	}
+
+	var mask *validate.FieldMaskRule
+	if mask, err = shared.FieldMaskRule(msg); err != nil {
+		return
+	}
+	if mask != nil {
+		out.Mask = &maskManifest{Field: mask.GetField(), Target: mask.GetTarget()}
+	}
+	return
+}
+
+// buildRuleSet describes rules. Rules without an error of their own use
+// inherited, the error of the enclosing repeated or map rule.
+func buildRuleSet(field string, base *validate.ErrorBase, rules *validate.FieldRules, inherited *errorFactory) (*ruleSet, error) {
+	out := &ruleSet{}
+	if mr := rules.GetMessage(); mr != nil {
+		out.Message = &messageRules{Skip: mr.GetSkip(), Required: mr.GetRequired(), Groups: shared.RuleGroups(mr)}
+		if mr.GetWhen() != nil {
+			w, err := marshalCompact(mr.GetWhen())
+			if err != nil {
+				return nil, err
+			}
+			out.Message.When = w
+		}
+		if mr.GetError() != nil {
+			e, err := buildError(field, base, mr.ProtoReflect(), mr.GetError())
+			if err != nil {
+				return nil, err
+			}
+			out.Message.Error = e
+		}
+	}
+
+	rr := rules.ProtoReflect()
+	typ := rr.WhichOneof(rr.Descriptor().Oneofs().ByName("type"))
+	if typ == nil {
+		return out, nil
+	}
+	out.Type = string(typ.Name())
+
+	// Most rule types hold a list of rules, bool and enum rules are a single one.
+	typed := rr.Get(typ).Message()
+	var list []protoreflect.Message
+	if fd := typed.Descriptor().Fields().ByName("rules"); fd != nil && fd.IsList() {
+		for i := 0; i < typed.Get(fd).List().Len(); i++ {
+			list = append(list, typed.Get(fd).List().Get(i).Message())
+		}
+	} else {
+		list = append(list, typed)
+	}
+
+	for i, r := range list {
+		entry, err := buildRule(field, base, i, r, inherited)
+		if err != nil {
+			return nil, err
+		}
+		out.Rules = append(out.Rules, entry)
+	}
+	return out, nil
+}
+
+func buildRule(field string, base *validate.ErrorBase, index int, r protoreflect.Message, inherited *errorFactory) (out rule, err error) {
+	out.Index = index
+	out.Error = inherited
+	out.Groups = shared.RuleGroups(r.Interface())
+
+	constraints := proto.Clone(r.Interface()).ProtoReflect()
+	fields := r.Descriptor().Fields()
+	if fd := fields.ByName("ignore_empty"); fd != nil {
+		out.IgnoreEmpty = r.Get(fd).Bool()
+		constraints.Clear(fd)
+	}
+	if fd := fields.ByName("error"); fd != nil && r.Has(fd) {
+		e := r.Get(fd).Message().Interface().(*validate.Error)
+		if out.Error, err = buildError(field, base, r, e); err != nil {
+			return
+		}
+		constraints.Clear(fd)
+	}
+	if fd := fields.ByName("groups"); fd != nil {
+		constraints.Clear(fd)
+	}
+	if fd := fields.ByName("when"); fd != nil && r.Has(fd) {
+		if out.When, err = marshalCompact(r.Get(fd).Message().Interface()); err != nil {
+			return
+		}
+		constraints.Clear(fd)
+	}
+
+	for _, nested := range []struct {
+		name protoreflect.Name
+		dst  **ruleSet
+	}{{"items", &out.Items}, {"keys", &out.Keys}, {"values", &out.Values}} {
+		fd := fields.ByName(nested.name)
+		if fd == nil || !r.Has(fd) {
+			continue
+		}
+		constraints.Clear(fd)
+		rules := r.Get(fd).Message().Interface().(*validate.FieldRules)
+		if *nested.dst, err = buildRuleSet(field, base, rules, out.Error); err != nil {
+			return
+		}
+	}
+
+	out.Constraints, err = marshalCompact(constraints.Interface())
+	return
+}
+
+// marshalCompact renders m as compact JSON with the proto field names. Unlike
+// protojson, 64-bit integers are JSON numbers as the 32-bit ones are, so
+// consumers need not tell them apart.
+func marshalCompact(m proto.Message) (json.RawMessage, error) {
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v interface{}
+	if err = dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	int64Numbers(m.ProtoReflect(), v)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err = enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// int64Numbers replaces the strings protojson renders 64-bit integers of m as
+// in v, its decoded JSON, by numbers.
+func int64Numbers(m protoreflect.Message, v interface{}) {
+	// well-known types such as durations render as strings
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		key := string(fd.Name())
+		if fd.IsList() {
+			items, _ := obj[key].([]interface{})
+			for i := range items {
+				items[i] = int64Number(fd, val.List().Get(i), items[i])
+			}
+		} else if !fd.IsMap() {
+			obj[key] = int64Number(fd, val, obj[key])
+		}
+		return true
+	})
+}
+
+func int64Number(fd protoreflect.FieldDescriptor, val protoreflect.Value, v interface{}) interface{} {
+	switch fd.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if s, ok := v.(string); ok {
+			return json.Number(s)
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		int64Numbers(val.Message(), v)
+	}
+	return v
+}
+
+func buildError(field string, base *validate.ErrorBase, r protoreflect.Message, e *validate.Error) (*errorFactory, error) {
+	out := &errorFactory{
+		Pkg:    base.GetPkg(),
+		Class:  base.GetClass(),
+		Method: e.GetMethod(),
+		Params: []interface{}{},
+	}
+	if e.GetPkg() != "" {
+		out.Pkg = e.GetPkg()
+	}
+	if e.GetClass() != "" {
+		out.Class = e.GetClass()
+	}
+
+	args, err := shared.ErrorArgs(field, r, e)
+	if err != nil {
+		return nil, err
+	}
+	for _, arg := range args {
+		var v interface{}
+		switch arg.Kind {
+		case shared.IntArg:
+			v = arg.Int
+		case shared.UintArg:
+			v = arg.Uint
+		case shared.FloatArg:
+			v = arg.Float
+		case shared.BoolArg:
+			v = arg.Bool
+		case shared.RefArg:
+			v = map[string]string{"ref": arg.Str}
+		case shared.ValueArg:
+			v = map[string]string{"placeholder": "$value"}
+		default:
+			v = arg.Str
+		}
+		out.Params = append(out.Params, v)
+	}
+	return out, nil
+}
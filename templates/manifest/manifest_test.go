@@ -0,0 +1,35 @@
+package manifest
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+func TestMarshalCompact(t *testing.T) {
+	tests := []struct {
+		in   proto.Message
+		want string
+	}{
+		{&validate.Int32Rule{Gt: proto.Int32(-1), In: []int32{1, 2}}, `{"gt":-1,"in":[1,2]}`},
+		{&validate.Int64Rule{Lte: proto.Int64(math.MinInt64), NotIn: []int64{-1}}, `{"lte":-9223372036854775808,"not_in":[-1]}`},
+		{&validate.UInt64Rule{In: []uint64{math.MaxUint64}}, `{"in":[18446744073709551615]}`},
+		{&validate.SFixed64Rule{Const: proto.Int64(9007199254740993)}, `{"const":9007199254740993}`},
+		{&validate.When{Field: proto.String("n"), Value: &validate.When_Uint{Uint: 5000000000}}, `{"field":"n","uint":5000000000}`},
+		{&validate.DurationRule{Gte: durationpb.New(1e9)}, `{"gte":"1s"}`},
+		{&validate.StringRule{Pattern: proto.String("^<a&b>$"), Len: proto.Uint64(3)}, `{"len":3,"pattern":"^<a&b>$"}`},
+	}
+	for _, tt := range tests {
+		got, err := marshalCompact(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("marshalCompact(%v) = %s; want %s", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+)
+
+const fileTpl = `{{ manifest . }}
+`
+
+func Register(tpl *template.Template, params pgs.Parameters) {
+	tpl.Funcs(map[string]interface{}{
+		"manifest": fileManifestJSON,
+	})
+
+	template.Must(tpl.Parse(fileTpl))
+}
+
+// CodeFormat re-indents the generated manifest.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated manifest failed, %w", err)
+	}
+	var buf bytes.Buffer
+	if err = json.Indent(&buf, src, "", "  "); err != nil {
+		return fmt.Errorf("indent generated manifest failed, %w", err)
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// FilePath places the manifest next to the proto file it describes.
+func FilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	// Don't describe files that don't import PGV
+	if !importsPgv(f) {
+		return nil
+	}
+	out := f.InputPath().SetExt(".validate." + tpl.Name())
+	return &out
+}
+
+func importsPgv(f pgs.File) bool {
+	for _, dep := range f.Descriptor().Dependency {
+		if strings.HasSuffix(dep, "validate.proto") {
+			return true
+		}
+	}
+	return false
+}
+
+func fileManifestJSON(f pgs.File) (string, error) {
+	m, err := buildFile(f)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(m); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -7,8 +7,17 @@ import (
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
 
+	"github.com/curl-li/protoc-gen-validate/templates/cc"
+	"github.com/curl-li/protoc-gen-validate/templates/ccnop"
+	"github.com/curl-li/protoc-gen-validate/templates/csharp"
+	"github.com/curl-li/protoc-gen-validate/templates/golang"
 	"github.com/curl-li/protoc-gen-validate/templates/java"
+	"github.com/curl-li/protoc-gen-validate/templates/jsonschema"
+	"github.com/curl-li/protoc-gen-validate/templates/manifest"
+	"github.com/curl-li/protoc-gen-validate/templates/python"
+	"github.com/curl-li/protoc-gen-validate/templates/rust"
 	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/templates/ts"
 )
 
 type CodeFormatFn func(in io.Reader, out io.Writer) error
@@ -26,23 +35,61 @@ func makeTemplate(ext string, fn RegisterFn, params pgs.Parameters) *template.Te
 
 func Template(params pgs.Parameters) map[string][]*template.Template {
 	return map[string][]*template.Template{
-		//"cc":    {makeTemplate("h", cc.RegisterHeader, params), makeTemplate("cc", cc.RegisterModule, params)},
-		//"ccnop": {makeTemplate("h", ccnop.RegisterHeader, params), makeTemplate("cc", ccnop.RegisterModule, params)},
-		//"go":    {makeTemplate("go", golang.Register, params)},
-		"java": {makeTemplate("java", java.Register, params)},
+		"cc":         {makeTemplate("h", cc.RegisterHeader, params), makeTemplate("cc", cc.RegisterModule, params)},
+		"ccnop":      {makeTemplate("h", ccnop.RegisterHeader, params), makeTemplate("cc", ccnop.RegisterModule, params)},
+		"csharp":     {makeTemplate("cs", csharp.Register, params)},
+		"go":         {makeTemplate("go", golang.Register, params)},
+		"java":       javaTemplates(params),
+		"jsonschema": {makeTemplate(jsonschema.SchemaTemplate, jsonschema.Register, params)},
+		"kotlin":     {makeTemplate("kotlin", java.RegisterKotlin, params)},
+		"manifest":   {makeTemplate("json", manifest.Register, params)},
+		"openapi":    {makeTemplate(jsonschema.OpenAPITemplate, jsonschema.RegisterOpenAPI, params)},
+		"python":     {makeTemplate("py", python.Register, params)},
+		"rust":       {makeTemplate("rs", rust.Register, params)},
+		"ts":         {makeTemplate("ts", ts.Register, params)},
 	}
 }
 
+// javaTemplates returns the Java validators template, and the ones of the
+// Jakarta Bean Validation constraints and gRPC interceptors if they are
+// enabled.
+func javaTemplates(params pgs.Parameters) []*template.Template {
+	tpls := []*template.Template{makeTemplate("java", java.Register, params)}
+	if beanValidation, _ := params.BoolDefault(java.BeanValidationParam, false); beanValidation {
+		tpls = append(tpls, makeTemplate("constraints", java.RegisterConstraints, params))
+	}
+	if grpc, _ := params.BoolDefault(java.GrpcParam, false); grpc {
+		tpls = append(tpls, makeTemplate("interceptor", java.RegisterInterceptor, params))
+	}
+	return tpls
+}
+
 func FilePathFor(tpl *template.Template) FilePathFn {
 	switch tpl.Name() {
-	//case "h":
-	//	return cc.CcFilePath
-	//case "ccnop":
-	//	return cc.CcFilePath
-	//case "cc":
-	//	return cc.CcFilePath
+	case "h", "cc":
+		return cc.CcFilePath
+	case "cs":
+		return csharp.FilePath
+	case "go":
+		return golang.GoFilePath
 	case "java":
 		return java.JavaFilePath
+	case "constraints":
+		return java.ConstraintsFilePath
+	case "interceptor":
+		return java.InterceptorsFilePath
+	case "kotlin":
+		return java.KotlinFilePath
+	case "json":
+		return manifest.FilePath
+	case jsonschema.SchemaTemplate, jsonschema.OpenAPITemplate:
+		return jsonschema.FilePath
+	case "py":
+		return python.FilePath
+	case "rs":
+		return rust.FilePath
+	case "ts":
+		return ts.FilePath
 	default:
 		return func(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
 			out := ctx.OutputPath(f)
@@ -52,16 +99,28 @@ func FilePathFor(tpl *template.Template) FilePathFn {
 	}
 }
 
-func CodeFormatterFor(tpl *template.Template) CodeFormatFn {
+func CodeFormatterFor(tpl *template.Template, params pgs.Parameters) CodeFormatFn {
 	switch tpl.Name() {
-	//case "h":
-	//	return cc.CcFilePath
-	//case "ccnop":
-	//	return cc.CcFilePath
-	//case "cc":
-	//	return cc.CcFilePath
-	case "java":
-		return java.CodeFormat
+	case "h", "cc":
+		return cc.CodeFormat
+	case "cs":
+		return csharp.CodeFormat
+	case "go":
+		return golang.CodeFormat
+	case "java", "constraints", "interceptor":
+		return java.CodeFormatter(params)
+	case "kotlin":
+		return java.KotlinCodeFormat
+	case "json":
+		return manifest.CodeFormat
+	case jsonschema.SchemaTemplate, jsonschema.OpenAPITemplate:
+		return jsonschema.CodeFormat
+	case "py":
+		return python.CodeFormat
+	case "rs":
+		return rust.CodeFormat
+	case "ts":
+		return ts.CodeFormat
 	default:
 		return nil
 	}
@@ -0,0 +1,34 @@
+package python
+
+const anyConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+{{ constantName $ctx $index "InLookup" }} = frozenset([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.NotIn }}
+{{ constantName $ctx $index "NotInLookup" }} = frozenset([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+])
+{{- end }}
+{{- end }}
+`
+
+const anyTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{ $kw := "if" }}
+{{- if $r.GetRequired }}
+    if not {{ has $ctx }}:
+        {{ fail $ctx "required" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.In }}
+    {{ $kw }} {{ has $ctx }} and {{ $val }}.type_url not in {{ constantName $ctx $index "InLookup" }}:
+        {{ fail $ctx "in" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.NotIn }}
+    {{ $kw }} {{ has $ctx }} and {{ $val }}.type_url in {{ constantName $ctx $index "NotInLookup" }}:
+        {{ fail $ctx "not_in" $r.GetError }}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,7 @@
+package python
+
+const boolTpl = `{{ $r := .Rules -}}
+{{- if $r.Const }}
+    if {{ if $r.GetConst }}not {{ end }}{{ accessor . }}:
+        {{ fail . "const" $r.GetError }}
+{{- end }}`
@@ -0,0 +1,92 @@
+package python
+
+const bytesConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+{{ constantName $ctx $index "InLookup" }} = frozenset([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.NotIn }}
+{{ constantName $ctx $index "NotInLookup" }} = frozenset([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.Pattern }}
+{{ constantName $ctx $index "Pattern" }} = re.compile({{ lit $r.GetPattern }})
+{{- end }}
+{{- end }}
+`
+
+const bytesTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{ $kw := "if" }}
+{{- if $r.GetIgnoreEmpty }}
+    if {{ emptyCond $ctx }}:
+        pass
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Const }}
+    {{ $kw }} {{ $val }} != {{ bytesLit $r.GetConst }}:
+        {{ fail $ctx "const" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.In }}
+    {{ $kw }} {{ $val }} not in {{ constantName $ctx $index "InLookup" }}:
+        {{ fail $ctx "in" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.NotIn }}
+    {{ $kw }} {{ $val }} in {{ constantName $ctx $index "NotInLookup" }}:
+        {{ fail $ctx "not_in" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Len }}
+    {{ $kw }} len({{ $val }}) != {{ $r.GetLen }}:
+        {{ fail $ctx "len" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MinLen }}
+    {{ $kw }} len({{ $val }}) < {{ $r.GetMinLen }}:
+        {{ fail $ctx "min_len" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MaxLen }}
+    {{ $kw }} len({{ $val }}) > {{ $r.GetMaxLen }}:
+        {{ fail $ctx "max_len" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Pattern }}
+    {{ $kw }} not {{ constantName $ctx $index "Pattern" }}.search(pgv.utf8({{ $val }})):
+        {{ fail $ctx "pattern" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Prefix }}
+    {{ $kw }} not {{ $val }}.startswith({{ bytesLit $r.GetPrefix }}):
+        {{ fail $ctx "prefix" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Suffix }}
+    {{ $kw }} not {{ $val }}.endswith({{ bytesLit $r.GetSuffix }}):
+        {{ fail $ctx "suffix" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Contains }}
+    {{ $kw }} {{ bytesLit $r.GetContains }} not in {{ $val }}:
+        {{ fail $ctx "contains" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetIp }}
+    {{ $kw }} len({{ $val }}) not in (4, 16):
+        {{ fail $ctx "ip" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetIpv4 }}
+    {{ $kw }} len({{ $val }}) != 4:
+        {{ fail $ctx "ipv4" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetIpv6 }}
+    {{ $kw }} len({{ $val }}) != 16:
+        {{ fail $ctx "ipv6" $r.GetError }}
+{{- end }}
+{{ end -}}
+`
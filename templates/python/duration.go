@@ -0,0 +1,45 @@
+package python
+
+const durationConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+{{ constantName $ctx $index "InLookup" }} = frozenset([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.NotIn }}
+{{ constantName $ctx $index "NotInLookup" }} = frozenset([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end -}}
+])
+{{- end }}
+{{- end }}
+`
+
+const durationTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if $r.GetRequired }}
+    if not {{ has $ctx }}:
+        {{ fail $ctx "required" $r.GetError }}
+    else:
+	{{- else }}
+    if {{ has $ctx }}:
+	{{- end }}
+        d = {{ accessor $ctx }}
+        if not pgv.is_valid_duration(d):
+            {{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+        elif pgv.nanos(d) != {{ nanosLit $r.GetConst }}:
+            {{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond $ctx "pgv.nanos(d)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+        elif {{ . }}:
+            {{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.In }}
+        elif pgv.nanos(d) not in {{ constantName $ctx $index "InLookup" }}:
+            {{ fail $ctx "in" $r.GetError }}
+		{{- end }}
+		{{- if $r.NotIn }}
+        elif pgv.nanos(d) in {{ constantName $ctx $index "NotInLookup" }}:
+            {{ fail $ctx "not_in" $r.GetError }}
+		{{- end }}
+{{ end -}}
+`
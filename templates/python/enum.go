@@ -0,0 +1,36 @@
+package python
+
+const enumConstTpl = `{{ $r := .Rules -}}
+{{- if $r.In }}
+{{ constantName . 0 "InLookup" }} = frozenset([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.NotIn }}
+{{ constantName . 0 "NotInLookup" }} = frozenset([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}}
+])
+{{- end }}
+`
+
+const enumTpl = `{{ $r := .Rules }}{{ $val := accessor . }}{{ $kw := "if" }}
+{{- if $r.Const }}
+    {{ $kw }} {{ $val }} != {{ $r.GetConst }}:
+        {{ fail . "const" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetDefinedOnly }}
+    {{ $kw }} {{ $val }} not in {{ enumName . }}.values():
+        {{ fail . "defined_only" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.In }}
+    {{ $kw }} {{ $val }} not in {{ constantName . 0 "InLookup" }}:
+        {{ fail . "in" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.NotIn }}
+    {{ $kw }} {{ $val }} in {{ constantName . 0 "NotInLookup" }}:
+        {{ fail . "not_in" $r.GetError }}
+{{- end }}
+`
@@ -0,0 +1,15 @@
+package python
+
+const fileTpl = `# Code generated by protoc-gen-validate. DO NOT EDIT.
+# source: {{ .InputPath }}
+{{ if usesPatterns . }}
+import re
+{{ end }}
+from protoc_gen_validate import validator as pgv
+{{- range imports . }}
+{{ .Statement }}
+{{- end }}
+{{ range .AllMessages }}
+	{{- template "msg" . }}
+{{ end }}
+`
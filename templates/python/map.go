@@ -0,0 +1,40 @@
+package python
+
+const mapConstTpl = `{{ renderConstants (.Key "" "Key") }}
+{{- renderConstants (.Elem "" "Value") -}}
+`
+
+const mapTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{ $kw := "if" }}
+{{- if $r.GetIgnoreEmpty }}
+    if {{ emptyCond $ctx }}:
+        pass
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MinPairs }}
+    {{ $kw }} len({{ $val }}) < {{ $r.GetMinPairs }}:
+        {{ fail $ctx "min_pairs" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MaxPairs }}
+    {{ $kw }} len({{ $val }}) > {{ $r.GetMaxPairs }}:
+        {{ fail $ctx "max_pairs" $r.GetError }}
+{{- end }}
+{{- if $r.GetNoSparse }}
+    # no_sparse holds for every Python map, which can't hold unset messages
+{{- end }}
+{{- if or $r.GetKeys $r.GetValues }}
+    for {{ if $r.GetKeys }}key{{ else }}_{{ end }}, {{ if $r.GetValues }}val{{ else }}_{{ end }} in v.entries({{ lit (fieldPath $ctx) }}, {{ $val }}):
+	{{- if $r.GetKeys }}
+{{ nest 1 (render ($ctx.KeyWithErrIndex "key" "Key" $index)) }}
+	{{- end }}
+	{{- if $r.GetValues }}
+{{ nest 1 (render ($ctx.ElemWithErrIndex "val" "Value" $index)) }}
+	{{- end }}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasValues .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+    for _, val in v.entries({{ lit (fieldPath .) }}, {{ $val }}):
+        {{ checkRef . }}(val, v)
+{{- end }}
+`
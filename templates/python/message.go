@@ -0,0 +1,37 @@
+package python
+
+const messageTpl = `{{ $f := .Field }}{{ $r := .Rules }}
+	{{- if .MessageRules.GetSkip }}
+    # skipping validation for {{ $f.Name }}
+	{{- else if .AccessorOverride }}
+	{{- if validated (embedded .) }}
+    v.nested({{ lit (fieldPath .) }}, {{ accessor . }}, {{ checkRef . }})
+	{{- end }}
+	{{- else if $r.GetRequired }}
+    if not {{ has . }}:
+        {{ fail . "required" $r.GetError }}
+	{{- if validated (embedded .) }}
+    else:
+        v.nested({{ lit (fieldPath .) }}, {{ accessor . }}, {{ checkRef . }})
+	{{- end }}
+	{{- else if validated (embedded .) }}
+    if {{ has . }}:
+        v.nested({{ lit (fieldPath .) }}, {{ accessor . }}, {{ checkRef . }})
+	{{- end -}}
+`
+
+const wrapperConstTpl = `{{ renderConstants (unwrap .) }}`
+
+const wrapperTpl = `
+	{{- if .AccessorOverride }}
+{{ render (unwrap .) }}
+	{{- else }}
+    if {{ has . }}:
+        wrapper = {{ accessor . }}
+{{ nest 1 (render (unwrap .)) }}
+	{{- if .MessageRules.GetRequired }}
+    else:
+        {{ fail . "required" .MessageRules.GetError }}
+	{{- end }}
+	{{- end }}
+`
@@ -0,0 +1,50 @@
+package python
+
+const msgTpl = `
+{{ if not (ignored .) -}}
+{{ $ctx := . -}}
+{{ range validatedFields . -}}
+	{{ renderConstants (context $ctx .) }}
+{{- end -}}
+{{ template "oneOfConst" . }}
+
+def {{ checkName . }}(m, v):
+    """Reports the violations of the rules of {{ msgTyp . }} to v."""
+	{{- if disabled . }}
+    # validation is disabled for {{ msgTyp . }}
+	{{- else }}
+	{{- range validatedFields . }}
+{{ template "field" (context $ctx .) }}
+	{{- end }}
+	{{- template "oneOf" . }}
+	{{- end }}
+
+
+def validate_{{ funcName . }}(m, registry=None):
+    """Validates {{ msgTyp . }}, raising the error of the first failed rule."""
+    pgv.validate({{ checkName . }}, m, registry)
+
+
+def validate_{{ funcName . }}_all(m, registry=None):
+    """Validates {{ msgTyp . }}, returning every failed rule."""
+    return pgv.validate_all({{ checkName . }}, m, registry)
+{{- end -}}
+`
+
+// fieldTpl gates the rules of a proto3 optional field on it being set, and
+// reports its required rule otherwise.
+const fieldTpl = `{{ if optional . -}}
+	{{- if constrained .Rules }}
+    if {{ has . }}:
+{{ nest 1 (render .) }}
+	{{- with failRequired . }}
+    else:
+        {{ . }}
+	{{- end }}
+	{{- else }}{{ with failRequired . }}
+    if not {{ has $ }}:
+        {{ . }}
+	{{- end }}{{ end }}
+{{- else -}}
+{{ render . }}
+{{- end }}`
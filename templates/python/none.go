@@ -0,0 +1,3 @@
+package python
+
+const noneTpl = `    # no validation rules for {{ .Field.Name }}`
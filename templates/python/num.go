@@ -0,0 +1,44 @@
+package python
+
+const numConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+{{ constantName $ctx $index "InLookup" }} = frozenset([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ numLit $ctx $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.NotIn }}
+{{ constantName $ctx $index "NotInLookup" }} = frozenset([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ numLit $ctx $v }}{{ end -}}
+])
+{{- end }}
+{{- end }}
+`
+
+const numTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{ $kw := "if" }}
+{{- if $r.GetIgnoreEmpty }}
+    if {{ emptyCond $ctx }}:
+        pass
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Const }}
+    {{ $kw }} {{ $val }} != {{ numLit $ctx $r.GetConst }}:
+        {{ fail $ctx "const" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- with rangeCond $ctx $val $r.Lt $r.Lte $r.Gt $r.Gte }}
+    {{ $kw }} {{ . }}:
+        {{ fail $ctx (boundsRule $r) $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.In }}
+    {{ $kw }} {{ $val }} not in {{ constantName $ctx $index "InLookup" }}:
+        {{ fail $ctx "in" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.NotIn }}
+    {{ $kw }} {{ $val }} in {{ constantName $ctx $index "NotInLookup" }}:
+        {{ fail $ctx "not_in" $r.GetError }}
+{{- end }}
+{{ end -}}
+`
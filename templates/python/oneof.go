@@ -0,0 +1,21 @@
+package python
+
+const oneOfConstTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+{{- range .Fields }}{{ renderConstants (context $msg .) }}{{ end -}}
+{{- end -}}
+`
+
+const oneOfTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+    kind = m.WhichOneof({{ lit .Name.String }})
+	{{- $kw := "if" }}
+	{{- range .Fields }}
+    {{ $kw }} kind == {{ lit .Name.String }}:
+{{ nest 1 (render (context $msg .)) }}
+	{{- $kw = "elif" }}
+	{{- end }}
+	{{- if (oneofRule .).GetRequired }}
+    else:
+        {{ failOneOf $msg . }}
+	{{- end }}
+{{- end -}}
+`
@@ -0,0 +1,818 @@
+package python
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+func Register(tpl *template.Template, params pgs.Parameters) {
+	fns := pyFuncs{}
+
+	tpl.Funcs(map[string]interface{}{
+		"accessor":        fns.accessor,
+		"nest":            fns.nest,
+		"bytesLit":        fns.bytesLit,
+		"checkName":       fns.checkName,
+		"checkRef":        fns.checkRef,
+		"boundsRule":      shared.BoundsRule,
+		"constantName":    fns.constantName,
+		"constrained":     shared.HasConstraints,
+		"embedded":        fns.embedded,
+		"emptyCond":       fns.emptyCond,
+		"enumName":        fns.enumName,
+		"fail":            fns.fail,
+		"failOneOf":       fns.failOneOf,
+		"failRequired":    fns.failRequired,
+		"fieldPath":       fns.fieldPath,
+		"funcName":        fns.funcName,
+		"has":             fns.has,
+		"hasItems":        hasItems,
+		"hasValues":       hasValues,
+		"imports":         fns.imports,
+		"lit":             fns.lit,
+		"msgTyp":          fns.msgTyp,
+		"nanosLit":        fns.nanosLit,
+		"numLit":          fns.numLit,
+		"optional":        fns.optional,
+		"rangeCond":       fns.rangeCond,
+		"renderConstants": fns.renderConstants(tpl),
+		"unwrap":          fns.unwrap,
+		"usesPatterns":    usesPatterns,
+		"validated":       fns.validated,
+		"validatedFields": fns.validatedFields,
+	})
+
+	template.Must(tpl.Parse(fileTpl))
+	template.Must(tpl.New("msg").Parse(msgTpl))
+	template.Must(tpl.New("field").Parse(fieldTpl))
+
+	template.Must(tpl.New("none").Parse(noneTpl))
+
+	template.Must(tpl.New("float").Parse(numTpl))
+	template.Must(tpl.New("floatConst").Parse(numConstTpl))
+	template.Must(tpl.New("double").Parse(numTpl))
+	template.Must(tpl.New("doubleConst").Parse(numConstTpl))
+	template.Must(tpl.New("int32").Parse(numTpl))
+	template.Must(tpl.New("int32Const").Parse(numConstTpl))
+	template.Must(tpl.New("int64").Parse(numTpl))
+	template.Must(tpl.New("int64Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint32").Parse(numTpl))
+	template.Must(tpl.New("uint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint64").Parse(numTpl))
+	template.Must(tpl.New("uint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint32").Parse(numTpl))
+	template.Must(tpl.New("sint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint64").Parse(numTpl))
+	template.Must(tpl.New("sint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed32").Parse(numTpl))
+	template.Must(tpl.New("fixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed64").Parse(numTpl))
+	template.Must(tpl.New("fixed64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed32").Parse(numTpl))
+	template.Must(tpl.New("sfixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed64").Parse(numTpl))
+	template.Must(tpl.New("sfixed64Const").Parse(numConstTpl))
+
+	template.Must(tpl.New("bool").Parse(boolTpl))
+	template.Must(tpl.New("string").Parse(stringTpl))
+	template.Must(tpl.New("stringConst").Parse(stringConstTpl))
+	template.Must(tpl.New("bytes").Parse(bytesTpl))
+	template.Must(tpl.New("bytesConst").Parse(bytesConstTpl))
+
+	template.Must(tpl.New("any").Parse(anyTpl))
+	template.Must(tpl.New("anyConst").Parse(anyConstTpl))
+	template.Must(tpl.New("enum").Parse(enumTpl))
+	template.Must(tpl.New("enumConst").Parse(enumConstTpl))
+	template.Must(tpl.New("message").Parse(messageTpl))
+	template.Must(tpl.New("repeated").Parse(repeatedTpl))
+	template.Must(tpl.New("repeatedConst").Parse(repeatedConstTpl))
+	template.Must(tpl.New("map").Parse(mapTpl))
+	template.Must(tpl.New("mapConst").Parse(mapConstTpl))
+	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
+	template.Must(tpl.New("oneOfConst").Parse(oneOfConstTpl))
+
+	template.Must(tpl.New("timestamp").Parse(timestampTpl))
+	template.Must(tpl.New("duration").Parse(durationTpl))
+	template.Must(tpl.New("durationConst").Parse(durationConstTpl))
+	template.Must(tpl.New("wrapper").Parse(wrapperTpl))
+	template.Must(tpl.New("wrapperConst").Parse(wrapperConstTpl))
+}
+
+// pyFuncs renders validators against the message classes protoc generates
+// with --python_out. Rule templates render the statements of a check function
+// one level deep, nested statements are shifted by nest.
+type pyFuncs struct{}
+
+const indent = "    "
+
+// CodeFormat trims the blank lines the templates leave: one at most between the
+// statements of function bodies, two around top-level functions as PEP 8 asks
+// and one at most elsewhere.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+
+	var buf bytes.Buffer
+	blanks, prev := 0, ""
+	for _, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			blanks++
+			continue
+		}
+
+		indented := strings.HasPrefix(line, " ")
+		switch {
+		case buf.Len() == 0:
+		case indented:
+			// separate the rules of a function, but not the branches of a
+			// statement nor the body of a block from its header
+			opens := strings.HasSuffix(prev, ":") || strings.HasSuffix(prev, `"""`)
+			branch := strings.HasPrefix(line, indent+"elif ") || strings.HasPrefix(line, indent+"else:")
+			if blanks > 0 && !opens && !branch && !strings.HasPrefix(line, indent+indent) {
+				buf.WriteString("\n")
+			}
+		case strings.HasPrefix(line, "def ") || strings.HasPrefix(prev, " "):
+			buf.WriteString("\n\n")
+		case blanks > 0:
+			buf.WriteString("\n")
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+		blanks, prev = 0, line
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// FilePath places the validators next to the module protoc generates for the
+// proto file, named like the modules of grpcio-tools.
+func FilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	// Don't generate validators for files that don't import PGV
+	if !importsPgv(f) {
+		return nil
+	}
+	out := pgs.FilePath(moduleName(f, "_pb2_validate").String()).SetExt(".py")
+	return &out
+}
+
+func importsPgv(f pgs.File) bool {
+	for _, dep := range f.Descriptor().Dependency {
+		if strings.HasSuffix(dep, "validate.proto") {
+			return true
+		}
+	}
+	return false
+}
+
+// moduleName returns the path of the module generated for f with the given
+// suffix, e.g. `acme/v1/foo_pb2`: like protoc, dashes become underscores.
+func moduleName(f pgs.File, suffix string) pgs.FilePath {
+	name := strings.TrimSuffix(f.InputPath().String(), ".proto")
+	return pgs.FilePath(strings.ReplaceAll(name, "-", "_") + suffix)
+}
+
+// moduleAlias returns the name a module is imported as, following protoc:
+// `acme/v1/foo_pb2` becomes `acme_dot_v1_dot_foo__pb2`.
+func moduleAlias(module pgs.FilePath) string {
+	name := strings.ReplaceAll(module.String(), "_", "__")
+	return strings.ReplaceAll(name, "/", "_dot_")
+}
+
+// typeName returns the name of a message or enum relative to the module of its
+// file, e.g. `Outer.Inner`.
+func typeName(e pgs.Entity) string {
+	name := strings.TrimPrefix(e.FullyQualifiedName(), ".")
+	if pkg := e.Package().ProtoName().String(); pkg != "" {
+		name = strings.TrimPrefix(name, pkg+".")
+	}
+	return name
+}
+
+func (fns pyFuncs) msgTyp(msg pgs.Message) string {
+	return typeName(msg)
+}
+
+// funcName returns the snake_case name of msg used by its functions, with
+// nested messages joined to their parents by an underscore.
+func (fns pyFuncs) funcName(msg pgs.Message) string {
+	parts := strings.Split(typeName(msg), ".")
+	for i, part := range parts {
+		parts[i] = strcase.ToSnake(part)
+	}
+	return strings.Join(parts, "_")
+}
+
+func (fns pyFuncs) checkName(msg pgs.Message) string {
+	return "check_" + fns.funcName(msg)
+}
+
+// checkRef returns the expression of the check function of the message type of
+// ctx, qualified by its module if it is defined by another file.
+func (fns pyFuncs) checkRef(ctx shared.RuleContext) string {
+	embed := fns.embedded(ctx)
+	if embed.File().Name() == ctx.Field.File().Name() {
+		return fns.checkName(embed)
+	}
+	return moduleAlias(moduleName(embed.File(), "_pb2_validate")) + "." + fns.checkName(embed)
+}
+
+// enumName returns the expression of the enum type of ctx, resolving repeated
+// items and map values.
+func (fns pyFuncs) enumName(ctx shared.RuleContext) string {
+	en := ctx.Field.Type().Enum()
+	if t := ctx.Field.Type(); t.IsRepeated() || t.IsMap() {
+		en = t.Element().Enum()
+	}
+	return moduleAlias(moduleName(en.File(), "_pb2")) + "." + typeName(en)
+}
+
+// embedded returns the message type of ctx, resolving repeated items and map
+// values.
+func (fns pyFuncs) embedded(ctx shared.RuleContext) pgs.Message {
+	if t := ctx.Field.Type(); t.IsRepeated() || t.IsMap() {
+		return t.Element().Embed()
+	}
+	return ctx.Field.Type().Embed()
+}
+
+// validated reports if a check function exists for msg: well-known types and
+// messages of files without rules have none.
+func (fns pyFuncs) validated(msg pgs.Message) bool {
+	if msg == nil || msg.IsWellKnown() || !importsPgv(msg.File()) {
+		return false
+	}
+	ignored, err := shared.Ignored(msg)
+	return err == nil && !ignored
+}
+
+// keywords are the Python keywords, which protoc leaves as field names only
+// reachable through getattr.
+var keywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true, "async": true,
+	"await": true, "break": true, "class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+func (fns pyFuncs) accessor(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride
+	}
+	name := ctx.Field.Name().String()
+	if keywords[name] {
+		return fmt.Sprintf("getattr(m, %q)", name)
+	}
+	return "m." + name
+}
+
+// has renders the condition under which the message field of ctx is set.
+// Repeated items and map values always are.
+func (fns pyFuncs) has(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride + " is not None"
+	}
+	return fmt.Sprintf("m.HasField(%q)", ctx.Field.Name())
+}
+
+// validatedFields returns the fields of msg outside of a real oneof, proto3
+// optional fields included.
+func (fns pyFuncs) validatedFields(msg pgs.Message) (out []pgs.Field) {
+	for _, f := range msg.Fields() {
+		if !f.InRealOneOf() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// optional reports whether ctx is a proto3 optional scalar field, whose rules
+// only apply once it is set.
+func (fns pyFuncs) optional(ctx shared.RuleContext) bool {
+	f := ctx.Field
+	return ctx.Typ != "none" && ctx.AccessorOverride == "" && f.InOneOf() && !f.InRealOneOf() && !f.Type().IsEmbed()
+}
+
+// failRequired renders the report of the required rule of the proto3
+// optional scalar field of ctx, empty if it has none.
+func (fns pyFuncs) failRequired(ctx shared.RuleContext) (string, error) {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil || !shared.ScalarRequired(&rules) {
+		return "", err
+	}
+	return fns.fail(ctx, "required", shared.RequiredError(ctx.Rules))
+}
+
+// nest shifts the statements rendered for a nested rule context by depth
+// levels, adding a pass statement if there are none.
+func (fns pyFuncs) nest(depth int, code string) string {
+	prefix := strings.Repeat(indent, depth)
+	lines := strings.Split(code, "\n")
+	empty := true
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if !strings.HasPrefix(trimmed, "#") {
+			empty = false
+		}
+		lines[i] = prefix + line
+	}
+	if empty {
+		lines = append(lines, prefix+indent+"pass")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fieldPath returns the name violations of ctx are reported under, empty for
+// repeated items and map entries which are reported under their index or key.
+func (fns pyFuncs) fieldPath(ctx shared.RuleContext) string {
+	switch strings.SplitN(ctx.AccessorOverride, ".", 2)[0] {
+	case "item", "key", "val":
+		return ""
+	}
+	return ctx.Field.Name().String()
+}
+
+// constantName returns the name of a module level constant backing the rule
+// at index of ctx.
+func (fns pyFuncs) constantName(ctx shared.RuleContext, index int, rule string) string {
+	name := strings.ReplaceAll(typeName(ctx.Field.Message()), ".", "_")
+	return fmt.Sprintf("_%s_%s%s_%d_%s", name, ctx.Field.Name(), ctx.Index, index, rule)
+}
+
+// numLit renders v as a Python literal.
+func (fns pyFuncs) numLit(ctx shared.RuleContext, v interface{}) string {
+	switch n := v.(type) {
+	case float32:
+		return floatLit(float64(n))
+	case float64:
+		if ctx.Typ == "float" {
+			// float fields hold float32 values widened to a float
+			return floatLit(float64(float32(n)))
+		}
+		return floatLit(n)
+	}
+	return fmt.Sprint(v)
+}
+
+func floatLit(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+// lit renders a string as a Python literal, the escapes of Go being valid in
+// Python as well.
+func (fns pyFuncs) lit(s string) string {
+	return strconv.Quote(s)
+}
+
+func boolLit(b bool) string {
+	if b {
+		return "True"
+	}
+	return "False"
+}
+
+func (fns pyFuncs) bytesLit(b []byte) string {
+	var sb strings.Builder
+	sb.WriteString(`b"`)
+	for _, c := range b {
+		switch {
+		case c == '"' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c >= 0x20 && c < 0x7f:
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, `\x%02x`, c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func (fns pyFuncs) nanosLit(v interface{}) string {
+	switch t := v.(type) {
+	case *durationpb.Duration:
+		return strconv.FormatInt(t.AsDuration().Nanoseconds(), 10)
+	case *timestamppb.Timestamp:
+		return strconv.FormatInt(t.GetSeconds()*1e9+int64(t.GetNanos()), 10)
+	}
+	return fmt.Sprint(v)
+}
+
+// emptyCond renders the condition under which the value of ctx is empty, for
+// ignore_empty.
+func (fns pyFuncs) emptyCond(ctx shared.RuleContext) string {
+	value := fns.accessor(ctx)
+	switch ctx.Typ {
+	case "string":
+		return value + ` == ""`
+	case "bytes":
+		return value + ` == b""`
+	case "repeated", "map":
+		return fmt.Sprintf("len(%s) == 0", value)
+	}
+	return value + " == 0"
+}
+
+// rangeCond renders the condition under which value violates the lt/lte/gt/gte
+// bounds of a rule. Like the Java runtime, a lower bound above the upper bound
+// inverts the range into an exclusive one.
+func (fns pyFuncs) rangeCond(ctx shared.RuleContext, value string, lt, lte, gt, gte interface{}) (string, error) {
+	ltLit, ltVal, hasLt, err := fns.bound(ctx, lt)
+	if err != nil {
+		return "", err
+	}
+	lteLit, lteVal, hasLte, err := fns.bound(ctx, lte)
+	if err != nil {
+		return "", err
+	}
+	gtLit, gtVal, hasGt, err := fns.bound(ctx, gt)
+	if err != nil {
+		return "", err
+	}
+	gteLit, gteVal, hasGte, err := fns.bound(ctx, gte)
+	if err != nil {
+		return "", err
+	}
+
+	var upper, lower string
+	var upperVal, lowerVal float64
+	switch {
+	case hasLt:
+		upper, upperVal = fmt.Sprintf("%s >= %s", value, ltLit), ltVal
+	case hasLte:
+		upper, upperVal = fmt.Sprintf("%s > %s", value, lteLit), lteVal
+	}
+	switch {
+	case hasGt:
+		lower, lowerVal = fmt.Sprintf("%s <= %s", value, gtLit), gtVal
+	case hasGte:
+		lower, lowerVal = fmt.Sprintf("%s < %s", value, gteLit), gteVal
+	}
+
+	switch {
+	case upper == "":
+		return lower, nil
+	case lower == "":
+		return upper, nil
+	case lowerVal <= upperVal:
+		return fmt.Sprintf("%s or %s", lower, upper), nil
+	}
+
+	// exclusive range: the value must fall outside of [upper, lower]
+	inUpper := fmt.Sprintf("%s > %s", value, lteLit)
+	if hasLt {
+		inUpper = fmt.Sprintf("%s >= %s", value, ltLit)
+	}
+	inLower := fmt.Sprintf("%s < %s", value, gteLit)
+	if hasGt {
+		inLower = fmt.Sprintf("%s <= %s", value, gtLit)
+	}
+	return fmt.Sprintf("%s and %s", inUpper, inLower), nil
+}
+
+// bound resolves an optional rule bound into its literal and a numeric value
+// used to order the bounds of a range.
+func (fns pyFuncs) bound(ctx shared.RuleContext, v interface{}) (lit string, val float64, ok bool, err error) {
+	switch b := v.(type) {
+	case *durationpb.Duration:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsDuration()), true, nil
+	case *timestamppb.Timestamp:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsTime().UnixNano()), true, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Ptr {
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	if rv.IsNil() {
+		return
+	}
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Int32, reflect.Int64:
+		val = float64(rv.Int())
+	case reflect.Uint32, reflect.Uint64:
+		val = float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		val = rv.Float()
+	default:
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	return fns.numLit(ctx, rv.Interface()), val, true, nil
+}
+
+// unwrap returns the context of the value of a wrapper. Repeated items and map
+// values keep their name, which reports violations under their index or key.
+func (fns pyFuncs) unwrap(ctx shared.RuleContext) (shared.RuleContext, error) {
+	name := "wrapper"
+	if fns.fieldPath(ctx) == "" {
+		name = ctx.AccessorOverride
+	}
+	return ctx.Unwrap(name + ".value")
+}
+
+func (fns pyFuncs) renderConstants(tpl *template.Template) func(ctx shared.RuleContext) (string, error) {
+	return func(ctx shared.RuleContext) (string, error) {
+		var b bytes.Buffer
+		var err error
+
+		if t := tpl.Lookup(ctx.Typ + "Const"); t != nil {
+			err = t.Execute(&b, ctx)
+		}
+
+		return b.String(), err
+	}
+}
+
+// fail renders the report of the named rule of ctx failing with e. Nested item,
+// key and value rules without their own Error fall back to the Error of the
+// enclosing repeated or map rule at ctx.ErrIndex.
+func (fns pyFuncs) fail(ctx shared.RuleContext, rule string, e *validate.Error) (string, error) {
+	if e == nil && ctx.AccessorOverride != "" {
+		e = parentError(ctx)
+	}
+	args, err := shared.FieldErrorArgs(ctx.Field, e)
+	if err != nil {
+		return "", err
+	}
+	value := fns.accessor(ctx)
+	if rule == "required" {
+		value = "None"
+	}
+	spec, err := fns.errorSpec(ctx.ErrBase, e, args, value)
+	if err != nil {
+		return "", err
+	}
+	typ := ctx.Typ
+	if typ == "wrapper" {
+		typ = "message"
+	}
+	return fmt.Sprintf("v.fail(%q, %q, %s, %s)", fns.fieldPath(ctx), typ+"."+rule, value, spec), nil
+}
+
+func (fns pyFuncs) failOneOf(msg pgs.Message, oo pgs.OneOf) (string, error) {
+	base, err := errorBase(msg)
+	if err != nil {
+		return "", err
+	}
+	rule, err := shared.OneOfRule(oo)
+	if err != nil {
+		return "", err
+	}
+	args, err := shared.ErrorArgs(oo.Name().String(), rule.ProtoReflect(), rule.GetError())
+	if err != nil {
+		return "", err
+	}
+	spec, err := fns.errorSpec(base, rule.GetError(), args, "None")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("v.fail(%q, \"oneof.required\", None, %s)", oo.Name(), spec), nil
+}
+
+// errorSpec renders the pgv.ErrorSpec of e; value is the expression of the
+// validated value.
+func (fns pyFuncs) errorSpec(base *validate.ErrorBase, e *validate.Error, args []shared.ErrorArg, value string) (string, error) {
+	pkg, class := resolveErrorTarget(base, e)
+
+	params := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg.Kind {
+		case shared.IntArg:
+			params = append(params, strconv.FormatInt(arg.Int, 10))
+		case shared.UintArg:
+			params = append(params, strconv.FormatUint(arg.Uint, 10))
+		case shared.FloatArg:
+			params = append(params, floatLit(arg.Float))
+		case shared.BoolArg:
+			params = append(params, boolLit(arg.Bool))
+		case shared.RefArg:
+			params = append(params, fmt.Sprintf("pgv.ref(%s)", fns.lit(arg.Str)))
+		case shared.ValueArg:
+			if value == "None" {
+				return "", fmt.Errorf("error %q: $value is not available here", e.GetMethod())
+			}
+			params = append(params, value)
+		default:
+			params = append(params, fns.lit(arg.Str))
+		}
+	}
+	tuple := strings.Join(params, ", ")
+	if len(params) == 1 {
+		tuple += ","
+	}
+	return fmt.Sprintf("pgv.ErrorSpec(%s, %s, %s, (%s))",
+		fns.lit(pkg), fns.lit(class), fns.lit(e.GetMethod()), tuple), nil
+}
+
+type pyImport struct {
+	Module string
+	Alias  string
+}
+
+// Statement renders the import, as `from a.b import c as alias` for modules
+// within packages.
+func (i pyImport) Statement() string {
+	pkg, name := "", i.Module
+	if idx := strings.LastIndex(i.Module, "."); idx >= 0 {
+		pkg, name = i.Module[:idx], i.Module[idx+1:]
+	}
+	switch {
+	case pkg == "":
+		return fmt.Sprintf("import %s as %s", name, i.Alias)
+	case name == i.Alias:
+		return fmt.Sprintf("from %s import %s", pkg, name)
+	default:
+		return fmt.Sprintf("from %s import %s as %s", pkg, name, i.Alias)
+	}
+}
+
+// imports returns the imports of the validators of f besides the runtime: the
+// modules of the enums checked by defined_only and the validators of messages
+// of other files.
+func (fns pyFuncs) imports(f pgs.File) []pyImport {
+	modules := map[pgs.FilePath]bool{}
+	for _, msg := range f.AllMessages() {
+		ignored, _ := shared.Ignored(msg)
+		if ignored {
+			continue
+		}
+
+		for _, fld := range msg.Fields() {
+			t := fld.Type()
+			switch {
+			case t.IsEnum():
+				var rules validate.FieldRules
+				if _, err := fld.Extension(validate.E_Rules, &rules); err == nil && rules.GetEnum().GetDefinedOnly() {
+					modules[moduleName(t.Enum().File(), "_pb2")] = true
+				}
+			case (t.IsRepeated() || t.IsMap()) && t.Element().IsEnum():
+				for _, en := range definedOnlyElems(fld) {
+					modules[moduleName(en.File(), "_pb2")] = true
+				}
+			}
+
+			var embed pgs.Message
+			switch {
+			case t.IsEmbed():
+				embed = t.Embed()
+			case (t.IsRepeated() || t.IsMap()) && t.Element().IsEmbed():
+				embed = t.Element().Embed()
+			}
+			if embed != nil && embed.File().Name() != f.Name() && fns.validated(embed) {
+				modules[moduleName(embed.File(), "_pb2_validate")] = true
+			}
+		}
+	}
+
+	out := make([]pyImport, 0, len(modules))
+	for m := range modules {
+		out = append(out, pyImport{Module: strings.ReplaceAll(m.String(), "/", "."), Alias: moduleAlias(m)})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Module < out[j].Module })
+	return out
+}
+
+// usesPatterns reports if any rule of f has a pattern, which needs re.
+func usesPatterns(f pgs.File) bool {
+	for _, msg := range f.AllMessages() {
+		for _, fld := range msg.Fields() {
+			var rules validate.FieldRules
+			if ok, err := fld.Extension(validate.E_Rules, &rules); ok && err == nil && hasPattern(rules.ProtoReflect()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasPattern(m protoreflect.Message) bool {
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Name() == "pattern":
+			found = true
+		case fd.Message() == nil || fd.IsMap():
+		case fd.IsList():
+			for i := 0; i < v.List().Len() && !found; i++ {
+				found = hasPattern(v.List().Get(i).Message())
+			}
+		default:
+			found = hasPattern(v.Message())
+		}
+		return !found
+	})
+	return found
+}
+
+// definedOnlyElems returns the enum of the repeated items or map values of f,
+// if their rules include defined_only.
+func definedOnlyElems(f pgs.Field) []pgs.Enum {
+	var rules validate.FieldRules
+	if _, err := f.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	var elem *validate.FieldRules
+	for _, r := range rules.GetRepeated().GetRules() {
+		if r.GetItems() != nil {
+			elem = r.GetItems()
+		}
+	}
+	for _, r := range rules.GetMap().GetRules() {
+		if r.GetValues() != nil {
+			elem = r.GetValues()
+		}
+	}
+	if elem.GetEnum().GetDefinedOnly() {
+		return []pgs.Enum{f.Type().Element().Enum()}
+	}
+	return nil
+}
+
+func hasItems(rules *validate.RepeatedRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetItems() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasValues(rules *validate.MapRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetValues() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveErrorTarget(base *validate.ErrorBase, e *validate.Error) (pkg, class string) {
+	if base != nil {
+		pkg = base.GetPkg()
+		class = base.GetClass()
+	}
+	if len(e.GetPkg()) > 0 {
+		pkg = e.GetPkg()
+	}
+	if len(e.GetClass()) > 0 {
+		class = e.GetClass()
+	}
+	return
+}
+
+func errorBase(msg pgs.Message) (base *validate.ErrorBase, err error) {
+	_, err = msg.Extension(validate.E_ErrorBase, &base)
+	return
+}
+
+func parentError(ctx shared.RuleContext) *validate.Error {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	switch {
+	case rules.GetRepeated() != nil:
+		if rs := rules.GetRepeated().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	case rules.GetMap() != nil:
+		if rs := rules.GetMap().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package python
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestNest(t *testing.T) {
+	fns := pyFuncs{}
+	got := fns.nest(1, "\n    if item < 0:\n        v.fail()")
+	if want := "\n        if item < 0:\n            v.fail()"; got != want {
+		t.Errorf("nest = %q; want %q", got, want)
+	}
+	got = fns.nest(1, "    # no validation rules for x")
+	if want := "        # no validation rules for x\n        pass"; got != want {
+		t.Errorf("nest = %q; want %q", got, want)
+	}
+}
+
+func TestBytesLit(t *testing.T) {
+	if got, want := (pyFuncs{}).bytesLit([]byte("a\"\\\x00é")), `b"a\"\\\x00\xc3\xa9"`; got != want {
+		t.Errorf("bytesLit = %s; want %s", got, want)
+	}
+}
+
+func TestCodeFormat(t *testing.T) {
+	in := `# header
+import re
+
+_X = re.compile("a")
+
+def check_x(m, v):
+    """Doc."""
+
+    if m.a == "":
+        pass
+
+    elif len(m.a) > 1:
+        v.fail()
+
+
+    for item in v.each("b", m.b):
+
+        check_y(item, v)
+def validate_x(m):
+    pass
+`
+	want := `# header
+import re
+
+_X = re.compile("a")
+
+
+def check_x(m, v):
+    """Doc."""
+    if m.a == "":
+        pass
+    elif len(m.a) > 1:
+        v.fail()
+
+    for item in v.each("b", m.b):
+        check_y(item, v)
+
+
+def validate_x(m):
+    pass
+`
+	var out bytes.Buffer
+	if err := CodeFormat(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("CodeFormat:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,35 @@
+package python
+
+const repeatedConstTpl = `{{ renderConstants (.Elem "" "") }}`
+
+const repeatedTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{ $kw := "if" }}
+{{- if $r.GetIgnoreEmpty }}
+    if {{ emptyCond $ctx }}:
+        pass
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MinItems }}
+    {{ $kw }} len({{ $val }}) < {{ $r.GetMinItems }}:
+        {{ fail $ctx "min_items" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MaxItems }}
+    {{ $kw }} len({{ $val }}) > {{ $r.GetMaxItems }}:
+        {{ fail $ctx "max_items" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetUnique }}
+    {{ $kw }} not pgv.unique({{ $val }}):
+        {{ fail $ctx "unique" $r.GetError }}
+{{- end }}
+{{- if $r.GetItems }}
+    for item in v.each({{ lit (fieldPath $ctx) }}, {{ $val }}):
+{{ nest 1 (render ($ctx.ElemWithErrIndex "item" "" $index)) }}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasItems .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+    for item in v.each({{ lit (fieldPath .) }}, {{ $val }}):
+        {{ checkRef . }}(item, v)
+{{- end }}
+`
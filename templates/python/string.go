@@ -0,0 +1,150 @@
+package python
+
+const stringConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+{{ constantName $ctx $index "InLookup" }} = frozenset([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.NotIn }}
+{{ constantName $ctx $index "NotInLookup" }} = frozenset([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+])
+{{- end }}
+{{- if $r.Pattern }}
+{{ constantName $ctx $index "Pattern" }} = re.compile({{ lit $r.GetPattern }})
+{{- end }}
+{{- end }}
+`
+
+const stringTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{ $kw := "if" }}
+{{- if $r.GetIgnoreEmpty }}
+    if {{ emptyCond $ctx }}:
+        pass
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Const }}
+    {{ $kw }} {{ $val }} != {{ lit $r.GetConst }}:
+        {{ fail $ctx "const" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.In }}
+    {{ $kw }} {{ $val }} not in {{ constantName $ctx $index "InLookup" }}:
+        {{ fail $ctx "in" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.NotIn }}
+    {{ $kw }} {{ $val }} in {{ constantName $ctx $index "NotInLookup" }}:
+        {{ fail $ctx "not_in" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Len }}
+    {{ $kw }} len({{ $val }}) != {{ $r.GetLen }}:
+        {{ fail $ctx "len" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MinLen }}
+    {{ $kw }} len({{ $val }}) < {{ $r.GetMinLen }}:
+        {{ fail $ctx "min_len" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MaxLen }}
+    {{ $kw }} len({{ $val }}) > {{ $r.GetMaxLen }}:
+        {{ fail $ctx "max_len" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.LenBytes }}
+    {{ $kw }} pgv.byte_len({{ $val }}) != {{ $r.GetLenBytes }}:
+        {{ fail $ctx "len_bytes" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MinBytes }}
+    {{ $kw }} pgv.byte_len({{ $val }}) < {{ $r.GetMinBytes }}:
+        {{ fail $ctx "min_bytes" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.MaxBytes }}
+    {{ $kw }} pgv.byte_len({{ $val }}) > {{ $r.GetMaxBytes }}:
+        {{ fail $ctx "max_bytes" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Pattern }}
+    {{ $kw }} not {{ constantName $ctx $index "Pattern" }}.search({{ $val }}):
+        {{ fail $ctx "pattern" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Prefix }}
+    {{ $kw }} not {{ $val }}.startswith({{ lit $r.GetPrefix }}):
+        {{ fail $ctx "prefix" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Suffix }}
+    {{ $kw }} not {{ $val }}.endswith({{ lit $r.GetSuffix }}):
+        {{ fail $ctx "suffix" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.Contains }}
+    {{ $kw }} {{ lit $r.GetContains }} not in {{ $val }}:
+        {{ fail $ctx "contains" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.NotContains }}
+    {{ $kw }} {{ lit $r.GetNotContains }} in {{ $val }}:
+        {{ fail $ctx "not_contains" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetEmail }}
+    {{ $kw }} not pgv.is_email({{ $val }}):
+        {{ fail $ctx "email" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetAddress }}
+    {{ $kw }} not pgv.is_address({{ $val }}):
+        {{ fail $ctx "address" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetHostname }}
+    {{ $kw }} not pgv.is_hostname({{ $val }}):
+        {{ fail $ctx "hostname" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetIp }}
+    {{ $kw }} not pgv.is_ip({{ $val }}):
+        {{ fail $ctx "ip" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetIpv4 }}
+    {{ $kw }} not pgv.is_ipv4({{ $val }}):
+        {{ fail $ctx "ipv4" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetIpv6 }}
+    {{ $kw }} not pgv.is_ipv6({{ $val }}):
+        {{ fail $ctx "ipv6" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetUri }}
+    {{ $kw }} not pgv.is_uri({{ $val }}):
+        {{ fail $ctx "uri" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetUriRef }}
+    {{ $kw }} not pgv.is_uri_ref({{ $val }}):
+        {{ fail $ctx "uri_ref" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if $r.GetUuid }}
+    {{ $kw }} not pgv.is_uuid({{ $val }}):
+        {{ fail $ctx "uuid" $r.GetError }}
+	{{- $kw = "elif" }}
+{{- end }}
+{{- if eq $r.GetWellKnownRegex 1 }}
+    {{ $kw }} not pgv.is_http_header_name({{ $val }}, {{ if $r.GetStrict }}True{{ else }}False{{ end }}):
+        {{ fail $ctx "well_known_regex" $r.GetError }}
+{{- else if eq $r.GetWellKnownRegex 2 }}
+    {{ $kw }} not pgv.is_http_header_value({{ $val }}, {{ if $r.GetStrict }}True{{ else }}False{{ end }}):
+        {{ fail $ctx "well_known_regex" $r.GetError }}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,35 @@
+package python
+
+const timestampTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if $r.GetRequired }}
+    if not {{ has $ctx }}:
+        {{ fail $ctx "required" $r.GetError }}
+    else:
+	{{- else }}
+    if {{ has $ctx }}:
+	{{- end }}
+        ts = {{ accessor $ctx }}
+        if not pgv.is_valid_timestamp(ts):
+            {{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+        elif pgv.nanos(ts) != {{ nanosLit $r.GetConst }}:
+            {{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond $ctx "pgv.nanos(ts)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+        elif {{ . }}:
+            {{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.GetLtNow }}
+        elif pgv.nanos(ts) >= pgv.now_nanos():
+            {{ fail $ctx "lt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.GetGtNow }}
+        elif pgv.nanos(ts) <= pgv.now_nanos():
+            {{ fail $ctx "gt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.Within }}
+        elif not pgv.is_within_now(ts, {{ nanosLit $r.GetWithin }}):
+            {{ fail $ctx "within" $r.GetError }}
+		{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,40 @@
+package rust
+
+const anyConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "IN" }}: &[&str] = &[{{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }}];
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NOT_IN" }}: &[&str] = &[{{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }}];
+{{- end }}
+{{- end }}
+`
+
+const anyTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if or $r.In $r.NotIn }}
+	{{ if present $ctx }}if let Some(a) = &{{ accessor $ctx }} {{ "{" }}{{ else }}{{ "{" }}
+		let a = {{ accessor $ctx }};{{ end }}
+		{{- $else := "" }}
+		{{- if $r.In }}
+		{{ $else }}if !{{ constantName $ctx $index "IN" }}.contains(&a.type_url.as_str()) {
+			{{ fail $ctx "in" $r.GetError }}
+		{{- $else = "} else " }}
+		{{- end }}
+		{{- if $r.NotIn }}
+		{{ $else }}if {{ constantName $ctx $index "NOT_IN" }}.contains(&a.type_url.as_str()) {
+			{{ fail $ctx "not_in" $r.GetError }}
+		{{- $else = "} else " }}
+		{{- end }}
+		}
+	}
+	{{- if and $r.GetRequired (present $ctx) }} else {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- else if and $r.GetRequired (present $ctx) }}
+	if {{ accessor $ctx }}.is_none() {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+{{ end -}}
+`
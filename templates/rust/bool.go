@@ -0,0 +1,8 @@
+package rust
+
+const boolTpl = `{{ $r := .Rules -}}
+{{- if $r.Const }}
+	if {{ if $r.GetConst }}!{{ end }}{{ val . }} {
+		{{ fail . "const" $r.GetError }}
+	}
+{{- end }}`
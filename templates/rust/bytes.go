@@ -0,0 +1,93 @@
+package rust
+
+const bytesConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "IN" }}: &[&[u8]] = &[{{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end }}];
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NOT_IN" }}: &[&[u8]] = &[{{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end }}];
+{{- end }}
+{{- if $r.Pattern }}
+static {{ constantName $ctx $index "PATTERN" }}: ::pgv::Pattern = ::pgv::Pattern::new({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const bytesTpl = `{{ $ctx := . }}{{ $val := val . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if {{ emptyCond $ctx }} {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if {{ $val }} != {{ bytesLit $r.GetConst }}[..] {
+		{{ fail $ctx "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if !{{ constantName $ctx $index "IN" }}.contains(&&{{ $val }}) {
+		{{ fail $ctx "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if {{ constantName $ctx $index "NOT_IN" }}.contains(&&{{ $val }}) {
+		{{ fail $ctx "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Len }}
+	{{ $else }}if {{ $val }}.len() != {{ $r.GetLen }} {
+		{{ fail $ctx "len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	{{ $else }}if {{ $val }}.len() < {{ $r.GetMinLen }} {
+		{{ fail $ctx "min_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	{{ $else }}if {{ $val }}.len() > {{ $r.GetMaxLen }} {
+		{{ fail $ctx "max_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	{{ $else }}if !{{ constantName $ctx $index "PATTERN" }}.is_match_bytes(&{{ $val }}) {
+		{{ fail $ctx "pattern" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	{{ $else }}if !{{ $val }}.starts_with({{ bytesLit $r.GetPrefix }}) {
+		{{ fail $ctx "prefix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	{{ $else }}if !{{ $val }}.ends_with({{ bytesLit $r.GetSuffix }}) {
+		{{ fail $ctx "suffix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Contains }}
+	{{ $else }}if !::pgv::bytes::contains(&{{ $val }}, {{ bytesLit $r.GetContains }}) {
+		{{ fail $ctx "contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	{{ $else }}if {{ $val }}.len() != 4 && {{ $val }}.len() != 16 {
+		{{ fail $ctx "ip" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	{{ $else }}if {{ $val }}.len() != 4 {
+		{{ fail $ctx "ipv4" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	{{ $else }}if {{ $val }}.len() != 16 {
+		{{ fail $ctx "ipv6" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,41 @@
+package rust
+
+const durationConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "IN" }}: &[i128] = &[{{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end }}];
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NOT_IN" }}: &[i128] = &[{{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end }}];
+{{- end }}
+{{- end }}
+`
+
+const durationTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{ if present $ctx }}if let Some(d) = &{{ accessor $ctx }} {{ "{" }}{{ else }}{{ "{" }}
+		let d = {{ accessor $ctx }};{{ end }}
+		if !::pgv::times::is_valid_duration(d) {
+			{{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+		} else if ::pgv::times::duration_nanos(d) != {{ nanosLit $r.GetConst }} {
+			{{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond "::pgv::times::duration_nanos(d)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		} else if {{ . }} {
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.In }}
+		} else if !{{ constantName $ctx $index "IN" }}.contains(&::pgv::times::duration_nanos(d)) {
+			{{ fail $ctx "in" $r.GetError }}
+		{{- end }}
+		{{- if $r.NotIn }}
+		} else if {{ constantName $ctx $index "NOT_IN" }}.contains(&::pgv::times::duration_nanos(d)) {
+			{{ fail $ctx "not_in" $r.GetError }}
+		{{- end }}
+		}
+	}
+	{{- if and $r.GetRequired (present $ctx) }} else {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+{{ end -}}
+`
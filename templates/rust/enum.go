@@ -0,0 +1,37 @@
+package rust
+
+const enumConstTpl = `{{ $r := .Rules -}}
+{{- if $r.In }}
+const {{ constantName . 0 "IN" }}: &[i32] = &[{{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ $v }}{{ end }}];
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName . 0 "NOT_IN" }}: &[i32] = &[{{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ $v }}{{ end }}];
+{{- end }}
+`
+
+const enumTpl = `{{ $r := .Rules }}{{ $val := val . }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if {{ $val }} != {{ $r.GetConst }} {
+		{{ fail . "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetDefinedOnly }}
+	{{ $else }}if <{{ enumPath . }} as ::core::convert::TryFrom<i32>>::try_from({{ $val }}).is_err() {
+		{{ fail . "defined_only" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if !{{ constantName . 0 "IN" }}.contains(&{{ $val }}) {
+		{{ fail . "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if {{ constantName . 0 "NOT_IN" }}.contains(&{{ $val }}) {
+		{{ fail . "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+`
@@ -0,0 +1,8 @@
+package rust
+
+const fileTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+{{ range .Messages }}
+	{{- template "msg" . }}
+{{ end }}
+`
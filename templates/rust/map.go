@@ -0,0 +1,46 @@
+package rust
+
+const mapConstTpl = `{{ renderConstants (.Key "" "Key") }}
+{{- renderConstants (.Elem "" "Value") -}}
+`
+
+const mapTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if {{ emptyCond $ctx }} {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.MinPairs }}
+	{{ $else }}if {{ $val }}.len() < {{ $r.GetMinPairs }} {
+		{{ fail $ctx "min_pairs" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxPairs }}
+	{{ $else }}if {{ $val }}.len() > {{ $r.GetMaxPairs }} {
+		{{ fail $ctx "max_pairs" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+	{{- if $r.GetNoSparse }}
+	// no_sparse always holds: prost maps message values to the messages
+	{{- end }}
+	{{- if or $r.GetKeys $r.GetValues }}
+	v.entries({{ lit (fieldPath $ctx) }}, &{{ $val }}, |v, key, val| {
+		{{- if $r.GetKeys }}
+		{{ render ($ctx.KeyWithErrIndex "key" "Key" $index) }}
+		{{- end }}
+		{{- if $r.GetValues }}
+		{{ render ($ctx.ElemWithErrIndex "val" "Value" $index) }}
+		{{- end }}
+		Ok(())
+	})?;
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasValues .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	v.entries({{ lit (fieldPath .) }}, &{{ $val }}, |v, key, val| v.nested("", val))?;
+{{- end }}
+`
@@ -0,0 +1,38 @@
+package rust
+
+const messageTpl = `{{ $f := .Field }}{{ $r := .Rules }}
+	{{- if .MessageRules.GetSkip }}
+	// skipping validation for {{ $f.Name }}
+	{{- else if present . }}
+	{{- if validated (embedded .) }}
+	if let Some(m) = &{{ accessor . }} {
+		v.nested({{ lit (fieldPath .) }}, m)?;
+	}
+	{{- if $r.GetRequired }} else {
+		{{ fail . "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- else if $r.GetRequired }}
+	if {{ accessor . }}.is_none() {
+		{{ fail . "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- else if validated (embedded .) }}
+	v.nested({{ lit (fieldPath .) }}, {{ accessor . }})?;
+	{{- end -}}
+`
+
+const wrapperConstTpl = `{{ renderConstants (unwrap .) }}`
+
+const wrapperTpl = `{{ if present . }}
+	if let Some(value) = &{{ accessor . }} {
+		{{ render (unwrap .) }}
+	}
+	{{- if .MessageRules.GetRequired }} else {
+		{{ fail . "required" .MessageRules.GetError }}
+	}
+	{{- end }}
+{{- else }}
+	{{ render (unwrap .) }}
+{{- end }}
+`
@@ -0,0 +1,48 @@
+package rust
+
+const msgTpl = `
+{{ if not (ignored .) -}}
+{{ $ctx := . -}}
+impl ::pgv::Validate for {{ typeName . }} {
+	type Error = {{ errorType }};
+
+	fn error(info: &::pgv::ErrorInfo<'_>) -> Self::Error {
+		{{ errorFn }}(info)
+	}
+
+	#[allow(unused_variables, clippy::all)]
+	fn check(&self, v: &mut ::pgv::Violations<Self::Error>) -> ::pgv::Checked {
+		{{- if disabled . }}
+		// validation is disabled for {{ .Name }}
+		{{- else }}
+		{{- range validatedFields . }}
+		{{ renderConstants (context $ctx .) }}
+		{{- end }}
+		{{ template "oneOfConst" . }}
+		{{- range validatedFields . }}
+		{{ template "field" (context $ctx .) }}
+		{{- end }}
+		{{- template "oneOf" . }}
+		{{- end }}
+		Ok(())
+	}
+}
+{{- end }}
+{{- range .Messages }}
+{{ template "msg" . }}
+{{- end -}}
+`
+
+// fieldTpl gates the rules of a scalar field with explicit presence on it
+// being set, and reports its required rule otherwise.
+const fieldTpl = `{{ with hasCond . -}}
+	if let Some(value) = &{{ . }} {
+		{{ render (bind $ "value") }}
+	}
+	{{- with failRequired $ }} else {
+		{{ . }}
+	}
+	{{- end }}
+{{- else -}}
+	{{ render . }}
+{{- end }}`
@@ -0,0 +1,4 @@
+package rust
+
+const noneTpl = `// no validation rules for {{ .Field.Name }}
+`
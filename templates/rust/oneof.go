@@ -0,0 +1,24 @@
+package rust
+
+const oneOfConstTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+{{- range .Fields }}{{ renderConstants (context $msg .) }}{{ end -}}
+{{- end -}}
+`
+
+const oneOfTpl = `{{ $msg := . }}{{ range .RealOneOfs }}{{ $path := oneofPath . }}
+	match &self.{{ oneofField . }} {
+	{{- range .Fields }}
+		Some({{ $path }}::{{ oneofVariant . }}(value)) => {
+			{{ render (bind (context $msg .) "value") }}
+		}
+	{{- end }}
+	{{- if (oneofRule .).GetRequired }}
+		None => {
+			{{ failOneOf $msg . }}
+		}
+	{{- else }}
+		None => {}
+	{{- end }}
+	}
+{{- end -}}
+`
@@ -0,0 +1,923 @@
+package rust
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+const (
+	// ErrorTypeParam names the error type of the generated implementations of
+	// pgv::Validate, pgv::ValidationError by default.
+	ErrorTypeParam = "rust_error_type"
+	// ErrorParam is the path of the function building the errors of failed
+	// rules from their &pgv::ErrorInfo, which is where the factory methods
+	// named by validate.Error are mapped onto rust_error_type.
+	ErrorParam = "rust_error"
+
+	defaultErrorType = "::pgv::ValidationError"
+	defaultError     = "::pgv::ValidationError::new"
+)
+
+// Register registers the templates of the implementations of pgv::Validate for
+// the message types prost generates, see rust/pgv-rust-stub for their runtime.
+func Register(tpl *template.Template, params pgs.Parameters) {
+	fns := rustFuncs{
+		errorType: params.StrDefault(ErrorTypeParam, defaultErrorType),
+		errorFn:   params.StrDefault(ErrorParam, defaultError),
+	}
+
+	tpl.Funcs(map[string]interface{}{
+		"accessor":        fns.accessor,
+		"bind":            fns.bind,
+		"boundsRule":      shared.BoundsRule,
+		"bytesLit":        fns.bytesLit,
+		"constantName":    fns.constantName,
+		"embedded":        fns.embedded,
+		"emptyCond":       fns.emptyCond,
+		"enumPath":        fns.enumPath,
+		"errorFn":         func() string { return fns.errorFn },
+		"errorType":       func() string { return fns.errorType },
+		"fail":            fns.fail,
+		"failOneOf":       fns.failOneOf,
+		"failRequired":    fns.failRequired,
+		"fieldPath":       fns.fieldPath,
+		"hasCond":         fns.hasCond,
+		"hasItems":        hasItems,
+		"hasValues":       hasValues,
+		"lit":             fns.lit,
+		"nanosLit":        fns.nanosLit,
+		"numLit":          fns.numLit,
+		"oneofField":      fns.oneofField,
+		"oneofPath":       fns.oneofPath,
+		"oneofVariant":    fns.oneofVariant,
+		"present":         fns.present,
+		"rangeCond":       fns.rangeCond,
+		"ref":             fns.ref,
+		"renderConstants": fns.renderConstants(tpl),
+		"setType":         fns.setType,
+		"typeName":        fns.typeName,
+		"unwrap":          fns.unwrap,
+		"val":             fns.val,
+		"validated":       fns.validated,
+		"validatedFields": fns.validatedFields,
+	})
+
+	template.Must(tpl.Parse(fileTpl))
+	template.Must(tpl.New("msg").Parse(msgTpl))
+	template.Must(tpl.New("field").Parse(fieldTpl))
+
+	template.Must(tpl.New("none").Parse(noneTpl))
+
+	template.Must(tpl.New("float").Parse(numTpl))
+	template.Must(tpl.New("floatConst").Parse(numConstTpl))
+	template.Must(tpl.New("double").Parse(numTpl))
+	template.Must(tpl.New("doubleConst").Parse(numConstTpl))
+	template.Must(tpl.New("int32").Parse(numTpl))
+	template.Must(tpl.New("int32Const").Parse(numConstTpl))
+	template.Must(tpl.New("int64").Parse(numTpl))
+	template.Must(tpl.New("int64Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint32").Parse(numTpl))
+	template.Must(tpl.New("uint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint64").Parse(numTpl))
+	template.Must(tpl.New("uint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint32").Parse(numTpl))
+	template.Must(tpl.New("sint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint64").Parse(numTpl))
+	template.Must(tpl.New("sint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed32").Parse(numTpl))
+	template.Must(tpl.New("fixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed64").Parse(numTpl))
+	template.Must(tpl.New("fixed64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed32").Parse(numTpl))
+	template.Must(tpl.New("sfixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed64").Parse(numTpl))
+	template.Must(tpl.New("sfixed64Const").Parse(numConstTpl))
+
+	template.Must(tpl.New("bool").Parse(boolTpl))
+	template.Must(tpl.New("string").Parse(stringTpl))
+	template.Must(tpl.New("stringConst").Parse(stringConstTpl))
+	template.Must(tpl.New("bytes").Parse(bytesTpl))
+	template.Must(tpl.New("bytesConst").Parse(bytesConstTpl))
+
+	template.Must(tpl.New("any").Parse(anyTpl))
+	template.Must(tpl.New("anyConst").Parse(anyConstTpl))
+	template.Must(tpl.New("enum").Parse(enumTpl))
+	template.Must(tpl.New("enumConst").Parse(enumConstTpl))
+	template.Must(tpl.New("message").Parse(messageTpl))
+	template.Must(tpl.New("repeated").Parse(repeatedTpl))
+	template.Must(tpl.New("repeatedConst").Parse(repeatedConstTpl))
+	template.Must(tpl.New("map").Parse(mapTpl))
+	template.Must(tpl.New("mapConst").Parse(mapConstTpl))
+	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
+	template.Must(tpl.New("oneOfConst").Parse(oneOfConstTpl))
+
+	template.Must(tpl.New("timestamp").Parse(timestampTpl))
+	template.Must(tpl.New("duration").Parse(durationTpl))
+	template.Must(tpl.New("durationConst").Parse(durationConstTpl))
+	template.Must(tpl.New("wrapper").Parse(wrapperTpl))
+	template.Must(tpl.New("wrapperConst").Parse(wrapperConstTpl))
+}
+
+// rustFuncs renders validators against the message types prost generates:
+// message, wrapper and well-known fields are Options, as are scalar fields
+// with explicit presence, maps are HashMaps and oneofs are Options of an enum
+// in the module of their message.
+//
+// Within the templates the accessor of a field is a place, self.name, and
+// the one of repeated items, map entries and Some bindings a reference.
+type rustFuncs struct {
+	errorType string
+	errorFn   string
+}
+
+// CodeFormat re-indents the rendered validators by their braces with four
+// spaces, like rustfmt. Blank lines are collapsed, and only kept between items
+// and the members of impl blocks.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(src), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	var buf bytes.Buffer
+	// blocks records for each open brace whether it opens an impl block.
+	var blocks []bool
+	inCode := func() bool {
+		for _, impl := range blocks {
+			if !impl {
+				return true
+			}
+		}
+		return false
+	}
+	prev := ""
+	for i, line := range lines {
+		if line == "" {
+			next := ""
+			for _, l := range lines[i+1:] {
+				if l != "" {
+					next = l
+					break
+				}
+			}
+			if prev == "" || next == "" || inCode() || strings.HasSuffix(prev, "{") || strings.HasPrefix(next, "}") {
+				continue
+			}
+			buf.WriteByte('\n')
+			prev = ""
+			continue
+		}
+
+		ops := braces(line)
+		for len(ops) > 0 && ops[0] == '}' {
+			if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			ops = ops[1:]
+		}
+
+		buf.WriteString(strings.Repeat("    ", len(blocks)))
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+
+		for _, op := range ops {
+			if op == '{' {
+				blocks = append(blocks, strings.HasPrefix(line, "impl "))
+			} else if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+		}
+		prev = line
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// braces returns the braces of a line outside of comments and string
+// literals, in order. Quotes don't delimit anything else: the templates render
+// no character literals, and lifetimes are unbalanced.
+func braces(line string) []rune {
+	var out []rune
+	inString := false
+	escaped := false
+	prev := rune(0)
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			if r == '\\' {
+				escaped = true
+			} else if r == '"' {
+				inString = false
+			}
+		case r == '/' && prev == '/':
+			return out
+		case r == '"':
+			inString = true
+		case r == '{' || r == '}':
+			out = append(out, r)
+		}
+		prev = r
+	}
+	return out
+}
+
+// FilePath places the validators of a proto file in <file>.validate.rs, to be
+// included in the module of its package next to the code of prost.
+func FilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	// Don't generate validators for files that don't import PGV
+	if !importsPgv(f) {
+		return nil
+	}
+	out := f.InputPath().SetExt(".validate." + tpl.Name())
+	return &out
+}
+
+func importsPgv(f pgs.File) bool {
+	for _, dep := range f.Descriptor().Dependency {
+		if strings.HasSuffix(dep, "validate.proto") {
+			return true
+		}
+	}
+	return false
+}
+
+// words splits a proto name into words the way the heck crate does for prost:
+// at non-alphanumeric characters, before an uppercase letter following a
+// lowercase one, and before the last of a run of uppercase letters followed by
+// a lowercase one. Digits belong to the word they follow.
+func words(name string) []string {
+	var out []string
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		rs := []rune(word)
+		start := 0
+		// mode is the case of the last letter of the current word: 'l', 'u' or
+		// 0 at the start of a word
+		mode := rune(0)
+		for i, r := range rs {
+			if i+1 == len(rs) {
+				out = append(out, string(rs[start:]))
+				break
+			}
+			next := rs[i+1]
+			nextMode := mode
+			if unicode.IsLower(r) {
+				nextMode = 'l'
+			} else if unicode.IsUpper(r) {
+				nextMode = 'u'
+			}
+			switch {
+			case nextMode == 'l' && unicode.IsUpper(next):
+				out = append(out, string(rs[start:i+1]))
+				start, mode = i+1, 0
+			case mode == 'u' && unicode.IsUpper(r) && unicode.IsLower(next):
+				out = append(out, string(rs[start:i]))
+				start, mode = i, 0
+			default:
+				mode = nextMode
+			}
+		}
+	}
+	return out
+}
+
+// keywords are the Rust keywords prost renders as raw identifiers.
+var keywords = map[string]bool{
+	"as": true, "break": true, "const": true, "continue": true, "else": true, "enum": true, "false": true,
+	"fn": true, "for": true, "if": true, "impl": true, "in": true, "let": true, "loop": true, "match": true,
+	"mod": true, "move": true, "mut": true, "pub": true, "ref": true, "return": true, "static": true,
+	"struct": true, "trait": true, "true": true, "type": true, "unsafe": true, "use": true, "where": true,
+	"while": true, "dyn": true, "abstract": true, "become": true, "box": true, "do": true, "final": true,
+	"macro": true, "override": true, "priv": true, "typeof": true, "unsized": true, "virtual": true,
+	"yield": true, "async": true, "await": true, "try": true,
+}
+
+// snakeCase converts a proto name into the identifier of a field or module
+// the way prost does.
+func snakeCase(name string) string {
+	ws := words(name)
+	for i, w := range ws {
+		ws[i] = strings.ToLower(w)
+	}
+	ident := strings.Join(ws, "_")
+	switch {
+	case keywords[ident]:
+		return "r#" + ident
+	case ident == "self" || ident == "super" || ident == "extern" || ident == "crate":
+		return ident + "_"
+	}
+	return ident
+}
+
+// upperCamelCase converts a proto name into the identifier of a type or enum
+// variant the way prost does.
+func upperCamelCase(name string) string {
+	var b strings.Builder
+	for _, w := range words(name) {
+		rs := []rune(strings.ToLower(w))
+		rs[0] = unicode.ToUpper(rs[0])
+		b.WriteString(string(rs))
+	}
+	if b.String() == "Self" {
+		return "Self_"
+	}
+	return b.String()
+}
+
+// path returns the path of a message or enum, or of the module of its nested
+// types with module set, relative to the module of the package of from.
+func (fns rustFuncs) path(e pgs.Entity, from pgs.File, module bool) string {
+	var target []string
+	if pkg := e.Package().ProtoName().String(); pkg != "" {
+		for _, seg := range strings.Split(pkg, ".") {
+			target = append(target, snakeCase(seg))
+		}
+	}
+	name := strings.TrimPrefix(e.FullyQualifiedName(), ".")
+	if pkg := e.Package().ProtoName().String(); pkg != "" {
+		name = strings.TrimPrefix(name, pkg+".")
+	}
+	types := strings.Split(name, ".")
+	for _, t := range types[:len(types)-1] {
+		target = append(target, snakeCase(t))
+	}
+	if module {
+		target = append(target, snakeCase(types[len(types)-1]))
+	} else {
+		target = append(target, upperCamelCase(types[len(types)-1]))
+	}
+
+	var local []string
+	if pkg := from.Package().ProtoName().String(); pkg != "" {
+		for _, seg := range strings.Split(pkg, ".") {
+			local = append(local, snakeCase(seg))
+		}
+	}
+	common := 0
+	for common < len(local) && common < len(target)-1 && local[common] == target[common] {
+		common++
+	}
+	var out []string
+	for range local[common:] {
+		out = append(out, "super")
+	}
+	return strings.Join(append(out, target[common:]...), "::")
+}
+
+// typeName returns the path of the type of msg in the module of its package.
+func (fns rustFuncs) typeName(msg pgs.Message) string {
+	return fns.path(msg, msg.File(), false)
+}
+
+// enumPath returns the path of the enum of ctx, resolving repeated items and
+// map values.
+func (fns rustFuncs) enumPath(ctx shared.RuleContext) string {
+	t := ctx.Field.Type()
+	if t.IsRepeated() || t.IsMap() {
+		return fns.path(t.Element().Enum(), ctx.Field.File(), false)
+	}
+	return fns.path(t.Enum(), ctx.Field.File(), false)
+}
+
+// oneofPath returns the path of the enum prost generates for oo.
+func (fns rustFuncs) oneofPath(oo pgs.OneOf) string {
+	return fns.path(oo.Message(), oo.File(), true) + "::" + upperCamelCase(oo.Name().String())
+}
+
+// oneofField returns the name of the field prost generates for oo.
+func (fns rustFuncs) oneofField(oo pgs.OneOf) string {
+	return snakeCase(oo.Name().String())
+}
+
+// oneofVariant returns the variant of the enum of its oneof holding f.
+func (fns rustFuncs) oneofVariant(f pgs.Field) string {
+	return upperCamelCase(f.Name().String())
+}
+
+// validatedFields returns the fields of msg outside of a real oneof, proto3
+// optional fields included.
+func (fns rustFuncs) validatedFields(msg pgs.Message) (out []pgs.Field) {
+	for _, f := range msg.Fields() {
+		if !f.InRealOneOf() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// embedded returns the message type of ctx, resolving repeated items and map
+// values.
+func (fns rustFuncs) embedded(ctx shared.RuleContext) pgs.Message {
+	if t := ctx.Field.Type(); t.IsRepeated() || t.IsMap() {
+		return t.Element().Embed()
+	}
+	return ctx.Field.Type().Embed()
+}
+
+// validated reports if msg implements pgv::Validate: well-known types and
+// messages of files without rules do not.
+func (fns rustFuncs) validated(msg pgs.Message) bool {
+	if msg == nil || msg.IsWellKnown() || !importsPgv(msg.File()) {
+		return false
+	}
+	ignored, err := shared.Ignored(msg)
+	return err == nil && !ignored
+}
+
+func (fns rustFuncs) accessor(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride
+	}
+	return "self." + snakeCase(ctx.Field.Name().String())
+}
+
+// ref renders a reference to the value of ctx.
+func (fns rustFuncs) ref(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride
+	}
+	return "&" + fns.accessor(ctx)
+}
+
+// val renders the value of ctx the rules of its type compare: a &str for
+// strings, a [u8] place for bytes and a copy of anything else.
+func (fns rustFuncs) val(ctx shared.RuleContext) string {
+	switch ctx.Typ {
+	case "string":
+		return fns.accessor(ctx) + ".as_str()"
+	case "bytes":
+		return fns.accessor(ctx) + "[..]"
+	}
+	if ctx.AccessorOverride != "" {
+		return "*" + ctx.AccessorOverride
+	}
+	return fns.accessor(ctx)
+}
+
+// bind returns ctx with its value bound to name by a pattern.
+func (fns rustFuncs) bind(ctx shared.RuleContext, name string) shared.RuleContext {
+	ctx.AccessorOverride = name
+	return ctx
+}
+
+// present renders the Option of the message field of ctx, empty for repeated
+// items, map values and bound values which are set.
+func (fns rustFuncs) present(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ""
+	}
+	return fns.accessor(ctx)
+}
+
+// hasCond renders the Option of the scalar field of ctx with explicit
+// presence, empty for other fields.
+func (fns rustFuncs) hasCond(ctx shared.RuleContext) string {
+	f := ctx.Field
+	if ctx.Typ == "none" || !f.HasPresence() || f.InRealOneOf() {
+		return ""
+	}
+	if t := f.Type(); t.IsEmbed() || t.IsRepeated() || t.IsMap() {
+		return ""
+	}
+	return fns.accessor(ctx)
+}
+
+// failRequired renders the report of the required rule of the scalar field of
+// ctx, empty if it has none.
+func (fns rustFuncs) failRequired(ctx shared.RuleContext) (string, error) {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil || !shared.ScalarRequired(&rules) {
+		return "", err
+	}
+	return fns.fail(ctx, "required", shared.RequiredError(ctx.Rules))
+}
+
+// fieldPath returns the name violations of ctx are reported under, empty for
+// repeated items and map entries which are reported under their index or key.
+func (fns rustFuncs) fieldPath(ctx shared.RuleContext) string {
+	switch strings.SplitN(ctx.AccessorOverride, ".", 2)[0] {
+	case "item", "key", "val":
+		return ""
+	}
+	return ctx.Field.Name().String()
+}
+
+// constantName returns the name of a constant of the check of a message
+// backing the rule at index of ctx.
+func (fns rustFuncs) constantName(ctx shared.RuleContext, index int, rule string) string {
+	name := ctx.Field.Name().String()
+	if ctx.Index != "" {
+		name += "_" + ctx.Index
+	}
+	return strings.ToUpper(fmt.Sprintf("%s_%d_%s", name, index, rule))
+}
+
+// setType returns the element type of the lookup slices of the numbers of
+// ctx.
+func (fns rustFuncs) setType(ctx shared.RuleContext) string {
+	switch ctx.Typ {
+	case "float":
+		return "f32"
+	case "double":
+		return "f64"
+	case "int64", "sint64", "sfixed64":
+		return "i64"
+	case "uint64", "fixed64":
+		return "u64"
+	case "uint32", "fixed32":
+		return "u32"
+	default:
+		return "i32"
+	}
+}
+
+// emptyCond renders the condition under which the value of ctx is set, for
+// ignore_empty.
+func (fns rustFuncs) emptyCond(ctx shared.RuleContext) string {
+	switch ctx.Typ {
+	case "string", "bytes", "repeated", "map":
+		return "!" + fns.accessor(ctx) + ".is_empty()"
+	case "float", "double":
+		return fns.val(ctx) + " != 0.0"
+	default:
+		return fns.val(ctx) + " != 0"
+	}
+}
+
+// lit renders s as a Rust string literal, escaping control characters.
+func (fns rustFuncs) lit(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == 0:
+			b.WriteString(`\0`)
+		case unicode.IsControl(r) || !unicode.IsPrint(r) && r != ' ':
+			fmt.Fprintf(&b, `\u{%x}`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// bytesLit renders b as a Rust byte string literal.
+func (fns rustFuncs) bytesLit(b []byte) string {
+	var out strings.Builder
+	out.WriteString(`b"`)
+	for _, c := range b {
+		switch {
+		case c == '"' || c == '\\':
+			out.WriteByte('\\')
+			out.WriteByte(c)
+		case c >= 0x20 && c < 0x7F:
+			out.WriteByte(c)
+		default:
+			fmt.Fprintf(&out, `\x%02x`, c)
+		}
+	}
+	out.WriteByte('"')
+	return out.String()
+}
+
+// numLit renders a number of a rule as a Rust literal, typed by the value it
+// is compared with.
+func (fns rustFuncs) numLit(v interface{}) string {
+	switch n := v.(type) {
+	case float32:
+		return floatLit(float64(n), 32)
+	case float64:
+		return floatLit(n, 64)
+	case int32:
+		if n == math.MinInt32 {
+			return "i32::MIN"
+		}
+		return strconv.FormatInt(int64(n), 10)
+	case int64:
+		if n == math.MinInt64 {
+			return "i64::MIN"
+		}
+		return strconv.FormatInt(n, 10)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// floatLit renders v as a Rust literal of an f32 (bits 32) or f64.
+func floatLit(v float64, bits int) string {
+	typ := "f64"
+	if bits == 32 {
+		typ = "f32"
+	}
+	switch {
+	case math.IsNaN(v):
+		return typ + "::NAN"
+	case math.IsInf(v, 1):
+		return typ + "::INFINITY"
+	case math.IsInf(v, -1):
+		return typ + "::NEG_INFINITY"
+	}
+	s := strconv.FormatFloat(v, 'g', -1, bits)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// nanosLit renders a duration, or a timestamp as the time since the epoch, in
+// nanoseconds.
+func (fns rustFuncs) nanosLit(v interface{}) string {
+	switch t := v.(type) {
+	case *durationpb.Duration:
+		return strconv.FormatInt(t.AsDuration().Nanoseconds(), 10)
+	case *timestamppb.Timestamp:
+		return strconv.FormatInt(t.GetSeconds()*1e9+int64(t.GetNanos()), 10)
+	}
+	return fmt.Sprint(v)
+}
+
+// rangeCond renders the condition under which value violates the lt/lte/gt/gte
+// bounds of a rule. Like the Java runtime, a lower bound above the upper bound
+// inverts the range into an exclusive one.
+func (fns rustFuncs) rangeCond(value string, lt, lte, gt, gte interface{}) (string, error) {
+	ltLit, ltVal, hasLt, err := fns.bound(lt)
+	if err != nil {
+		return "", err
+	}
+	lteLit, lteVal, hasLte, err := fns.bound(lte)
+	if err != nil {
+		return "", err
+	}
+	gtLit, gtVal, hasGt, err := fns.bound(gt)
+	if err != nil {
+		return "", err
+	}
+	gteLit, gteVal, hasGte, err := fns.bound(gte)
+	if err != nil {
+		return "", err
+	}
+
+	var upper, lower string
+	var upperVal, lowerVal float64
+	switch {
+	case hasLt:
+		upper, upperVal = fmt.Sprintf("%s >= %s", value, ltLit), ltVal
+	case hasLte:
+		upper, upperVal = fmt.Sprintf("%s > %s", value, lteLit), lteVal
+	}
+	switch {
+	case hasGt:
+		lower, lowerVal = fmt.Sprintf("%s <= %s", value, gtLit), gtVal
+	case hasGte:
+		lower, lowerVal = fmt.Sprintf("%s < %s", value, gteLit), gteVal
+	}
+
+	switch {
+	case upper == "":
+		return lower, nil
+	case lower == "":
+		return upper, nil
+	case lowerVal <= upperVal:
+		return fmt.Sprintf("%s || %s", lower, upper), nil
+	}
+
+	// exclusive range: the value must fall outside of [upper, lower]
+	inUpper := fmt.Sprintf("%s > %s", value, lteLit)
+	if hasLt {
+		inUpper = fmt.Sprintf("%s >= %s", value, ltLit)
+	}
+	inLower := fmt.Sprintf("%s < %s", value, gteLit)
+	if hasGt {
+		inLower = fmt.Sprintf("%s <= %s", value, gtLit)
+	}
+	return fmt.Sprintf("%s && %s", inUpper, inLower), nil
+}
+
+// bound resolves an optional rule bound into its Rust literal and a numeric
+// value used to order the bounds of a range.
+func (fns rustFuncs) bound(v interface{}) (lit string, val float64, ok bool, err error) {
+	switch b := v.(type) {
+	case *durationpb.Duration:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsDuration()), true, nil
+	case *timestamppb.Timestamp:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsTime().UnixNano()), true, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Ptr {
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	if rv.IsNil() {
+		return
+	}
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Int32, reflect.Int64:
+		val = float64(rv.Int())
+	case reflect.Uint32, reflect.Uint64:
+		val = float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		val = rv.Float()
+	default:
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	return fns.numLit(rv.Interface()), val, true, nil
+}
+
+// unwrap returns the context of the value of a wrapper. prost maps wrappers to
+// Options of their value, which the wrapper template binds to value, and
+// repeated and map ones to their value.
+func (fns rustFuncs) unwrap(ctx shared.RuleContext) (shared.RuleContext, error) {
+	if fns.present(ctx) != "" {
+		return ctx.Unwrap("value")
+	}
+	return ctx.Unwrap(fns.accessor(ctx))
+}
+
+func (fns rustFuncs) renderConstants(tpl *template.Template) func(ctx shared.RuleContext) (string, error) {
+	return func(ctx shared.RuleContext) (string, error) {
+		var b bytes.Buffer
+		var err error
+
+		if t := tpl.Lookup(ctx.Typ + "Const"); t != nil {
+			err = t.Execute(&b, ctx)
+		}
+
+		return b.String(), err
+	}
+}
+
+// fail renders the report of the named rule of ctx failing with e. Nested
+// item, key and value rules without their own Error fall back to the Error of
+// the enclosing repeated or map rule at ctx.ErrIndex.
+func (fns rustFuncs) fail(ctx shared.RuleContext, rule string, e *validate.Error) (string, error) {
+	if e == nil && ctx.AccessorOverride != "" {
+		e = parentError(ctx)
+	}
+	args, err := shared.FieldErrorArgs(ctx.Field, e)
+	if err != nil {
+		return "", err
+	}
+	value := "::pgv::Param::Value(" + fns.ref(ctx) + ")"
+	if rule == "required" {
+		value = ""
+	}
+	spec, err := fns.errorSpec(ctx.ErrBase, e, args, value)
+	if err != nil {
+		return "", err
+	}
+	if value == "" {
+		value = "::pgv::Param::None"
+	}
+	typ := ctx.Typ
+	if typ == "wrapper" {
+		typ = "message"
+	}
+	return fmt.Sprintf("v.fail(%s, %s, %s, %s)?;", fns.lit(fns.fieldPath(ctx)), fns.lit(typ+"."+rule), value, spec), nil
+}
+
+func (fns rustFuncs) failOneOf(msg pgs.Message, oo pgs.OneOf) (string, error) {
+	base, err := errorBase(msg)
+	if err != nil {
+		return "", err
+	}
+	rule, err := shared.OneOfRule(oo)
+	if err != nil {
+		return "", err
+	}
+	args, err := shared.ErrorArgs(oo.Name().String(), rule.ProtoReflect(), rule.GetError())
+	if err != nil {
+		return "", err
+	}
+	spec, err := fns.errorSpec(base, rule.GetError(), args, "")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("v.fail(%s, \"oneof.required\", ::pgv::Param::None, %s)?;", fns.lit(oo.Name().String()), spec), nil
+}
+
+// errorSpec renders the pgv::ErrorSpec of e; value is the pgv::Param of the
+// validated value, if any.
+func (fns rustFuncs) errorSpec(base *validate.ErrorBase, e *validate.Error, args []shared.ErrorArg, value string) (string, error) {
+	pkg, class := resolveErrorTarget(base, e)
+
+	params := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg.Kind {
+		case shared.IntArg:
+			params = append(params, fmt.Sprintf("::pgv::Param::Int(%s)", fns.numLit(arg.Int)))
+		case shared.UintArg:
+			params = append(params, fmt.Sprintf("::pgv::Param::Uint(%d)", arg.Uint))
+		case shared.FloatArg:
+			params = append(params, fmt.Sprintf("::pgv::Param::Float(%s)", floatLit(arg.Float, 64)))
+		case shared.BoolArg:
+			params = append(params, fmt.Sprintf("::pgv::Param::Bool(%t)", arg.Bool))
+		case shared.RefArg:
+			params = append(params, fmt.Sprintf("::pgv::Param::Ref(%s)", fns.lit(arg.Str)))
+		case shared.ValueArg:
+			if value == "" {
+				return "", fmt.Errorf("error %q: $value is not available here", e.GetMethod())
+			}
+			params = append(params, value)
+		default:
+			params = append(params, fmt.Sprintf("::pgv::Param::Str(%s)", fns.lit(arg.Str)))
+		}
+	}
+
+	return fmt.Sprintf("::pgv::ErrorSpec { pkg: %s, class: %s, method: %s, params: &[%s] }",
+		fns.lit(pkg), fns.lit(class), fns.lit(e.GetMethod()), strings.Join(params, ", ")), nil
+}
+
+func hasItems(rules *validate.RepeatedRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetItems() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasValues(rules *validate.MapRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetValues() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveErrorTarget(base *validate.ErrorBase, e *validate.Error) (pkg, class string) {
+	if base != nil {
+		pkg = base.GetPkg()
+		class = base.GetClass()
+	}
+	if len(e.GetPkg()) > 0 {
+		pkg = e.GetPkg()
+	}
+	if len(e.GetClass()) > 0 {
+		class = e.GetClass()
+	}
+	return
+}
+
+func errorBase(msg pgs.Message) (base *validate.ErrorBase, err error) {
+	_, err = msg.Extension(validate.E_ErrorBase, &base)
+	return
+}
+
+func parentError(ctx shared.RuleContext) *validate.Error {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	switch {
+	case rules.GetRepeated() != nil:
+		if rs := rules.GetRepeated().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	case rules.GetMap() != nil:
+		if rs := rules.GetMap().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package rust
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		in           string
+		snake, camel string
+	}{
+		{"phone", "phone", "Phone"},
+		{"phone_number", "phone_number", "PhoneNumber"},
+		{"HTTPRequest", "http_request", "HttpRequest"},
+		{"field2Name", "field2_name", "Field2Name"},
+		{"IPv6_addr", "i_pv6_addr", "IPv6Addr"},
+		{"type", "r#type", "Type"},
+		{"self", "self_", "Self_"},
+	}
+	for _, tt := range tests {
+		if got := snakeCase(tt.in); got != tt.snake {
+			t.Errorf("snakeCase(%q) = %q; want %q", tt.in, got, tt.snake)
+		}
+		if got := upperCamelCase(tt.in); got != tt.camel {
+			t.Errorf("upperCamelCase(%q) = %q; want %q", tt.in, got, tt.camel)
+		}
+	}
+}
+
+func TestLiterals(t *testing.T) {
+	var fns rustFuncs
+	tests := []struct {
+		got, want string
+	}{
+		{fns.lit(`a"b\c`), `"a\"b\\c"`},
+		{fns.lit("é\x00\n\x7f"), `"é\0\n\u{7f}"`},
+		{fns.bytesLit([]byte{0xff, '1', '"'}), `b"\xff1\""`},
+		{fns.numLit(float32(1)), "1.0"},
+		{fns.numLit(1e21), "1e+21"},
+		{fns.numLit(math.Inf(-1)), "f64::NEG_INFINITY"},
+		{fns.numLit(float32(math.NaN())), "f32::NAN"},
+		{fns.numLit(int32(math.MinInt32)), "i32::MIN"},
+		{fns.numLit(int64(-3)), "-3"},
+		{fns.numLit(uint64(math.MaxUint64)), "18446744073709551615"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %s; want %s", tt.got, tt.want)
+		}
+	}
+}
+
+func TestCodeFormat(t *testing.T) {
+	in := `// Code generated by protoc-gen-validate. DO NOT EDIT.
+
+
+impl ::pgv::Validate for M {
+type Error = ::pgv::ValidationError;
+
+fn check(&self, v: &mut ::pgv::Violations<Self::Error>) -> ::pgv::Checked {
+const S_0_IN: &[&str] = &["}"];
+
+if self.s != "{" { // }
+v.fail("s", "string.const", ::pgv::Param::None, ::pgv::ErrorSpec { pkg: "", class: "", method: "bad", params: &[] })?;
+}
+Ok(())
+}
+}
+`
+	want := `// Code generated by protoc-gen-validate. DO NOT EDIT.
+
+impl ::pgv::Validate for M {
+    type Error = ::pgv::ValidationError;
+
+    fn check(&self, v: &mut ::pgv::Violations<Self::Error>) -> ::pgv::Checked {
+        const S_0_IN: &[&str] = &["}"];
+        if self.s != "{" { // }
+            v.fail("s", "string.const", ::pgv::Param::None, ::pgv::ErrorSpec { pkg: "", class: "", method: "bad", params: &[] })?;
+        }
+        Ok(())
+    }
+}
+`
+	var out bytes.Buffer
+	if err := CodeFormat(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("CodeFormat:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,41 @@
+package rust
+
+const repeatedConstTpl = `{{ renderConstants (.Elem "" "") }}`
+
+const repeatedTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if {{ emptyCond $ctx }} {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.MinItems }}
+	{{ $else }}if {{ $val }}.len() < {{ $r.GetMinItems }} {
+		{{ fail $ctx "min_items" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxItems }}
+	{{ $else }}if {{ $val }}.len() > {{ $r.GetMaxItems }} {
+		{{ fail $ctx "max_items" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUnique }}
+	{{ $else }}if !::pgv::unique(&{{ $val }}) {
+		{{ fail $ctx "unique" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+	{{- if $r.GetItems }}
+	v.each({{ lit (fieldPath $ctx) }}, &{{ $val }}, |v, item| {
+		{{ render ($ctx.ElemWithErrIndex "item" "" $index) }}
+		Ok(())
+	})?;
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasItems .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	v.each({{ lit (fieldPath .) }}, &{{ $val }}, |v, item| v.nested("", item))?;
+{{- end }}
+`
@@ -0,0 +1,152 @@
+package rust
+
+const stringConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "IN" }}: &[&str] = &[{{ range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }}];
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NOT_IN" }}: &[&str] = &[{{ range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end }}];
+{{- end }}
+{{- if $r.Pattern }}
+static {{ constantName $ctx $index "PATTERN" }}: ::pgv::Pattern = ::pgv::Pattern::new({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const stringTpl = `{{ $ctx := . }}{{ $val := val . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if {{ emptyCond $ctx }} {
+{{- end }}
+	{{- $else := "" }}
+	{{- if $r.Const }}
+	{{ $else }}if {{ $val }} != {{ lit $r.GetConst }} {
+		{{ fail $ctx "const" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.In }}
+	{{ $else }}if !{{ constantName $ctx $index "IN" }}.contains(&{{ $val }}) {
+		{{ fail $ctx "in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotIn }}
+	{{ $else }}if {{ constantName $ctx $index "NOT_IN" }}.contains(&{{ $val }}) {
+		{{ fail $ctx "not_in" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Len }}
+	{{ $else }}if ::pgv::strings::rune_count({{ $val }}) != {{ $r.GetLen }} {
+		{{ fail $ctx "len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinLen }}
+	{{ $else }}if ::pgv::strings::rune_count({{ $val }}) < {{ $r.GetMinLen }} {
+		{{ fail $ctx "min_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxLen }}
+	{{ $else }}if ::pgv::strings::rune_count({{ $val }}) > {{ $r.GetMaxLen }} {
+		{{ fail $ctx "max_len" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.LenBytes }}
+	{{ $else }}if {{ $val }}.len() != {{ $r.GetLenBytes }} {
+		{{ fail $ctx "len_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MinBytes }}
+	{{ $else }}if {{ $val }}.len() < {{ $r.GetMinBytes }} {
+		{{ fail $ctx "min_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.MaxBytes }}
+	{{ $else }}if {{ $val }}.len() > {{ $r.GetMaxBytes }} {
+		{{ fail $ctx "max_bytes" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Pattern }}
+	{{ $else }}if !{{ constantName $ctx $index "PATTERN" }}.is_match({{ $val }}) {
+		{{ fail $ctx "pattern" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Prefix }}
+	{{ $else }}if !{{ $val }}.starts_with({{ lit $r.GetPrefix }}) {
+		{{ fail $ctx "prefix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Suffix }}
+	{{ $else }}if !{{ $val }}.ends_with({{ lit $r.GetSuffix }}) {
+		{{ fail $ctx "suffix" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.Contains }}
+	{{ $else }}if !{{ $val }}.contains({{ lit $r.GetContains }}) {
+		{{ fail $ctx "contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.NotContains }}
+	{{ $else }}if {{ $val }}.contains({{ lit $r.GetNotContains }}) {
+		{{ fail $ctx "not_contains" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetEmail }}
+	{{ $else }}if !::pgv::strings::is_email({{ $val }}) {
+		{{ fail $ctx "email" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetAddress }}
+	{{ $else }}if !::pgv::strings::is_address({{ $val }}) {
+		{{ fail $ctx "address" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetHostname }}
+	{{ $else }}if !::pgv::strings::is_hostname({{ $val }}) {
+		{{ fail $ctx "hostname" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIp }}
+	{{ $else }}if !::pgv::strings::is_ip({{ $val }}) {
+		{{ fail $ctx "ip" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	{{ $else }}if !::pgv::strings::is_ipv4({{ $val }}) {
+		{{ fail $ctx "ipv4" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	{{ $else }}if !::pgv::strings::is_ipv6({{ $val }}) {
+		{{ fail $ctx "ipv6" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUri }}
+	{{ $else }}if !::pgv::strings::is_uri({{ $val }}) {
+		{{ fail $ctx "uri" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUriRef }}
+	{{ $else }}if !::pgv::strings::is_uri_ref({{ $val }}) {
+		{{ fail $ctx "uri_ref" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $r.GetUuid }}
+	{{ $else }}if !::pgv::strings::is_uuid({{ $val }}) {
+		{{ fail $ctx "uuid" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if eq $r.GetWellKnownRegex 1 }}
+	{{ $else }}if !::pgv::strings::is_http_header_name({{ $val }}, {{ $r.GetStrict }}) {
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- else if eq $r.GetWellKnownRegex 2 }}
+	{{ $else }}if !::pgv::strings::is_http_header_value({{ $val }}, {{ $r.GetStrict }}) {
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+	{{- $else = "} else " }}
+	{{- end }}
+	{{- if $else }}
+	}
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+`
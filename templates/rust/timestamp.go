@@ -0,0 +1,35 @@
+package rust
+
+const timestampTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{ if present $ctx }}if let Some(ts) = &{{ accessor $ctx }} {{ "{" }}{{ else }}{{ "{" }}
+		let ts = {{ accessor $ctx }};{{ end }}
+		if !::pgv::times::is_valid_timestamp(ts) {
+			{{ fail $ctx "valid" $r.GetError }}
+		{{- if $r.Const }}
+		} else if ::pgv::times::timestamp_nanos(ts) != {{ nanosLit $r.GetConst }} {
+			{{ fail $ctx "const" $r.GetError }}
+		{{- end }}
+		{{- with rangeCond "::pgv::times::timestamp_nanos(ts)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		} else if {{ . }} {
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+		{{- end }}
+		{{- if $r.GetLtNow }}
+		} else if ::pgv::times::timestamp_nanos(ts) >= ::pgv::times::now_nanos() {
+			{{ fail $ctx "lt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.GetGtNow }}
+		} else if ::pgv::times::timestamp_nanos(ts) <= ::pgv::times::now_nanos() {
+			{{ fail $ctx "gt_now" $r.GetError }}
+		{{- end }}
+		{{- if $r.Within }}
+		} else if !::pgv::times::is_within_now(ts, {{ nanosLit $r.GetWithin }}) {
+			{{ fail $ctx "within" $r.GetError }}
+		{{- end }}
+		}
+	}
+	{{- if and $r.GetRequired (present $ctx) }} else {
+		{{ fail $ctx "required" $r.GetError }}
+	}
+	{{- end }}
+{{ end -}}
+`
@@ -7,6 +7,7 @@ import (
 
 	pgs "github.com/lyft/protoc-gen-star"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/curl-li/protoc-gen-validate/validate"
 )
@@ -64,6 +65,7 @@ func (ctx RuleContext) Key(name, idx string) (out RuleContext, err error) {
 	out.Field = ctx.Field
 	out.AccessorOverride = name
 	out.Index = idx
+	out.ErrBase = ctx.ErrBase
 
 	var rule *validate.FieldRules
 	for _, r := range rules.GetRules() {
@@ -156,6 +158,82 @@ func Render(tpl *template.Template) func(ctx RuleContext) (string, error) {
 	}
 }
 
+// ScalarRequired reports whether a scalar rule of rules requires the field to
+// be set. The message-typed rules have their own `required` and are ignored.
+func ScalarRequired(rules *validate.FieldRules) bool {
+	switch rules.GetType().(type) {
+	case nil, *validate.FieldRules_Repeated, *validate.FieldRules_Map,
+		*validate.FieldRules_Any, *validate.FieldRules_Duration, *validate.FieldRules_Timestamp:
+		return false
+	}
+	rr := rules.ProtoReflect()
+	typed := rr.Get(rr.WhichOneof(rr.Descriptor().Oneofs().ByName("type"))).Message()
+
+	// Bool and enum rules are a single rule, the others hold a list.
+	list := []protoreflect.Message{typed}
+	if fd := typed.Descriptor().Fields().ByName("rules"); fd != nil {
+		list = list[:0]
+		for i := 0; i < typed.Get(fd).List().Len(); i++ {
+			list = append(list, typed.Get(fd).List().Get(i).Message())
+		}
+	}
+	for _, r := range list {
+		if fd := r.Descriptor().Fields().ByName("required"); fd != nil && r.Get(fd).Bool() {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiredError returns the Error of the first rule of rules requiring the
+// field to be set.
+func RequiredError(rules interface{ ProtoReflect() protoreflect.Message }) *validate.Error {
+	typed := rules.ProtoReflect()
+	list := []protoreflect.Message{typed}
+	if fd := typed.Descriptor().Fields().ByName("rules"); fd != nil {
+		list = list[:0]
+		for i := 0; i < typed.Get(fd).List().Len(); i++ {
+			list = append(list, typed.Get(fd).List().Get(i).Message())
+		}
+	}
+	for _, r := range list {
+		if fd := r.Descriptor().Fields().ByName("required"); fd != nil && r.Get(fd).Bool() {
+			if e, ok := r.Interface().(interface{ GetError() *validate.Error }); ok {
+				return e.GetError()
+			}
+		}
+	}
+	return nil
+}
+
+// HasConstraints reports whether a rule of rules, a typed rule set or one of
+// its rules, constrains the value beyond requiring it to be set.
+func HasConstraints(rules proto.Message) bool {
+	typed := rules.ProtoReflect()
+	list := []protoreflect.Message{typed}
+	if fd := typed.Descriptor().Fields().ByName("rules"); fd != nil {
+		list = list[:0]
+		for i := 0; i < typed.Get(fd).List().Len(); i++ {
+			list = append(list, typed.Get(fd).List().Get(i).Message())
+		}
+	}
+	for _, r := range list {
+		constrained := false
+		r.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			switch fd.Name() {
+			case "required", "ignore_empty", "error", "groups", "when":
+				return true
+			}
+			constrained = true
+			return false
+		})
+		if constrained {
+			return true
+		}
+	}
+	return false
+}
+
 func resolveRules(typ interface{ IsEmbed() bool }, rules *validate.FieldRules) (ruleType string, rule proto.Message, messageRule *validate.MessageRules, wrapped bool) {
 	switch r := rules.GetType().(type) {
 	case *validate.FieldRules_Float:
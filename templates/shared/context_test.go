@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+func TestHasConstraints(t *testing.T) {
+	required := &validate.Int64Rule{Required: proto.Bool(true), Error: &validate.Error{Method: proto.String("e")}}
+	tests := []struct {
+		rules proto.Message
+		want  bool
+	}{
+		{&validate.Int64Rules{}, false},
+		{&validate.Int64Rules{Rules: []*validate.Int64Rule{required}}, false},
+		{&validate.Int64Rules{Rules: []*validate.Int64Rule{required, {Gt: proto.Int64(0)}}}, true},
+		{required, false},
+		{&validate.StringRule{IgnoreEmpty: proto.Bool(true), Groups: []string{"create"}}, false},
+		{&validate.StringRule{MinLen: proto.Uint64(0)}, true},
+		{&validate.BoolRules{Required: proto.Bool(true)}, false},
+		{&validate.BoolRules{Const: proto.Bool(false)}, true},
+	}
+	for _, tt := range tests {
+		if got := HasConstraints(tt.rules); got != tt.want {
+			t.Errorf("HasConstraints(%v) = %v, want %v", tt.rules, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/curl-li/protoc-gen-validate/validate"
 )
@@ -33,3 +34,28 @@ func OneOfRule(oo pgs.OneOf) (rule *validate.OneOf, err error) {
 	_, err = oo.Extension(validate.E_Oneof, &rule)
 	return
 }
+
+// MethodRules returns the rules of the messages of m: the rules of its
+// service, overridden by its own. Its own groups replace the ones of the
+// service.
+func MethodRules(m pgs.Method) (rules *validate.MethodRules, err error) {
+	rules = &validate.MethodRules{}
+	if _, err = m.Service().Extension(validate.E_Methods, rules); err != nil {
+		return
+	}
+	var own validate.MethodRules
+	if _, err = m.Extension(validate.E_Method, &own); err != nil {
+		return
+	}
+	if len(own.GetGroups()) > 0 {
+		rules.Groups = nil
+	}
+	proto.Merge(rules, &own)
+	return
+}
+
+// FieldMaskRule returns the field mask rule of msg, nil if it has none.
+func FieldMaskRule(msg pgs.Message) (rule *validate.FieldMaskRule, err error) {
+	_, err = msg.Extension(validate.E_FieldMask, &rule)
+	return
+}
@@ -0,0 +1,311 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+// ErrorArgKind identifies how an ErrorArg is rendered.
+type ErrorArgKind int
+
+const (
+	StringArg ErrorArgKind = iota
+	IntArg
+	UintArg
+	FloatArg
+	BoolArg
+	// RefArg is a constant reference, rendered as is.
+	RefArg
+	// ValueArg is the validated value, only known at validation time.
+	ValueArg
+)
+
+// ErrorArg is an argument of an error method, resolved against the rule that
+// declares the error.
+type ErrorArg struct {
+	Kind  ErrorArgKind
+	Str   string
+	Int   int64
+	Uint  uint64
+	Float float64
+	Bool  bool
+}
+
+var refPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// MessageErrors returns every Error declared on the fields, oneofs and
+// expression rules of msg, including the ones of nested item, key and value
+// rules.
+func MessageErrors(msg pgs.Message) []*validate.Error {
+	var out []*validate.Error
+	for _, oo := range msg.OneOfs() {
+		if rule, err := OneOfRule(oo); err == nil && rule.GetError() != nil {
+			out = append(out, rule.GetError())
+		}
+	}
+	for _, f := range msg.Fields() {
+		var rules validate.FieldRules
+		if _, err := f.Extension(validate.E_Rules, &rules); err != nil {
+			continue
+		}
+		out = append(out, RuleErrors(&rules)...)
+	}
+	if rules, err := ExprRules(msg); err == nil {
+		for _, r := range rules {
+			if r.GetError() != nil {
+				out = append(out, r.GetError())
+			}
+		}
+	}
+	return out
+}
+
+// RuleErrors returns every Error reachable from rules.
+func RuleErrors(rules proto.Message) []*validate.Error {
+	var out []*validate.Error
+	WalkErrors(rules, func(_ protoreflect.Message, e *validate.Error) {
+		out = append(out, e)
+	})
+	return out
+}
+
+// WalkErrors calls fn with every Error reachable from rules and the rule
+// message declaring it.
+func WalkErrors(rules proto.Message, fn func(rule protoreflect.Message, e *validate.Error)) {
+	walkRules(rules, func(rule protoreflect.Message, v proto.Message) {
+		if e, ok := v.(*validate.Error); ok {
+			fn(rule, e)
+		}
+	})
+}
+
+// WalkMissingErrors calls fn with every rule message reachable from rules,
+// rules included, that can declare an Error but does not.
+func WalkMissingErrors(rules proto.Message, fn func(rule protoreflect.Message)) {
+	if rules == nil {
+		return
+	}
+	errorName := (*validate.Error)(nil).ProtoReflect().Descriptor().FullName()
+	visit := func(rule protoreflect.Message) {
+		fd := rule.Descriptor().Fields().ByName("error")
+		if rule.IsValid() && fd != nil && fd.Message() != nil && fd.Message().FullName() == errorName && !rule.Has(fd) {
+			fn(rule)
+		}
+	}
+	visit(rules.ProtoReflect())
+	walkRules(rules, func(_ protoreflect.Message, v proto.Message) {
+		visit(v.ProtoReflect())
+	})
+}
+
+// walkRules calls fn with every message reachable from rules, except map
+// entries, and the message declaring it.
+func walkRules(rules proto.Message, fn func(rule protoreflect.Message, v proto.Message)) {
+	if rules == nil {
+		return
+	}
+	walkMessages(rules.ProtoReflect(), fn)
+}
+
+func walkMessages(msg protoreflect.Message, fn func(protoreflect.Message, proto.Message)) {
+	if !msg.IsValid() {
+		return
+	}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				fn(msg, v.List().Get(i).Message().Interface())
+				walkMessages(v.List().Get(i).Message(), fn)
+			}
+		case fd.IsMap():
+		default:
+			fn(msg, v.Message().Interface())
+			walkMessages(v.Message(), fn)
+		}
+		return true
+	})
+}
+
+// ErrorRule returns the rule message declaring e within rules, or nil if e is
+// not part of rules.
+func ErrorRule(rules proto.Message, e *validate.Error) (out protoreflect.Message) {
+	WalkErrors(rules, func(rule protoreflect.Message, found *validate.Error) {
+		if found == e {
+			out = rule
+		}
+	})
+	return out
+}
+
+// FieldErrorArgs resolves the arguments of e, declared either in the rules of
+// f or on the oneof f belongs to.
+func FieldErrorArgs(f pgs.Field, e *validate.Error) ([]ErrorArg, error) {
+	if len(e.GetArgs()) == 0 {
+		return ErrorArgs("", nil, e)
+	}
+
+	var rules validate.FieldRules
+	if _, err := f.Extension(validate.E_Rules, &rules); err != nil {
+		return nil, err
+	}
+	if rule := ErrorRule(&rules, e); rule != nil {
+		return ErrorArgs(f.Name().String(), rule, e)
+	}
+
+	if oo := f.OneOf(); oo != nil {
+		if rule, err := OneOfRule(oo); err == nil && rule.GetError() == e {
+			return ErrorArgs(oo.Name().String(), rule.ProtoReflect(), e)
+		}
+	}
+	return nil, fmt.Errorf("error %q is not declared by field %s", e.GetMethod(), f.Name())
+}
+
+// ErrorArgs resolves the arguments of e, declared by rule on the named field
+// or oneof. Plain params resolve to string arguments.
+func ErrorArgs(field string, rule protoreflect.Message, e *validate.Error) ([]ErrorArg, error) {
+	if len(e.GetArgs()) == 0 {
+		out := make([]ErrorArg, 0, len(e.GetParams()))
+		for _, p := range e.GetParams() {
+			out = append(out, ErrorArg{Kind: StringArg, Str: p})
+		}
+		return out, nil
+	}
+	if len(e.GetParams()) > 0 {
+		return nil, fmt.Errorf("error %q cannot have both params and args", e.GetMethod())
+	}
+
+	out := make([]ErrorArg, 0, len(e.GetArgs()))
+	for i, p := range e.GetArgs() {
+		arg, err := resolveArg(field, rule, p)
+		if err != nil {
+			return nil, fmt.Errorf("error %q arg %d: %w", e.GetMethod(), i, err)
+		}
+		out = append(out, arg)
+	}
+	return out, nil
+}
+
+func resolveArg(field string, rule protoreflect.Message, p *validate.Param) (ErrorArg, error) {
+	switch k := p.GetKind().(type) {
+	case *validate.Param_Int:
+		return ErrorArg{Kind: IntArg, Int: k.Int}, nil
+	case *validate.Param_Bool:
+		return ErrorArg{Kind: BoolArg, Bool: k.Bool}, nil
+	case *validate.Param_String_:
+		return ErrorArg{Kind: StringArg, Str: k.String_}, nil
+	case *validate.Param_Ref:
+		if !refPattern.MatchString(k.Ref) {
+			return ErrorArg{}, fmt.Errorf("malformed constant reference %q", k.Ref)
+		}
+		return ErrorArg{Kind: RefArg, Str: k.Ref}, nil
+	case *validate.Param_Placeholder:
+		return resolvePlaceholder(field, rule, k.Placeholder)
+	default:
+		return ErrorArg{}, fmt.Errorf("param has no value")
+	}
+}
+
+func resolvePlaceholder(field string, rule protoreflect.Message, ph string) (ErrorArg, error) {
+	switch ph {
+	case "$field":
+		return ErrorArg{Kind: StringArg, Str: field}, nil
+	case "$value":
+		return ErrorArg{Kind: ValueArg}, nil
+	case "$rule":
+		return ErrorArg{Kind: StringArg, Str: RuleName(rule)}, nil
+	}
+
+	name := strings.TrimPrefix(ph, "$")
+	if name == ph || name == "" {
+		return ErrorArg{}, fmt.Errorf("unknown placeholder %q", ph)
+	}
+	if rule == nil {
+		return ErrorArg{}, fmt.Errorf("placeholder %q has no rule to refer to", ph)
+	}
+	fd := rule.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.IsList() || fd.IsMap() {
+		return ErrorArg{}, fmt.Errorf("placeholder %q is not a bound of %s", ph, rule.Descriptor().Name())
+	}
+	if !rule.Has(fd) {
+		return ErrorArg{}, fmt.Errorf("placeholder %q refers to an unset bound", ph)
+	}
+
+	v := rule.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return ErrorArg{Kind: IntArg, Int: v.Int()}, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return ErrorArg{Kind: UintArg, Uint: v.Uint()}, nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return ErrorArg{Kind: FloatArg, Float: v.Float()}, nil
+	case protoreflect.BoolKind:
+		return ErrorArg{Kind: BoolArg, Bool: v.Bool()}, nil
+	case protoreflect.StringKind:
+		return ErrorArg{Kind: StringArg, Str: v.String()}, nil
+	default:
+		return ErrorArg{}, fmt.Errorf("placeholder %q refers to a %s bound, only scalar bounds are supported", ph, fd.Kind())
+	}
+}
+
+// RuleName names the constraints set on rule, e.g. `string.min_len` or
+// `string.min_len,string.max_len`. Expression rules are `message.expr`.
+func RuleName(rule protoreflect.Message) string {
+	if rule == nil {
+		return ""
+	}
+	if _, ok := rule.Interface().(*validate.ExprRule); ok {
+		return "message.expr"
+	}
+	typ := string(rule.Descriptor().Name())
+	typ = strings.ToLower(strings.TrimSuffix(strings.TrimSuffix(typ, "Rules"), "Rule"))
+
+	var names []string
+	fields := rule.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !rule.Has(fd) || fd.Name() == "error" || fd.Name() == "ignore_empty" || fd.Name() == "when" {
+			continue
+		}
+		names = append(names, typ+"."+string(fd.Name()))
+	}
+	if len(names) == 0 {
+		return typ
+	}
+	return strings.Join(names, ",")
+}
+
+// BoundsRule names the check of the lt, lte, gt and gte bounds of rule the
+// way the Java validators report it: `range` for a lower and an upper bound,
+// else the bound, e.g. `gt`.
+func BoundsRule(rule proto.Message) string {
+	r := rule.ProtoReflect()
+	var upper, lower string
+	for _, name := range []protoreflect.Name{"lt", "lte", "gt", "gte"} {
+		fd := r.Descriptor().Fields().ByName(name)
+		if fd == nil || !r.Has(fd) {
+			continue
+		}
+		if name[0] == 'l' {
+			upper = string(name)
+		} else {
+			lower = string(name)
+		}
+	}
+	if upper != "" && lower != "" {
+		return "range"
+	}
+	return upper + lower
+}
@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+func TestBoundsRule(t *testing.T) {
+	tests := []struct {
+		rule proto.Message
+		want string
+	}{
+		{&validate.Int32Rule{Gt: proto.Int32(0)}, "gt"},
+		{&validate.Int32Rule{Lte: proto.Int32(0), In: []int32{1}}, "lte"},
+		{&validate.Int32Rule{Gte: proto.Int32(0), Lt: proto.Int32(10)}, "range"},
+		{&validate.DoubleRule{Lt: proto.Float64(-1), Gt: proto.Float64(1)}, "range"},
+		{&validate.DurationRule{Gte: durationpb.New(1)}, "gte"},
+	}
+	for _, tt := range tests {
+		if got := BoundsRule(tt.rule); got != tt.want {
+			t.Errorf("BoundsRule(%v) = %q, want %q", tt.rule, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,526 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Expr is a node of a parsed ExprRule expression. CheckExpr resolves the
+// identifiers of a parsed expression against a message and sets the type of
+// every node.
+type Expr interface {
+	// Pos is the byte offset of the node in the expression.
+	Pos() int
+	// Type is the type of the node, nil until the expression is checked.
+	Type() *ExprType
+}
+
+type exprNode struct {
+	pos int
+	typ *ExprType
+}
+
+func (n *exprNode) Pos() int        { return n.pos }
+func (n *exprNode) Type() *ExprType { return n.typ }
+
+type (
+	// LitExpr is a literal: a bool, int64, uint64, float64, string or []byte.
+	LitExpr struct {
+		exprNode
+		Value interface{}
+	}
+
+	// IdentExpr is a name. Once checked, it is a field of the message, a
+	// variable bound by a macro or `now`.
+	IdentExpr struct {
+		exprNode
+		Name string
+	}
+
+	// SelectExpr selects the field Name of Operand.
+	SelectExpr struct {
+		exprNode
+		Operand Expr
+		Name    string
+	}
+
+	// CallExpr calls Func with Args, either as a global function or as a
+	// method of Target.
+	CallExpr struct {
+		exprNode
+		Func   string
+		Target Expr
+		Args   []Expr
+	}
+
+	// UnaryExpr is `!X` or `-X`.
+	UnaryExpr struct {
+		exprNode
+		Op string
+		X  Expr
+	}
+
+	// BinaryExpr is `X Op Y`, Op being an arithmetic, relational or logical
+	// operator or `in`.
+	BinaryExpr struct {
+		exprNode
+		Op   string
+		X, Y Expr
+	}
+
+	// CondExpr is `Cond ? Then : Else`.
+	CondExpr struct {
+		exprNode
+		Cond, Then, Else Expr
+	}
+
+	// ListExpr is a list literal.
+	ListExpr struct {
+		exprNode
+		Elems []Expr
+	}
+)
+
+// ParseExpr parses an ExprRule expression.
+func ParseExpr(src string) (Expr, error) {
+	p := &exprParser{lex: exprLexer{src: src}}
+	p.next()
+	e := p.parseExpr()
+	if p.err == nil && p.tok.kind != tokEOF {
+		p.failf(p.tok.pos, "unexpected %s", p.tok)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return e, nil
+}
+
+// ExprError is an error found while parsing or checking an expression.
+type ExprError struct {
+	Pos int
+	Msg string
+}
+
+func (e *ExprError) Error() string { return fmt.Sprintf("%d: %s", e.Pos+1, e.Msg) }
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokIdent
+	tokInt
+	tokUint
+	tokDouble
+	tokString
+	tokBytes
+	tokOp
+)
+
+type token struct {
+	kind tokKind
+	pos  int
+	text string
+	val  interface{}
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+type exprLexer struct {
+	src string
+	pos int
+}
+
+var exprOps = []string{"&&", "||", "==", "!=", "<=", ">=", "!", "<", ">", "+", "-", "*", "/", "%", "?", ":", ".", ",", "(", ")", "[", "]"}
+
+func (l *exprLexer) next() (token, error) {
+	for l.pos < len(l.src) && unicode.IsSpace(rune(l.src[l.pos])) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: start}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case c == '"' || c == '\'':
+		s, err := l.quoted(false)
+		return token{kind: tokString, pos: start, text: l.src[start:l.pos], val: s}, err
+	case (c == 'r' || c == 'R') && l.peekQuote(1):
+		l.pos++
+		s, err := l.quoted(true)
+		return token{kind: tokString, pos: start, text: l.src[start:l.pos], val: s}, err
+	case (c == 'b' || c == 'B') && l.peekQuote(1):
+		l.pos++
+		s, err := l.quoted(false)
+		return token{kind: tokBytes, pos: start, text: l.src[start:l.pos], val: []byte(s)}, err
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for l.pos < len(l.src) && (l.src[l.pos] == '_' || unicode.IsLetter(rune(l.src[l.pos])) || unicode.IsDigit(rune(l.src[l.pos]))) {
+			l.pos++
+		}
+		return token{kind: tokIdent, pos: start, text: l.src[start:l.pos]}, nil
+	case c >= '0' && c <= '9':
+		return l.number()
+	}
+
+	for _, op := range exprOps {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokOp, pos: start, text: op}, nil
+		}
+	}
+	r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+	return token{}, &ExprError{start, fmt.Sprintf("unexpected character %q", r)}
+}
+
+func (l *exprLexer) peekQuote(offset int) bool {
+	return l.pos+offset < len(l.src) && (l.src[l.pos+offset] == '"' || l.src[l.pos+offset] == '\'')
+}
+
+func (l *exprLexer) number() (token, error) {
+	start := l.pos
+	if strings.HasPrefix(l.src[l.pos:], "0x") || strings.HasPrefix(l.src[l.pos:], "0X") {
+		l.pos += 2
+		for l.pos < len(l.src) && strings.IndexByte("0123456789abcdefABCDEF", l.src[l.pos]) >= 0 {
+			l.pos++
+		}
+	} else {
+		l.digits()
+	}
+
+	float := false
+	if l.pos+1 < len(l.src) && l.src[l.pos] == '.' && l.src[l.pos+1] >= '0' && l.src[l.pos+1] <= '9' {
+		float = true
+		l.pos++
+		l.digits()
+	}
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		float = true
+		l.pos++
+		if l.pos < len(l.src) && (l.src[l.pos] == '+' || l.src[l.pos] == '-') {
+			l.pos++
+		}
+		l.digits()
+	}
+	text := l.src[start:l.pos]
+
+	if float {
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return token{}, &ExprError{start, fmt.Sprintf("invalid double literal %s", text)}
+		}
+		return token{kind: tokDouble, pos: start, text: text, val: v}, nil
+	}
+	if l.pos < len(l.src) && (l.src[l.pos] == 'u' || l.src[l.pos] == 'U') {
+		l.pos++
+		v, err := strconv.ParseUint(text, 0, 64)
+		if err != nil {
+			return token{}, &ExprError{start, fmt.Sprintf("invalid uint literal %s", text)}
+		}
+		return token{kind: tokUint, pos: start, text: l.src[start:l.pos], val: v}, nil
+	}
+	// the magnitude of an int literal may exceed int64 by one, when negated
+	v, err := strconv.ParseUint(text, 0, 64)
+	if err != nil || v > 1<<63 {
+		return token{}, &ExprError{start, fmt.Sprintf("invalid int literal %s", text)}
+	}
+	return token{kind: tokInt, pos: start, text: text, val: v}, nil
+}
+
+func (l *exprLexer) digits() {
+	for l.pos < len(l.src) && l.src[l.pos] >= '0' && l.src[l.pos] <= '9' {
+		l.pos++
+	}
+}
+
+// quoted reads a quoted literal, resolving escapes unless raw.
+func (l *exprLexer) quoted(raw bool) (string, error) {
+	start := l.pos
+	quote := l.src[l.pos]
+	l.pos++
+
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == quote:
+			l.pos++
+			return sb.String(), nil
+		case c == '\n':
+			return "", &ExprError{start, "unterminated string literal"}
+		case c == '\\' && !raw:
+			if err := l.escape(&sb); err != nil {
+				return "", err
+			}
+		default:
+			sb.WriteByte(c)
+			l.pos++
+		}
+	}
+	return "", &ExprError{start, "unterminated string literal"}
+}
+
+func (l *exprLexer) escape(sb *strings.Builder) error {
+	start := l.pos
+	l.pos++
+	if l.pos >= len(l.src) {
+		return &ExprError{start, "unterminated escape sequence"}
+	}
+	c := l.src[l.pos]
+	l.pos++
+	switch c {
+	case 'n':
+		sb.WriteByte('\n')
+	case 'r':
+		sb.WriteByte('\r')
+	case 't':
+		sb.WriteByte('\t')
+	case '\\', '\'', '"', '`', '?':
+		sb.WriteByte(c)
+	case 'x', 'u', 'U':
+		n := map[byte]int{'x': 2, 'u': 4, 'U': 8}[c]
+		if l.pos+n > len(l.src) {
+			return &ExprError{start, "invalid escape sequence"}
+		}
+		v, err := strconv.ParseUint(l.src[l.pos:l.pos+n], 16, 32)
+		if err != nil {
+			return &ExprError{start, "invalid escape sequence"}
+		}
+		l.pos += n
+		if c == 'x' {
+			sb.WriteByte(byte(v))
+		} else {
+			sb.WriteRune(rune(v))
+		}
+	default:
+		return &ExprError{start, fmt.Sprintf("invalid escape sequence \\%c", c)}
+	}
+	return nil
+}
+
+type exprParser struct {
+	lex exprLexer
+	tok token
+	err error
+}
+
+func (p *exprParser) next() {
+	if p.err != nil {
+		return
+	}
+	tok, err := p.lex.next()
+	if err != nil {
+		p.err = err
+		p.tok = token{kind: tokEOF, pos: p.lex.pos}
+		return
+	}
+	p.tok = tok
+}
+
+func (p *exprParser) failf(pos int, format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = &ExprError{pos, fmt.Sprintf(format, args...)}
+	}
+}
+
+func (p *exprParser) isOp(op string) bool {
+	return p.tok.kind == tokOp && p.tok.text == op
+}
+
+func (p *exprParser) expect(op string) {
+	if !p.isOp(op) {
+		p.failf(p.tok.pos, "expected %q, found %s", op, p.tok)
+		return
+	}
+	p.next()
+}
+
+// parseExpr parses `or ? expr : expr`.
+func (p *exprParser) parseExpr() Expr {
+	cond := p.parseOr()
+	if !p.isOp("?") {
+		return cond
+	}
+	pos := p.tok.pos
+	p.next()
+	then := p.parseOr()
+	p.expect(":")
+	return &CondExpr{exprNode{pos: pos}, cond, then, p.parseExpr()}
+}
+
+func (p *exprParser) parseOr() Expr {
+	x := p.parseAnd()
+	for p.isOp("||") && p.err == nil {
+		pos := p.tok.pos
+		p.next()
+		x = &BinaryExpr{exprNode{pos: pos}, "||", x, p.parseAnd()}
+	}
+	return x
+}
+
+func (p *exprParser) parseAnd() Expr {
+	x := p.parseRelation()
+	for p.isOp("&&") && p.err == nil {
+		pos := p.tok.pos
+		p.next()
+		x = &BinaryExpr{exprNode{pos: pos}, "&&", x, p.parseRelation()}
+	}
+	return x
+}
+
+func (p *exprParser) parseRelation() Expr {
+	x := p.parseAddition()
+	for p.err == nil {
+		op := p.tok.text
+		switch {
+		case p.tok.kind == tokOp && (op == "<" || op == "<=" || op == ">" || op == ">=" || op == "==" || op == "!="):
+		case p.tok.kind == tokIdent && op == "in":
+		default:
+			return x
+		}
+		pos := p.tok.pos
+		p.next()
+		x = &BinaryExpr{exprNode{pos: pos}, op, x, p.parseAddition()}
+	}
+	return x
+}
+
+func (p *exprParser) parseAddition() Expr {
+	x := p.parseMultiplication()
+	for (p.isOp("+") || p.isOp("-")) && p.err == nil {
+		op, pos := p.tok.text, p.tok.pos
+		p.next()
+		x = &BinaryExpr{exprNode{pos: pos}, op, x, p.parseMultiplication()}
+	}
+	return x
+}
+
+func (p *exprParser) parseMultiplication() Expr {
+	x := p.parseUnary()
+	for (p.isOp("*") || p.isOp("/") || p.isOp("%")) && p.err == nil {
+		op, pos := p.tok.text, p.tok.pos
+		p.next()
+		x = &BinaryExpr{exprNode{pos: pos}, op, x, p.parseUnary()}
+	}
+	return x
+}
+
+func (p *exprParser) parseUnary() Expr {
+	if !p.isOp("!") && !p.isOp("-") {
+		return p.parseMember()
+	}
+	op, pos := p.tok.text, p.tok.pos
+	p.next()
+
+	// negative numeric literals are literals, so that the most negative int
+	// can be written
+	if op == "-" && (p.tok.kind == tokInt || p.tok.kind == tokDouble) {
+		tok := p.tok
+		p.next()
+		var lit Expr
+		if v, ok := tok.val.(uint64); ok {
+			lit = &LitExpr{exprNode{pos: pos}, -int64(v)}
+		} else {
+			lit = &LitExpr{exprNode{pos: pos}, -tok.val.(float64)}
+		}
+		return p.parseMemberSuffix(lit)
+	}
+	return &UnaryExpr{exprNode{pos: pos}, op, p.parseUnary()}
+}
+
+func (p *exprParser) parseMember() Expr {
+	return p.parseMemberSuffix(p.parsePrimary())
+}
+
+func (p *exprParser) parseMemberSuffix(x Expr) Expr {
+	for p.isOp(".") && p.err == nil {
+		p.next()
+		if p.tok.kind != tokIdent {
+			p.failf(p.tok.pos, "expected a field name, found %s", p.tok)
+			return x
+		}
+		name, pos := p.tok.text, p.tok.pos
+		p.next()
+		if p.isOp("(") {
+			x = &CallExpr{exprNode{pos: pos}, name, x, p.parseArgs()}
+		} else {
+			x = &SelectExpr{exprNode{pos: pos}, x, name}
+		}
+	}
+	if p.isOp("[") {
+		p.failf(p.tok.pos, "indexing is not supported, use a macro such as all or exists")
+	}
+	return x
+}
+
+func (p *exprParser) parseArgs() []Expr {
+	p.expect("(")
+	var args []Expr
+	for !p.isOp(")") && p.err == nil {
+		args = append(args, p.parseExpr())
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	p.expect(")")
+	return args
+}
+
+func (p *exprParser) parsePrimary() Expr {
+	tok := p.tok
+	switch tok.kind {
+	case tokInt:
+		p.next()
+		if tok.val.(uint64) > 1<<63-1 {
+			p.failf(tok.pos, "int literal %s overflows", tok.text)
+		}
+		return &LitExpr{exprNode{pos: tok.pos}, int64(tok.val.(uint64))}
+	case tokUint, tokDouble, tokString, tokBytes:
+		p.next()
+		return &LitExpr{exprNode{pos: tok.pos}, tok.val}
+	case tokIdent:
+		p.next()
+		switch tok.text {
+		case "true", "false":
+			return &LitExpr{exprNode{pos: tok.pos}, tok.text == "true"}
+		case "null":
+			p.failf(tok.pos, "null is not supported, use has()")
+			return nil
+		}
+		if p.isOp("(") {
+			return &CallExpr{exprNode{pos: tok.pos}, tok.text, nil, p.parseArgs()}
+		}
+		return &IdentExpr{exprNode{pos: tok.pos}, tok.text}
+	case tokOp:
+		switch tok.text {
+		case "(":
+			p.next()
+			x := p.parseExpr()
+			p.expect(")")
+			return x
+		case "[":
+			p.next()
+			list := &ListExpr{exprNode: exprNode{pos: tok.pos}}
+			for !p.isOp("]") && p.err == nil {
+				list.Elems = append(list.Elems, p.parseExpr())
+				if !p.isOp(",") {
+					break
+				}
+				p.next()
+			}
+			p.expect("]")
+			return list
+		}
+	}
+	p.failf(tok.pos, "unexpected %s", tok)
+	return nil
+}
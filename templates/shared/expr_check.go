@@ -0,0 +1,820 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+// ExprKind is the kind of value an expression evaluates to.
+type ExprKind int
+
+const (
+	ExprBool ExprKind = iota + 1
+	ExprInt
+	ExprUint
+	ExprDouble
+	ExprString
+	ExprBytes
+	// ExprEnum values are the numbers of an enum, comparable with ints.
+	ExprEnum
+	ExprMessage
+	ExprDuration
+	ExprTimestamp
+	ExprList
+	ExprMap
+)
+
+var exprKindNames = map[ExprKind]string{
+	ExprBool: "bool", ExprInt: "int", ExprUint: "uint", ExprDouble: "double",
+	ExprString: "string", ExprBytes: "bytes", ExprEnum: "enum", ExprMessage: "message",
+	ExprDuration: "duration", ExprTimestamp: "timestamp", ExprList: "list", ExprMap: "map",
+}
+
+// ExprType is the type of an expression. Values read from fields keep the
+// proto type of the field, e.g. int32 for an int, which generators need to
+// pick the type of the target language.
+type ExprType struct {
+	Kind      ExprKind
+	Elem, Key *ExprType
+	Enum      pgs.Enum
+	Message   pgs.Message
+	Proto     pgs.ProtoType
+}
+
+func (t *ExprType) String() string {
+	switch t.Kind {
+	case ExprList:
+		return fmt.Sprintf("list(%s)", t.Elem)
+	case ExprMap:
+		return fmt.Sprintf("map(%s, %s)", t.Key, t.Elem)
+	case ExprEnum:
+		return strings.TrimPrefix(t.Enum.FullyQualifiedName(), ".")
+	case ExprMessage:
+		return strings.TrimPrefix(t.Message.FullyQualifiedName(), ".")
+	}
+	return exprKindNames[t.Kind]
+}
+
+// Nodes only found in checked expressions, replacing the identifiers, field
+// selections and macros of the parsed one.
+type (
+	// FieldExpr reads Field of Operand, or of the validated message if
+	// Operand is nil. Singular wrapper fields are read as their value.
+	FieldExpr struct {
+		exprNode
+		Operand Expr
+		Field   pgs.Field
+	}
+
+	// HasExpr tests the presence of Field of Operand, or of the validated
+	// message if Operand is nil. Fields without presence are present if set
+	// to anything but their default, repeated and map fields if not empty.
+	HasExpr struct {
+		exprNode
+		Operand Expr
+		Field   pgs.Field
+	}
+
+	// EnumValueExpr is an enum value referenced by name.
+	EnumValueExpr struct {
+		exprNode
+		Value pgs.EnumValue
+	}
+
+	// VarExpr is the variable bound by a macro.
+	VarExpr struct {
+		exprNode
+		Name string
+	}
+
+	// NowExpr is the current time.
+	NowExpr struct {
+		exprNode
+	}
+
+	// ComprehensionExpr is the macro `Range.Macro(Var, Pred)`, Macro being
+	// all, exists or exists_one. Var ranges over the items of a list or the
+	// keys of a map.
+	ComprehensionExpr struct {
+		exprNode
+		Macro string
+		Range Expr
+		Var   string
+		Pred  Expr
+	}
+)
+
+// ExprRules returns the expression rules of msg.
+func ExprRules(msg pgs.Message) (rules []*validate.ExprRule, err error) {
+	_, err = msg.Extension(validate.E_Expr, &rules)
+	return
+}
+
+// CheckExpr parses src and checks it against the fields of msg. The checked
+// expression is a bool, its calls are normalized to take their receiver as
+// the first argument: size, int, uint, double, string, startsWith, endsWith,
+// contains and matches.
+func CheckExpr(msg pgs.Message, src string) (Expr, error) {
+	e, err := ParseExpr(src)
+	if err != nil {
+		return nil, err
+	}
+	c := &exprChecker{msg: msg, enums: enumValueScope(msg)}
+	if e, err = c.check(e); err != nil {
+		return nil, err
+	}
+	if e.Type().Kind != ExprBool {
+		return nil, &ExprError{e.Pos(), fmt.Sprintf("expression is a %s, not a bool", e.Type())}
+	}
+	return e, nil
+}
+
+// CheckedExprRule is an expression rule of a message with its checked
+// expression and the arguments of its error. Field is the field the rule
+// reports against, nil for the message itself.
+type CheckedExprRule struct {
+	*validate.ExprRule
+	Expr    Expr
+	Field   pgs.Field
+	ErrBase *validate.ErrorBase
+	Args    []ErrorArg
+}
+
+// CheckedExprRules returns the expression rules of msg with their checked
+// expressions, ready for a backend to render.
+func CheckedExprRules(msg pgs.Message) ([]CheckedExprRule, error) {
+	rules, err := ExprRules(msg)
+	if err != nil {
+		return nil, err
+	}
+	var base *validate.ErrorBase
+	if _, err = msg.Extension(validate.E_ErrorBase, &base); err != nil {
+		return nil, err
+	}
+
+	out := make([]CheckedExprRule, 0, len(rules))
+	for i, r := range rules {
+		e, err := CheckExpr(msg, r.GetExpression())
+		if err != nil {
+			return nil, fmt.Errorf("expression rule %d of %s: %w", i, msg.Name(), err)
+		}
+		name, f := msg.Name().String(), pgs.Field(nil)
+		if r.Field != nil {
+			name, f = r.GetField(), lookupField(msg, r.GetField())
+		}
+		args, err := ErrorArgs(name, r.ProtoReflect(), r.GetError())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, CheckedExprRule{ExprRule: r, Expr: e, Field: f, ErrBase: base, Args: args})
+	}
+	return out, nil
+}
+
+type exprVar struct {
+	name string
+	typ  *ExprType
+}
+
+type exprChecker struct {
+	msg   pgs.Message
+	enums map[string]pgs.EnumValue
+	vars  []exprVar
+}
+
+func errorf(e Expr, format string, args ...interface{}) error {
+	return &ExprError{e.Pos(), fmt.Sprintf(format, args...)}
+}
+
+func (c *exprChecker) check(e Expr) (Expr, error) {
+	switch e := e.(type) {
+	case *LitExpr:
+		e.typ = litType(e.Value)
+		return e, nil
+	case *IdentExpr:
+		return c.checkIdent(e)
+	case *SelectExpr:
+		return c.checkSelect(e)
+	case *CallExpr:
+		return c.checkCall(e)
+	case *UnaryExpr:
+		return c.checkUnary(e)
+	case *BinaryExpr:
+		return c.checkBinary(e)
+	case *CondExpr:
+		return c.checkCond(e)
+	case *ListExpr:
+		return c.checkList(e)
+	case *FieldExpr, *EnumValueExpr:
+		// already resolved
+		return e, nil
+	}
+	return nil, errorf(e, "unexpected expression")
+}
+
+func litType(v interface{}) *ExprType {
+	switch v.(type) {
+	case bool:
+		return &ExprType{Kind: ExprBool}
+	case int64:
+		return &ExprType{Kind: ExprInt}
+	case uint64:
+		return &ExprType{Kind: ExprUint}
+	case float64:
+		return &ExprType{Kind: ExprDouble}
+	case string:
+		return &ExprType{Kind: ExprString}
+	case []byte:
+		return &ExprType{Kind: ExprBytes}
+	case *durationpb.Duration:
+		return &ExprType{Kind: ExprDuration}
+	case *timestamppb.Timestamp:
+		return &ExprType{Kind: ExprTimestamp}
+	}
+	return nil
+}
+
+func (c *exprChecker) lookupVar(name string) *ExprType {
+	for i := len(c.vars) - 1; i >= 0; i-- {
+		if c.vars[i].name == name {
+			return c.vars[i].typ
+		}
+	}
+	return nil
+}
+
+func lookupField(msg pgs.Message, name string) pgs.Field {
+	for _, f := range msg.Fields() {
+		if f.Name().String() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (c *exprChecker) checkIdent(e *IdentExpr) (Expr, error) {
+	if t := c.lookupVar(e.Name); t != nil {
+		return &VarExpr{exprNode{e.pos, t}, e.Name}, nil
+	}
+	if f := lookupField(c.msg, e.Name); f != nil {
+		return &FieldExpr{exprNode{e.pos, fieldExprType(f)}, nil, f}, nil
+	}
+	if e.Name == "now" {
+		return &NowExpr{exprNode{e.pos, &ExprType{Kind: ExprTimestamp}}}, nil
+	}
+	if v, ok := c.lookupEnumValue(e.Name); ok {
+		return &EnumValueExpr{exprNode{e.pos, &ExprType{Kind: ExprEnum, Enum: v.Enum()}}, v}, nil
+	}
+	return nil, errorf(e, "undeclared reference to %q", e.Name)
+}
+
+// isRoot reports whether e is `this`, the validated message.
+func (c *exprChecker) isRoot(e Expr) bool {
+	id, ok := e.(*IdentExpr)
+	return ok && id.Name == "this" && c.lookupVar("this") == nil && lookupField(c.msg, "this") == nil
+}
+
+// qualifiedName returns the dotted name e spells, if it is one.
+func qualifiedName(e Expr) (string, bool) {
+	switch e := e.(type) {
+	case *IdentExpr:
+		return e.Name, true
+	case *SelectExpr:
+		name, ok := qualifiedName(e.Operand)
+		return name + "." + e.Name, ok
+	}
+	return "", false
+}
+
+// rootIdent returns the identifier a chain of selections starts with.
+func rootIdent(e Expr) *IdentExpr {
+	for {
+		switch x := e.(type) {
+		case *IdentExpr:
+			return x
+		case *SelectExpr:
+			e = x.Operand
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *exprChecker) checkSelect(e *SelectExpr) (Expr, error) {
+	var operand Expr
+	var msg pgs.Message
+	if c.isRoot(e.Operand) {
+		msg = c.msg
+	} else {
+		// a chain of names not starting with a field or variable names an
+		// enum value
+		if id := rootIdent(e); id != nil && c.lookupVar(id.Name) == nil && lookupField(c.msg, id.Name) == nil && id.Name != "now" && !c.isRoot(id) {
+			name, _ := qualifiedName(e)
+			if v, ok := c.lookupEnumValue(name); ok {
+				return &EnumValueExpr{exprNode{e.pos, &ExprType{Kind: ExprEnum, Enum: v.Enum()}}, v}, nil
+			}
+			return nil, errorf(e, "undeclared reference to %q", name)
+		}
+
+		var err error
+		if operand, err = c.check(e.Operand); err != nil {
+			return nil, err
+		}
+		if operand.Type().Kind != ExprMessage {
+			return nil, errorf(e, "cannot select field %q of a %s", e.Name, operand.Type())
+		}
+		msg = operand.Type().Message
+	}
+
+	f := lookupField(msg, e.Name)
+	if f == nil {
+		return nil, errorf(e, "message %s has no field %q", strings.TrimPrefix(msg.FullyQualifiedName(), "."), e.Name)
+	}
+	return &FieldExpr{exprNode{e.pos, fieldExprType(f)}, operand, f}, nil
+}
+
+func (c *exprChecker) checkCall(e *CallExpr) (Expr, error) {
+	switch e.Func {
+	case "has":
+		return c.checkHas(e)
+	case "all", "exists", "exists_one":
+		return c.checkComprehension(e)
+	case "duration", "timestamp":
+		return checkTimeLit(e)
+	}
+
+	args := e.Args
+	if e.Target != nil {
+		args = append([]Expr{e.Target}, args...)
+	}
+	for i := range args {
+		var err error
+		if args[i], err = c.check(args[i]); err != nil {
+			return nil, err
+		}
+	}
+	out := &CallExpr{exprNode{pos: e.pos}, e.Func, nil, args}
+
+	kinds := func(allowed ...ExprKind) error {
+		if len(args) != 1 {
+			return errorf(e, "%s takes a single argument", e.Func)
+		}
+		for _, k := range allowed {
+			if args[0].Type().Kind == k {
+				return nil
+			}
+		}
+		return errorf(e, "%s is not defined for a %s", e.Func, args[0].Type())
+	}
+
+	var err error
+	switch e.Func {
+	case "size":
+		err = kinds(ExprString, ExprBytes, ExprList, ExprMap)
+		out.typ = &ExprType{Kind: ExprInt}
+	case "int":
+		err = kinds(ExprInt, ExprUint, ExprDouble, ExprEnum, ExprString)
+		out.typ = &ExprType{Kind: ExprInt}
+	case "uint":
+		err = kinds(ExprInt, ExprUint, ExprDouble)
+		out.typ = &ExprType{Kind: ExprUint}
+	case "double":
+		err = kinds(ExprInt, ExprUint, ExprDouble)
+		out.typ = &ExprType{Kind: ExprDouble}
+	case "string":
+		err = kinds(ExprInt, ExprUint, ExprDouble, ExprBool, ExprString)
+		out.typ = &ExprType{Kind: ExprString}
+	case "startsWith", "endsWith", "contains", "matches":
+		if e.Target == nil || len(args) != 2 {
+			return nil, errorf(e, "%s is called as s.%s(string)", e.Func, e.Func)
+		}
+		if args[0].Type().Kind != ExprString || args[1].Type().Kind != ExprString {
+			return nil, errorf(e, "%s is only defined for strings", e.Func)
+		}
+		if lit, ok := args[1].(*LitExpr); ok && e.Func == "matches" {
+			if _, rerr := regexp.Compile(lit.Value.(string)); rerr != nil {
+				return nil, errorf(lit, "invalid pattern: %v", rerr)
+			}
+		}
+		out.typ = &ExprType{Kind: ExprBool}
+	default:
+		return nil, errorf(e, "undeclared function %q", e.Func)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exprChecker) checkHas(e *CallExpr) (Expr, error) {
+	if e.Target != nil || len(e.Args) != 1 {
+		return nil, errorf(e, "has takes a single field, e.g. has(a.b)")
+	}
+	var sel Expr
+	switch arg := e.Args[0].(type) {
+	case *IdentExpr:
+		if c.lookupVar(arg.Name) == nil {
+			sel = arg
+		}
+	case *SelectExpr:
+		sel = arg
+	}
+	if sel == nil {
+		return nil, errorf(e, "has takes a field, e.g. has(a.b)")
+	}
+
+	f, err := c.check(sel)
+	if err != nil {
+		return nil, err
+	}
+	fe, ok := f.(*FieldExpr)
+	if !ok {
+		return nil, errorf(e, "has takes a field, e.g. has(a.b)")
+	}
+	return &HasExpr{exprNode{e.pos, &ExprType{Kind: ExprBool}}, fe.Operand, fe.Field}, nil
+}
+
+func (c *exprChecker) checkComprehension(e *CallExpr) (Expr, error) {
+	if e.Target == nil || len(e.Args) != 2 {
+		return nil, errorf(e, "%s is called as x.%s(v, predicate)", e.Func, e.Func)
+	}
+	v, ok := e.Args[0].(*IdentExpr)
+	if !ok {
+		return nil, errorf(e.Args[0], "%s binds a variable name", e.Func)
+	}
+
+	rng, err := c.check(e.Target)
+	if err != nil {
+		return nil, err
+	}
+	var elem *ExprType
+	switch rng.Type().Kind {
+	case ExprList:
+		elem = rng.Type().Elem
+	case ExprMap:
+		elem = rng.Type().Key
+	default:
+		return nil, errorf(e, "%s is not defined for a %s", e.Func, rng.Type())
+	}
+
+	c.vars = append(c.vars, exprVar{v.Name, elem})
+	pred, err := c.check(e.Args[1])
+	c.vars = c.vars[:len(c.vars)-1]
+	if err != nil {
+		return nil, err
+	}
+	if pred.Type().Kind != ExprBool {
+		return nil, errorf(pred, "the predicate of %s is a %s, not a bool", e.Func, pred.Type())
+	}
+	return &ComprehensionExpr{exprNode{e.pos, &ExprType{Kind: ExprBool}}, e.Func, rng, v.Name, pred}, nil
+}
+
+// checkTimeLit turns duration("1h30m") and timestamp("2006-01-02T15:04:05Z")
+// into literals.
+func checkTimeLit(e *CallExpr) (Expr, error) {
+	var lit *LitExpr
+	if len(e.Args) == 1 && e.Target == nil {
+		lit, _ = e.Args[0].(*LitExpr)
+	}
+	s, ok := "", false
+	if lit != nil {
+		s, ok = lit.Value.(string)
+	}
+	if !ok {
+		return nil, errorf(e, "%s takes a string literal", e.Func)
+	}
+
+	if e.Func == "duration" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, errorf(lit, "invalid duration %q", s)
+		}
+		return &LitExpr{exprNode{e.pos, &ExprType{Kind: ExprDuration}}, durationpb.New(d)}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, errorf(lit, "invalid timestamp %q, expected RFC 3339", s)
+	}
+	return &LitExpr{exprNode{e.pos, &ExprType{Kind: ExprTimestamp}}, timestamppb.New(t)}, nil
+}
+
+func (c *exprChecker) checkUnary(e *UnaryExpr) (Expr, error) {
+	x, err := c.check(e.X)
+	if err != nil {
+		return nil, err
+	}
+	e.X = x
+	k := x.Type().Kind
+	switch {
+	case e.Op == "!" && k == ExprBool, e.Op == "-" && (k == ExprInt || k == ExprDouble || k == ExprDuration):
+		e.typ = &ExprType{Kind: k}
+		return e, nil
+	}
+	return nil, errorf(e, "operator %s is not defined for a %s", e.Op, x.Type())
+}
+
+func (c *exprChecker) checkCond(e *CondExpr) (Expr, error) {
+	var err error
+	if e.Cond, err = c.check(e.Cond); err != nil {
+		return nil, err
+	}
+	if e.Then, err = c.check(e.Then); err != nil {
+		return nil, err
+	}
+	if e.Else, err = c.check(e.Else); err != nil {
+		return nil, err
+	}
+	if e.Cond.Type().Kind != ExprBool {
+		return nil, errorf(e.Cond, "condition is a %s, not a bool", e.Cond.Type())
+	}
+	e.Then, e.Else = coerce(e.Then, e.Else)
+	if !sameType(e.Then.Type(), e.Else.Type()) {
+		return nil, errorf(e, "branches have different types %s and %s", e.Then.Type(), e.Else.Type())
+	}
+	e.typ = e.Then.Type()
+	return e, nil
+}
+
+func (c *exprChecker) checkList(e *ListExpr) (Expr, error) {
+	if len(e.Elems) == 0 {
+		return nil, errorf(e, "empty lists are not supported")
+	}
+	for i := range e.Elems {
+		var err error
+		if e.Elems[i], err = c.check(e.Elems[i]); err != nil {
+			return nil, err
+		}
+	}
+	// int literals of a list of doubles or uints are doubles or uints
+	for i := 1; i < len(e.Elems); i++ {
+		e.Elems[0], e.Elems[i] = coerce(e.Elems[0], e.Elems[i])
+	}
+	for _, x := range e.Elems[1:] {
+		if !sameType(e.Elems[0].Type(), x.Type()) {
+			return nil, errorf(x, "list mixes %s and %s", e.Elems[0].Type(), x.Type())
+		}
+	}
+	elem := *e.Elems[0].Type()
+	elem.Proto = 0
+	e.typ = &ExprType{Kind: ExprList, Elem: &elem}
+	return e, nil
+}
+
+func (c *exprChecker) checkBinary(e *BinaryExpr) (Expr, error) {
+	var err error
+	if e.X, err = c.check(e.X); err != nil {
+		return nil, err
+	}
+	if e.Y, err = c.check(e.Y); err != nil {
+		return nil, err
+	}
+	e.X, e.Y = coerce(e.X, e.Y)
+	x, y := e.X.Type(), e.Y.Type()
+
+	mismatch := errorf(e, "operator %s is not defined for %s and %s", e.Op, x, y)
+	switch e.Op {
+	case "&&", "||":
+		if x.Kind != ExprBool || y.Kind != ExprBool {
+			return nil, mismatch
+		}
+		e.typ = &ExprType{Kind: ExprBool}
+	case "==", "!=":
+		if !comparable(x, y) {
+			return nil, mismatch
+		}
+		e.typ = &ExprType{Kind: ExprBool}
+	case "<", "<=", ">", ">=":
+		if !comparable(x, y) || !ordered(x.Kind) {
+			return nil, mismatch
+		}
+		e.typ = &ExprType{Kind: ExprBool}
+	case "in":
+		var elem *ExprType
+		switch y.Kind {
+		case ExprList:
+			elem = y.Elem
+		case ExprMap:
+			elem = y.Key
+		default:
+			return nil, mismatch
+		}
+		if lit, ok := e.X.(*LitExpr); ok {
+			e.X = coerceLit(lit, elem.Kind)
+		}
+		if !comparable(e.X.Type(), elem) {
+			return nil, mismatch
+		}
+		e.typ = &ExprType{Kind: ExprBool}
+	default:
+		if e.typ = arithmetic(e.Op, x, y); e.typ == nil {
+			return nil, mismatch
+		}
+	}
+	return e, nil
+}
+
+// arithmetic returns the type of `x op y`, nil if op isn't defined for x and
+// y.
+func arithmetic(op string, x, y *ExprType) *ExprType {
+	switch {
+	case x.Kind == ExprTimestamp && y.Kind == ExprDuration && (op == "+" || op == "-"),
+		x.Kind == ExprDuration && y.Kind == ExprTimestamp && op == "+":
+		return &ExprType{Kind: ExprTimestamp}
+	case x.Kind == ExprTimestamp && y.Kind == ExprTimestamp && op == "-",
+		x.Kind == ExprDuration && y.Kind == ExprDuration && (op == "+" || op == "-"):
+		return &ExprType{Kind: ExprDuration}
+	case x.Kind != y.Kind:
+		return nil
+	}
+	switch x.Kind {
+	case ExprInt, ExprUint:
+		return &ExprType{Kind: x.Kind}
+	case ExprDouble:
+		if op != "%" {
+			return &ExprType{Kind: x.Kind}
+		}
+	case ExprString, ExprBytes:
+		if op == "+" {
+			return &ExprType{Kind: x.Kind}
+		}
+	}
+	return nil
+}
+
+func ordered(k ExprKind) bool {
+	switch k {
+	case ExprInt, ExprUint, ExprDouble, ExprEnum, ExprString, ExprDuration, ExprTimestamp:
+		return true
+	}
+	return false
+}
+
+// comparable reports whether x and y can be compared, ints being comparable
+// with doubles and enum values.
+func comparable(x, y *ExprType) bool {
+	if sameType(x, y) {
+		return true
+	}
+	numeric := func(k ExprKind) bool { return k == ExprInt || k == ExprDouble || k == ExprEnum }
+	if x.Kind == ExprEnum && y.Kind == ExprEnum {
+		return false
+	}
+	if x.Kind == ExprDouble && y.Kind == ExprEnum || x.Kind == ExprEnum && y.Kind == ExprDouble {
+		return false
+	}
+	return numeric(x.Kind) && numeric(y.Kind)
+}
+
+func sameType(x, y *ExprType) bool {
+	if x.Kind != y.Kind {
+		return false
+	}
+	switch x.Kind {
+	case ExprEnum:
+		return x.Enum.FullyQualifiedName() == y.Enum.FullyQualifiedName()
+	case ExprMessage:
+		return x.Message.FullyQualifiedName() == y.Message.FullyQualifiedName()
+	case ExprList:
+		return sameType(x.Elem, y.Elem)
+	case ExprMap:
+		return sameType(x.Key, y.Key) && sameType(x.Elem, y.Elem)
+	}
+	return true
+}
+
+// coerce retypes an int literal compared with or combined with a uint or
+// double as a uint or double literal, so that `count > 0` holds for a uint32
+// count.
+func coerce(x, y Expr) (Expr, Expr) {
+	if lit, ok := x.(*LitExpr); ok {
+		x = coerceLit(lit, y.Type().Kind)
+	}
+	if lit, ok := y.(*LitExpr); ok {
+		y = coerceLit(lit, x.Type().Kind)
+	}
+	return x, y
+}
+
+func coerceLit(lit *LitExpr, to ExprKind) Expr {
+	v, ok := lit.Value.(int64)
+	if !ok {
+		return lit
+	}
+	switch {
+	case to == ExprUint && v >= 0:
+		return &LitExpr{exprNode{lit.pos, &ExprType{Kind: ExprUint}}, uint64(v)}
+	case to == ExprDouble:
+		return &LitExpr{exprNode{lit.pos, &ExprType{Kind: ExprDouble}}, float64(v)}
+	}
+	return lit
+}
+
+// fieldExprType returns the type f is read as. Singular wrappers are read as
+// their value.
+func fieldExprType(f pgs.Field) *ExprType {
+	t := f.Type()
+	switch {
+	case t.IsMap():
+		return &ExprType{Kind: ExprMap, Key: elemExprType(t.Key(), false), Elem: elemExprType(t.Element(), false)}
+	case t.IsRepeated():
+		return &ExprType{Kind: ExprList, Elem: elemExprType(t.Element(), false)}
+	}
+	return elemExprType(t, true)
+}
+
+type exprTypeElem interface {
+	ProtoType() pgs.ProtoType
+	IsEmbed() bool
+	Embed() pgs.Message
+	IsEnum() bool
+	Enum() pgs.Enum
+}
+
+func elemExprType(t exprTypeElem, unwrap bool) *ExprType {
+	if t.IsEnum() {
+		return &ExprType{Kind: ExprEnum, Enum: t.Enum(), Proto: pgs.EnumT}
+	}
+	if !t.IsEmbed() {
+		return scalarExprType(t.ProtoType())
+	}
+
+	switch wkt := t.Embed().WellKnownType(); wkt {
+	case pgs.TimestampWKT:
+		return &ExprType{Kind: ExprTimestamp}
+	case pgs.DurationWKT:
+		return &ExprType{Kind: ExprDuration}
+	case pgs.DoubleValueWKT, pgs.FloatValueWKT, pgs.Int64ValueWKT, pgs.UInt64ValueWKT,
+		pgs.Int32ValueWKT, pgs.UInt32ValueWKT, pgs.BoolValueWKT, pgs.StringValueWKT, pgs.BytesValueWKT:
+		if unwrap {
+			return scalarExprType(t.Embed().Fields()[0].Type().ProtoType())
+		}
+	}
+	return &ExprType{Kind: ExprMessage, Message: t.Embed()}
+}
+
+func scalarExprType(pt pgs.ProtoType) *ExprType {
+	t := &ExprType{Proto: pt}
+	switch pt {
+	case pgs.BoolT:
+		t.Kind = ExprBool
+	case pgs.Int32T, pgs.Int64T, pgs.SInt32, pgs.SInt64, pgs.SFixed32, pgs.SFixed64:
+		t.Kind = ExprInt
+	case pgs.UInt32T, pgs.UInt64T, pgs.Fixed32T, pgs.Fixed64T:
+		t.Kind = ExprUint
+	case pgs.FloatT, pgs.DoubleT:
+		t.Kind = ExprDouble
+	case pgs.StringT:
+		t.Kind = ExprString
+	case pgs.BytesT:
+		t.Kind = ExprBytes
+	}
+	return t
+}
+
+// lookupEnumValue resolves name the way protoc resolves type names, searching
+// the scope of the message outwards. Values are named either after their
+// enum, `Type.TYPE_USER`, or as siblings of the enum, `TYPE_USER`.
+func (c *exprChecker) lookupEnumValue(name string) (pgs.EnumValue, bool) {
+	if strings.HasPrefix(name, ".") {
+		v, ok := c.enums[name]
+		return v, ok
+	}
+	scope := c.msg.FullyQualifiedName()
+	for {
+		if v, ok := c.enums[scope+"."+name]; ok {
+			return v, true
+		}
+		if scope == "" {
+			return nil, false
+		}
+		scope = scope[:strings.LastIndex(scope, ".")]
+	}
+}
+
+// enumValueScope indexes the enum values visible to msg by their fully
+// qualified names.
+func enumValueScope(msg pgs.Message) map[string]pgs.EnumValue {
+	files := append([]pgs.File{msg.File()}, msg.File().Imports()...)
+	files = append(files, msg.Package().Files()...)
+
+	out := map[string]pgs.EnumValue{}
+	for _, f := range files {
+		for _, enum := range f.AllEnums() {
+			fqn := enum.FullyQualifiedName()
+			parent := fqn[:strings.LastIndex(fqn, ".")]
+			for _, v := range enum.Values() {
+				out[fqn+"."+v.Name().String()] = v
+				out[parent+"."+v.Name().String()] = v
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// dump renders a parsed expression with explicit parentheses.
+func dump(e Expr) string {
+	switch e := e.(type) {
+	case *LitExpr:
+		return fmt.Sprintf("%#v", e.Value)
+	case *IdentExpr:
+		return e.Name
+	case *SelectExpr:
+		return dump(e.Operand) + "." + e.Name
+	case *CallExpr:
+		args := make([]string, len(e.Args))
+		for i, a := range e.Args {
+			args[i] = dump(a)
+		}
+		call := e.Func + "(" + strings.Join(args, ", ") + ")"
+		if e.Target != nil {
+			return dump(e.Target) + "." + call
+		}
+		return call
+	case *UnaryExpr:
+		return e.Op + dump(e.X)
+	case *BinaryExpr:
+		return "(" + dump(e.X) + " " + e.Op + " " + dump(e.Y) + ")"
+	case *CondExpr:
+		return "(" + dump(e.Cond) + " ? " + dump(e.Then) + " : " + dump(e.Else) + ")"
+	case *ListExpr:
+		elems := make([]string, len(e.Elems))
+		for i, x := range e.Elems {
+			elems[i] = dump(x)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	}
+	return "?"
+}
+
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{"end_time > start_time", "(end_time > start_time)"},
+		{"a || b && !c", "(a || (b && !c))"},
+		{"a + b * c - d % 2 == 0", "(((a + (b * c)) - (d % 2)) == 0)"},
+		{"x in [1, 2u, 3.5] ? 'a' : b\"\\x01\"", "((x in [1, 0x2, 3.5]) ? \"a\" : []byte{0x1})"},
+		{"-9223372036854775808 < -x", "(-9223372036854775808 < -x)"},
+		{"has(a.b) && size(s) > 0 && s.matches(r'\\d+')", "((has(a.b) && (size(s) > 0)) && s.matches(\"\\\\d+\"))"},
+		{"items.all(i, i.qty > 0x10)", "items.all(i, (i.qty > 16))"},
+	}
+	for _, tt := range tests {
+		e, err := ParseExpr(tt.src)
+		if err != nil {
+			t.Errorf("ParseExpr(%q): %v", tt.src, err)
+			continue
+		}
+		if got := dump(e); got != tt.want {
+			t.Errorf("ParseExpr(%q) = %s; want %s", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestParseExprErrors(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{"a >", "4: unexpected end of expression"},
+		{"(a", "3: expected \")\", found end of expression"},
+		{"a[0]", "2: indexing is not supported"},
+		{"a == null", "6: null is not supported"},
+		{"'abc", "1: unterminated string literal"},
+		{"9223372036854775808", "1: int literal 9223372036854775808 overflows"},
+		{"a # b", "3: unexpected character '#'"},
+	}
+	for _, tt := range tests {
+		_, err := ParseExpr(tt.src)
+		if err == nil || !strings.HasPrefix(err.Error(), tt.want) {
+			t.Errorf("ParseExpr(%q) = %v; want %s", tt.src, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package shared
+
+import (
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+// DeclaredGroups returns the validation groups declared by f.
+func DeclaredGroups(f pgs.File) (groups []string, err error) {
+	_, err = f.Extension(validate.E_Groups, &groups)
+	return
+}
+
+// RuleGroups returns the validation groups of the rule message r: its own and
+// the ones of its error, without duplicates. A rule without groups always
+// applies.
+func RuleGroups(r proto.Message) []string {
+	if r == nil {
+		return nil
+	}
+	var out []string
+	seen := map[string]bool{}
+	add := func(groups []string) {
+		for _, g := range groups {
+			if !seen[g] {
+				seen[g] = true
+				out = append(out, g)
+			}
+		}
+	}
+
+	rr := r.ProtoReflect()
+	fields := rr.Descriptor().Fields()
+	if fd := fields.ByName("groups"); fd != nil && fd.IsList() && fd.Kind() == protoreflect.StringKind {
+		add(stringList(rr.Get(fd).List()))
+	}
+	if fd := fields.ByName("error"); fd != nil && rr.Has(fd) {
+		if e, ok := rr.Get(fd).Message().Interface().(*validate.Error); ok {
+			add(e.GetGroups())
+		}
+	}
+	return out
+}
+
+// WalkGroups calls fn with every rule message reachable from rules that
+// belongs to a validation group, and its groups.
+func WalkGroups(rules proto.Message, fn func(rule protoreflect.Message, groups []string)) {
+	if rules == nil {
+		return
+	}
+	visit := func(rule protoreflect.Message) {
+		if groups := RuleGroups(rule.Interface()); len(groups) > 0 {
+			fn(rule, groups)
+		}
+	}
+	visit(rules.ProtoReflect())
+	walkRules(rules, func(_ protoreflect.Message, v proto.Message) {
+		if _, ok := v.(*validate.Error); !ok {
+			visit(v.ProtoReflect())
+		}
+	})
+}
+
+func stringList(l protoreflect.List) []string {
+	out := make([]string, l.Len())
+	for i := range out {
+		out[i] = l.Get(i).String()
+	}
+	return out
+}
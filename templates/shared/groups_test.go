@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+func TestRuleGroups(t *testing.T) {
+	tests := []struct {
+		rule proto.Message
+		want []string
+	}{
+		{&validate.StringRule{}, nil},
+		{(*validate.OneOf)(nil), nil},
+		{&validate.StringRule{Groups: []string{"create"}}, []string{"create"}},
+		{&validate.Int32Rule{
+			Groups: []string{"create", "update"},
+			Error:  &validate.Error{Method: proto.String("e"), Groups: []string{"update", "patch"}},
+		}, []string{"create", "update", "patch"}},
+		{&validate.ExprRule{Error: &validate.Error{Method: proto.String("e"), Groups: []string{"update"}}}, []string{"update"}},
+	}
+	for _, tt := range tests {
+		if got := RuleGroups(tt.rule); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RuleGroups(%v) = %v, want %v", tt.rule, got, tt.want)
+		}
+	}
+}
@@ -38,7 +38,7 @@ func Needs(m pgs.Message, wk WellKnown) bool {
 		switch {
 		case f.Type().IsRepeated() && f.Type().Element().ProtoType() == pgs.StringT:
 			var strRule *validate.StringRules
-			for _, rr := range rules.GetRepeated().Rules {
+			for _, rr := range rules.GetRepeated().GetRules() {
 				if rr.GetItems() != nil {
 					strRule = rr.GetItems().GetString_()
 				}
@@ -50,7 +50,7 @@ func Needs(m pgs.Message, wk WellKnown) bool {
 
 			if f.Type().Key().ProtoType() == pgs.StringT {
 				var strRule *validate.StringRules
-				for _, rr := range rules.GetMap().Rules {
+				for _, rr := range rules.GetMap().GetRules() {
 					if rr.Keys != nil {
 						strRule = rr.GetKeys().GetString_()
 					}
@@ -61,8 +61,8 @@ func Needs(m pgs.Message, wk WellKnown) bool {
 			}
 			if f.Type().Element().ProtoType() == pgs.StringT {
 				var strRule *validate.StringRules
-				for _, rr := range rules.GetMap().Rules {
-					if rr.Keys != nil {
+				for _, rr := range rules.GetMap().GetRules() {
+					if rr.Values != nil {
 						strRule = rr.GetValues().GetString_()
 					}
 				}
@@ -85,7 +85,7 @@ func Needs(m pgs.Message, wk WellKnown) bool {
 }
 
 func strRulesNeeds(rules *validate.StringRules, wk WellKnown) bool {
-	for _, rule := range rules.Rules {
+	for _, rule := range rules.GetRules() {
 		switch wk {
 		case Email:
 			if rule.GetEmail() {
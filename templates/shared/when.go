@@ -0,0 +1,107 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+var whenOps = map[validate.When_Op]string{
+	validate.When_EQ:  "==",
+	validate.When_NE:  "!=",
+	validate.When_LT:  "<",
+	validate.When_LTE: "<=",
+	validate.When_GT:  ">",
+	validate.When_GTE: ">=",
+}
+
+// WalkWhens calls fn with every When reachable from rules and the rule
+// message declaring it.
+func WalkWhens(rules proto.Message, fn func(rule protoreflect.Message, w *validate.When)) {
+	walkRules(rules, func(rule protoreflect.Message, v proto.Message) {
+		if w, ok := v.(*validate.When); ok {
+			fn(rule, w)
+		}
+	})
+}
+
+// WhenExpr returns the condition w as a checked expression over the fields of
+// msg, the message declaring the field the rule applies to.
+func WhenExpr(msg pgs.Message, w *validate.When) (Expr, error) {
+	e, err := whenExpr(msg, w)
+	var exprErr *ExprError
+	if errors.As(err, &exprErr) {
+		err = errors.New(exprErr.Msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("when %s: %w", w.GetField(), err)
+	}
+	return e, nil
+}
+
+func whenExpr(msg pgs.Message, w *validate.When) (Expr, error) {
+	path, err := ParseExpr(w.GetField())
+	if err != nil {
+		return nil, fmt.Errorf("invalid field path")
+	}
+	if _, ok := qualifiedName(path); !ok {
+		return nil, fmt.Errorf("invalid field path")
+	}
+	c := &exprChecker{msg: msg, enums: enumValueScope(msg)}
+
+	switch w.GetOp() {
+	case validate.When_SET, validate.When_UNSET:
+		if w.Value != nil {
+			return nil, fmt.Errorf("%s compares to no value", w.GetOp())
+		}
+		has, err := c.checkHas(&CallExpr{Func: "has", Args: []Expr{path}})
+		if err != nil || w.GetOp() == validate.When_SET {
+			return has, err
+		}
+		return &UnaryExpr{exprNode{typ: has.Type()}, "!", has}, nil
+	}
+
+	field, err := c.check(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := field.(*FieldExpr); !ok {
+		return nil, fmt.Errorf("not a field")
+	}
+
+	var value Expr
+	switch v := w.Value.(type) {
+	case *validate.When_Int:
+		value = &LitExpr{Value: v.Int}
+	case *validate.When_Uint:
+		value = &LitExpr{Value: v.Uint}
+	case *validate.When_Double:
+		value = &LitExpr{Value: v.Double}
+	case *validate.When_Bool:
+		value = &LitExpr{Value: v.Bool}
+	case *validate.When_String_:
+		value = &LitExpr{Value: v.String_}
+	case *validate.When_Enum:
+		t := field.Type()
+		if t.Kind != ExprEnum {
+			return nil, fmt.Errorf("a %s has no enum values", t)
+		}
+		for _, ev := range t.Enum.Values() {
+			if ev.Name().String() == v.Enum {
+				value = &EnumValueExpr{exprNode{typ: t}, ev}
+			}
+		}
+		if value == nil {
+			return nil, fmt.Errorf("enum %s has no value %s", t, v.Enum)
+		}
+	default:
+		return nil, fmt.Errorf("%s compares to a value", w.GetOp())
+	}
+
+	return c.checkBinary(&BinaryExpr{Op: whenOps[w.GetOp()], X: field, Y: value})
+}
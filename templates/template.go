@@ -8,6 +8,8 @@ import (
 	"text/template"
 
 	pgs "github.com/lyft/protoc-gen-star"
+
+	"github.com/curl-li/protoc-gen-validate/templates/java"
 )
 
 type WrapTemplate struct {
@@ -25,6 +27,9 @@ func (wt *WrapTemplate) Execute(w io.Writer, data interface{}) error {
 		return err
 	}
 	if err := wt.formatCode(out, w); err != nil {
+		if errors.Is(err, java.ErrFormatToolUnverified) {
+			return err
+		}
 		wt.Logf("format code failed, %v", err)
 		// fallback
 		return wt.Template.Execute(w, data)
@@ -37,7 +42,7 @@ func (wt *WrapTemplate) formatCode(r io.Reader, w io.Writer) error {
 	if !ok {
 		return errors.New("pgs template is not *template.Template")
 	}
-	formatter := CodeFormatterFor(tpl)
+	formatter := CodeFormatterFor(tpl, wt.Parameters())
 	if formatter == nil {
 		return fmt.Errorf("unsupported formatter for code: %s", tpl.Name())
 	}
@@ -0,0 +1,36 @@
+package ts
+
+const anyConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "InLookup" }} = new Set<string>([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+]);
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NotInLookup" }} = new Set<string>([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+]);
+{{- end }}
+{{- end }}
+`
+
+const anyTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules }}
+	switch (true) {
+	{{- if $r.GetRequired }}
+	case {{ $val }} === undefined:
+		{{ fail $ctx "required" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.In }}
+	case {{ $val }} !== undefined && !{{ constantName $ctx $index "InLookup" }}.has({{ $val }}.typeUrl):
+		{{ fail $ctx "in" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ $val }} !== undefined && {{ constantName $ctx $index "NotInLookup" }}.has({{ $val }}.typeUrl):
+		{{ fail $ctx "not_in" $r.GetError }}
+		break;
+	{{- end }}
+	}
+{{ end -}}
+`
@@ -0,0 +1,97 @@
+package ts
+
+const bytesConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "InLookup" }} = [
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end -}}
+];
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NotInLookup" }} = [
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ bytesLit $v }}{{ end -}}
+];
+{{- end }}
+{{- if $r.Pattern }}
+const {{ constantName $ctx $index "Pattern" }} = new RegExp({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const bytesTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if constrained $r }}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	switch (true) {
+	{{- if $r.Const }}
+	case !pgv.bytesEqual({{ $val }}, {{ bytesLit $r.GetConst }}):
+		{{ fail $ctx "const" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.In }}
+	case !pgv.bytesIn({{ $val }}, {{ constantName $ctx $index "InLookup" }}):
+		{{ fail $ctx "in" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.NotIn }}
+	case pgv.bytesIn({{ $val }}, {{ constantName $ctx $index "NotInLookup" }}):
+		{{ fail $ctx "not_in" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Len }}
+	case {{ $val }}.length !== {{ $r.GetLen }}:
+		{{ fail $ctx "len" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MinLen }}
+	case {{ $val }}.length < {{ $r.GetMinLen }}:
+		{{ fail $ctx "min_len" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MaxLen }}
+	case {{ $val }}.length > {{ $r.GetMaxLen }}:
+		{{ fail $ctx "max_len" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Pattern }}
+	case !{{ constantName $ctx $index "Pattern" }}.test(pgv.utf8({{ $val }})):
+		{{ fail $ctx "pattern" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Prefix }}
+	case !pgv.hasPrefix({{ $val }}, {{ bytesLit $r.GetPrefix }}):
+		{{ fail $ctx "prefix" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Suffix }}
+	case !pgv.hasSuffix({{ $val }}, {{ bytesLit $r.GetSuffix }}):
+		{{ fail $ctx "suffix" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Contains }}
+	case !pgv.bytesContain({{ $val }}, {{ bytesLit $r.GetContains }}):
+		{{ fail $ctx "contains" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetIp }}
+	case {{ $val }}.length !== 4 && {{ $val }}.length !== 16:
+		{{ fail $ctx "ip" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	case {{ $val }}.length !== 4:
+		{{ fail $ctx "ipv4" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	case {{ $val }}.length !== 16:
+		{{ fail $ctx "ipv6" $r.GetError }}
+		break;
+	{{- end }}
+	}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{- end }}
+{{ end -}}
+`
@@ -0,0 +1,53 @@
+package ts
+
+const durationConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "InLookup" }} = new Set<bigint>([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end -}}
+]);
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NotInLookup" }} = new Set<bigint>([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ nanosLit $v }}{{ end -}}
+]);
+{{- end }}
+{{- end }}
+`
+
+const durationTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if $r.GetRequired }}
+	if ({{ accessor $ctx }} === undefined) {
+		{{ fail $ctx "required" $r.GetError }}
+	} else {
+	{{- else }}
+	if ({{ accessor $ctx }} !== undefined) {
+	{{- end }}
+		const d = {{ accessor $ctx }};
+		switch (true) {
+		case !pgv.isValidDuration(d):
+			{{ fail $ctx "valid" $r.GetError }}
+			break;
+		{{- if $r.Const }}
+		case pgv.nanos(d) !== {{ nanosLit $r.GetConst }}:
+			{{ fail $ctx "const" $r.GetError }}
+			break;
+		{{- end }}
+		{{- with rangeCond $ctx "pgv.nanos(d)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		case {{ . }}:
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+			break;
+		{{- end }}
+		{{- if $r.In }}
+		case !{{ constantName $ctx $index "InLookup" }}.has(pgv.nanos(d)):
+			{{ fail $ctx "in" $r.GetError }}
+			break;
+		{{- end }}
+		{{- if $r.NotIn }}
+		case {{ constantName $ctx $index "NotInLookup" }}.has(pgv.nanos(d)):
+			{{ fail $ctx "not_in" $r.GetError }}
+			break;
+		{{- end }}
+		}
+	}
+{{ end -}}
+`
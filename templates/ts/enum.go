@@ -0,0 +1,39 @@
+package ts
+
+const enumConstTpl = `{{ $r := .Rules -}}
+{{- if $r.In }}
+const {{ constantName . 0 "InLookup" }} = new Set<number>([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}}
+]);
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName . 0 "NotInLookup" }} = new Set<number>([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}}
+]);
+{{- end }}
+`
+
+const enumTpl = `{{ $r := .Rules }}{{ $val := accessor . }}
+	switch (true) {
+	{{- if $r.Const }}
+	case {{ $val }} !== {{ $r.GetConst }}:
+		{{ fail . "const" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetDefinedOnly }}
+	case {{ enumName . }}[{{ $val }}] === undefined:
+		{{ fail . "defined_only" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName . 0 "InLookup" }}.has({{ $val }}):
+		{{ fail . "in" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName . 0 "NotInLookup" }}.has({{ $val }}):
+		{{ fail . "not_in" $r.GetError }}
+		break;
+	{{- end }}
+	}
+`
@@ -0,0 +1,12 @@
+package ts
+
+const fileTpl = `// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: {{ .InputPath }}
+/* eslint-disable */
+{{ range imports . }}
+import {{ .Clause }} from {{ lit .Path }};
+{{- end }}
+{{ range .AllMessages }}
+	{{- template "msg" . }}
+{{ end }}
+`
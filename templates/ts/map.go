@@ -0,0 +1,46 @@
+package ts
+
+const mapConstTpl = `{{ renderConstants (.Key "" "Key") }}
+{{- renderConstants (.Elem "" "Value") -}}
+`
+
+const mapTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	switch (true) {
+	{{- if $r.MinPairs }}
+	case Object.keys({{ $val }}).length < {{ $r.GetMinPairs }}:
+		{{ fail $ctx "min_pairs" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MaxPairs }}
+	case Object.keys({{ $val }}).length > {{ $r.GetMaxPairs }}:
+		{{ fail $ctx "max_pairs" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetNoSparse }}
+	case Object.values({{ $val }}).some((val) => val === undefined):
+		{{ fail $ctx "no_sparse" $r.GetError }}
+		break;
+	{{- end }}
+	}
+	{{- if or $r.GetKeys $r.GetValues }}
+	v.entries({{ lit (fieldPath $ctx) }}, {{ $val }}, ({{ if $r.GetKeys }}k{{ else }}_{{ end }}{{ if $r.GetValues }}, val{{ end }}) => {
+		{{- if $r.GetKeys }}
+		const key = {{ mapKey $ctx }};
+		{{- render ($ctx.KeyWithErrIndex "key" "Key" $index) }}
+		{{- end }}
+		{{- if $r.GetValues }}
+		{{- render ($ctx.ElemWithErrIndex "val" "Value" $index) }}
+		{{- end }}
+	});
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasValues .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	v.entries({{ lit (fieldPath .) }}, {{ $val }}, (_, val) => {{ checkName (embedded .) }}(val, v));
+{{- end }}
+`
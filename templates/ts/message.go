@@ -0,0 +1,29 @@
+package ts
+
+const messageTpl = `{{ $f := .Field }}{{ $r := .Rules }}
+	{{- if .MessageRules.GetSkip }}
+	// skipping validation for {{ $f.Name }}
+	{{- else -}}
+	{{- if $r.GetRequired }}
+	if ({{ accessor . }} === undefined) {
+		{{ fail . "required" $r.GetError }}
+	}
+	{{- end }}
+	{{- if validated (embedded .) }}
+	v.nested({{ lit (fieldPath .) }}, {{ accessor . }}, {{ checkName (embedded .) }});
+	{{- end }}
+	{{- end -}}
+`
+
+const wrapperConstTpl = `{{ renderConstants (unwrap .) }}`
+
+const wrapperTpl = `
+	if ({{ accessor . }} !== undefined) {
+		const wrapper = {{ accessor . }};
+		{{- render (unwrap .) }}
+	}
+	{{- if .MessageRules.GetRequired }} else {
+		{{ fail . "required" .MessageRules.GetError }}
+	}
+	{{- end }}
+`
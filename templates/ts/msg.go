@@ -0,0 +1,61 @@
+package ts
+
+const msgTpl = `
+{{ if not (ignored .) -}}
+{{ $ctx := . -}}
+{{ range validatedFields . -}}
+	{{ renderConstants (context $ctx .) }}
+{{- end -}}
+{{ template "oneOfConst" . }}
+/**
+ * Reports the violations of the rules of {{ msgTyp . }} to v.
+ */
+export function {{ checkName . }}(m: {{ msgTyp . }}, v: pgv.Violations): void {
+	{{- if disabled . }}
+	// validation is disabled for {{ msgTyp . }}
+	{{- else }}
+	{{- range validatedFields . }}
+	{{ template "field" (context $ctx .) }}
+	{{- end }}
+	{{- template "oneOf" . }}
+	{{- end }}
+}
+
+/**
+ * Validates {{ msgTyp . }}, throwing the error of the first failed rule.
+ */
+export function validate{{ msgTyp . }}(m: {{ msgTyp . }}, opts?: pgv.ValidateOptions): void {
+	pgv.validate({{ checkName . }}, m, {{ options }});
+}
+
+/**
+ * Validates {{ msgTyp . }}, returning every failed rule.
+ */
+export function validate{{ msgTyp . }}All(m: {{ msgTyp . }}, opts?: pgv.ValidateOptions): pgv.Violation[] {
+	return pgv.validateAll({{ checkName . }}, m, {{ options }});
+}
+{{- end -}}
+`
+
+// fieldTpl gates the rules of a proto3 optional field on it being set, and
+// reports its required rule otherwise.
+const fieldTpl = `{{ with optionalProp . -}}
+{{ $prop := . -}}
+{{ if constrained $.Rules }}
+	if ({{ $prop }} !== undefined) {
+		{{ render $ }}
+	}
+	{{- with failRequired $ }} else {
+		{{ . }}
+	}
+	{{- end }}
+{{- else -}}
+	{{ with failRequired $ }}
+	if ({{ $prop }} === undefined) {
+		{{ . }}
+	}
+	{{- end }}
+{{- end }}
+{{- else -}}
+	{{ render . }}
+{{- end }}`
@@ -0,0 +1,3 @@
+package ts
+
+const noneTpl = `// no validation rules for {{ .Field.Name }}`
@@ -0,0 +1,49 @@
+package ts
+
+const numConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "InLookup" }} = new Set<{{ setType $ctx }}>([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ numLit $ctx $v }}{{ end -}}
+]);
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NotInLookup" }} = new Set<{{ setType $ctx }}>([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ numLit $ctx $v }}{{ end -}}
+]);
+{{- end }}
+{{- end }}
+`
+
+const numTpl = `{{ $ctx := . }}{{ $val := numVal . (accessor .) }}{{ range $index, $r := .Rules.Rules -}}
+{{- if constrained $r }}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	switch (true) {
+	{{- if $r.Const }}
+	case {{ $val }} !== {{ numLit $ctx $r.GetConst }}:
+		{{ fail $ctx "const" $r.GetError }}
+		break;
+	{{- end }}
+	{{- with rangeCond $ctx $val $r.Lt $r.Lte $r.Gt $r.Gte }}
+	case {{ . }}:
+		{{ fail $ctx (boundsRule $r) $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName $ctx $index "InLookup" }}.has({{ $val }}):
+		{{ fail $ctx "in" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName $ctx $index "NotInLookup" }}.has({{ $val }}):
+		{{ fail $ctx "not_in" $r.GetError }}
+		break;
+	{{- end }}
+	}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{- end }}
+{{ end -}}
+`
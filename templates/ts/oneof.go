@@ -0,0 +1,21 @@
+package ts
+
+const oneOfConstTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+{{- range .Fields }}{{ renderConstants (context $msg .) }}{{ end -}}
+{{- end -}}
+`
+
+const oneOfTpl = `{{ $msg := . }}{{ range .RealOneOfs }}
+	switch ({{ oneofKind . }}) {
+	{{- range .Fields }}
+	case {{ oneofCase . }}:
+		{{ render (context $msg .) }}
+		break;
+	{{- end }}
+	{{- if (oneofRule .).GetRequired }}
+	default:
+		{{ failOneOf $msg . }}
+	{{- end }}
+	}
+{{- end -}}
+`
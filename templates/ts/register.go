@@ -0,0 +1,939 @@
+package ts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curl-li/protoc-gen-validate/templates/shared"
+	"github.com/curl-li/protoc-gen-validate/validate"
+)
+
+const (
+	// defaultRuntime is the module generated files import the runtime from,
+	// see ts/pgv-ts-stub.
+	defaultRuntime = "pgv-ts-stub"
+
+	// LongTypeParam mirrors the long_type_* options of protobuf-ts: 64-bit
+	// integers are `bigint` (default), `string` or `number`.
+	LongTypeParam = "ts_long_type"
+	// RuntimeParam overrides the module the runtime is imported from.
+	RuntimeParam = "ts_runtime"
+	// ErrorFactoryParam names a module exporting an `errorFactory`, used by
+	// default to build the errors of failed rules.
+	ErrorFactoryParam = "ts_error_factory"
+)
+
+func Register(tpl *template.Template, params pgs.Parameters) {
+	fns := tsFuncs{
+		longType:     params.StrDefault(LongTypeParam, "bigint"),
+		runtime:      params.StrDefault(RuntimeParam, defaultRuntime),
+		errorFactory: params.Str(ErrorFactoryParam),
+	}
+
+	tpl.Funcs(map[string]interface{}{
+		"accessor":        fns.accessor,
+		"boundsRule":      shared.BoundsRule,
+		"bytesLit":        fns.bytesLit,
+		"checkName":       fns.checkName,
+		"constantName":    fns.constantName,
+		"constrained":     shared.HasConstraints,
+		"embedded":        fns.embedded,
+		"emptyCond":       fns.emptyCond,
+		"enumName":        fns.enumName,
+		"fail":            fns.fail,
+		"failOneOf":       fns.failOneOf,
+		"failRequired":    fns.failRequired,
+		"fieldPath":       fns.fieldPath,
+		"hasItems":        hasItems,
+		"hasValues":       hasValues,
+		"imports":         fns.imports,
+		"lit":             fns.lit,
+		"mapKey":          fns.mapKey,
+		"msgTyp":          fns.msgTyp,
+		"nanosLit":        fns.nanosLit,
+		"numLit":          fns.numLit,
+		"numVal":          fns.numVal,
+		"oneofCase":       fns.oneofCase,
+		"oneofKind":       fns.oneofKind,
+		"optionalProp":    fns.optionalProp,
+		"options":         fns.options,
+		"rangeCond":       fns.rangeCond,
+		"renderConstants": fns.renderConstants(tpl),
+		"setType":         fns.setType,
+		"unwrap":          fns.unwrap,
+		"validated":       fns.validated,
+		"validatedFields": fns.validatedFields,
+	})
+
+	template.Must(tpl.Parse(fileTpl))
+	template.Must(tpl.New("msg").Parse(msgTpl))
+	template.Must(tpl.New("field").Parse(fieldTpl))
+
+	template.Must(tpl.New("none").Parse(noneTpl))
+
+	template.Must(tpl.New("float").Parse(numTpl))
+	template.Must(tpl.New("floatConst").Parse(numConstTpl))
+	template.Must(tpl.New("double").Parse(numTpl))
+	template.Must(tpl.New("doubleConst").Parse(numConstTpl))
+	template.Must(tpl.New("int32").Parse(numTpl))
+	template.Must(tpl.New("int32Const").Parse(numConstTpl))
+	template.Must(tpl.New("int64").Parse(numTpl))
+	template.Must(tpl.New("int64Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint32").Parse(numTpl))
+	template.Must(tpl.New("uint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("uint64").Parse(numTpl))
+	template.Must(tpl.New("uint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint32").Parse(numTpl))
+	template.Must(tpl.New("sint32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sint64").Parse(numTpl))
+	template.Must(tpl.New("sint64Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed32").Parse(numTpl))
+	template.Must(tpl.New("fixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("fixed64").Parse(numTpl))
+	template.Must(tpl.New("fixed64Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed32").Parse(numTpl))
+	template.Must(tpl.New("sfixed32Const").Parse(numConstTpl))
+	template.Must(tpl.New("sfixed64").Parse(numTpl))
+	template.Must(tpl.New("sfixed64Const").Parse(numConstTpl))
+
+	template.Must(tpl.New("bool").Parse(boolTpl))
+	template.Must(tpl.New("string").Parse(stringTpl))
+	template.Must(tpl.New("stringConst").Parse(stringConstTpl))
+	template.Must(tpl.New("bytes").Parse(bytesTpl))
+	template.Must(tpl.New("bytesConst").Parse(bytesConstTpl))
+
+	template.Must(tpl.New("any").Parse(anyTpl))
+	template.Must(tpl.New("anyConst").Parse(anyConstTpl))
+	template.Must(tpl.New("enum").Parse(enumTpl))
+	template.Must(tpl.New("enumConst").Parse(enumConstTpl))
+	template.Must(tpl.New("message").Parse(messageTpl))
+	template.Must(tpl.New("repeated").Parse(repeatedTpl))
+	template.Must(tpl.New("repeatedConst").Parse(repeatedConstTpl))
+	template.Must(tpl.New("map").Parse(mapTpl))
+	template.Must(tpl.New("mapConst").Parse(mapConstTpl))
+	template.Must(tpl.New("oneOf").Parse(oneOfTpl))
+	template.Must(tpl.New("oneOfConst").Parse(oneOfConstTpl))
+
+	template.Must(tpl.New("timestamp").Parse(timestampTpl))
+	template.Must(tpl.New("duration").Parse(durationTpl))
+	template.Must(tpl.New("durationConst").Parse(durationConstTpl))
+	template.Must(tpl.New("wrapper").Parse(wrapperTpl))
+	template.Must(tpl.New("wrapperConst").Parse(wrapperConstTpl))
+}
+
+// tsFuncs renders validators against the message types protobuf-ts generates.
+type tsFuncs struct {
+	longType     string
+	runtime      string
+	errorFactory string
+}
+
+// CodeFormat re-indents the rendered validators by their braces with four
+// spaces, like protobuf-ts, and drops the blank lines the templates leave
+// inside of blocks.
+func CodeFormat(in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("read generated code failed, %w", err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(src), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	var buf bytes.Buffer
+	// blocks records for each open brace whether it opens a switch, and if a
+	// case label of that switch has been seen.
+	type block struct{ isSwitch, inCase bool }
+	var blocks []block
+	prev := ""
+	for i, line := range lines {
+		if line == "" {
+			next := ""
+			for _, l := range lines[i+1:] {
+				if l != "" {
+					next = l
+					break
+				}
+			}
+			if prev == "" || next == "" || len(blocks) > 1 || strings.HasSuffix(prev, "{") || strings.HasPrefix(next, "}") {
+				continue
+			}
+			buf.WriteByte('\n')
+			prev = ""
+			continue
+		}
+
+		ops := braces(line)
+		for len(ops) > 0 && ops[0] == '}' {
+			if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			ops = ops[1:]
+		}
+
+		label := strings.HasPrefix(line, "case ") || strings.HasPrefix(line, "default:")
+		if label && len(blocks) > 0 {
+			blocks[len(blocks)-1].inCase = true
+		}
+		depth := 0
+		for j, b := range blocks {
+			depth++
+			// statements of a case are indented below its label
+			if b.inCase && !(label && j == len(blocks)-1) {
+				depth++
+			}
+		}
+		buf.WriteString(strings.Repeat("    ", depth))
+		if strings.HasPrefix(line, "*") {
+			// continued doc comment
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+
+		for _, op := range ops {
+			if op == '{' {
+				blocks = append(blocks, block{isSwitch: strings.HasPrefix(line, "switch ")})
+			} else if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+		}
+		prev = line
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// braces returns the braces of a line outside of string literals, in order.
+func braces(line string) []rune {
+	var out []rune
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '{' || r == '}':
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// FilePath places the validators next to the module protobuf-ts generates for
+// the proto file.
+func FilePath(f pgs.File, ctx pgsgo.Context, tpl *template.Template) *pgs.FilePath {
+	// Don't generate validators for files that don't import PGV
+	if !importsPgv(f) {
+		return nil
+	}
+	out := f.InputPath().SetExt(".validate." + tpl.Name())
+	return &out
+}
+
+func importsPgv(f pgs.File) bool {
+	for _, dep := range f.Descriptor().Dependency {
+		if strings.HasSuffix(dep, "validate.proto") {
+			return true
+		}
+	}
+	return false
+}
+
+// localName converts a proto name the way protobuf-ts names properties.
+func localName(name string) string {
+	var b strings.Builder
+	capNext := false
+	for i, r := range name {
+		switch {
+		case r == '_':
+			capNext = true
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+			capNext = true
+		case capNext:
+			b.WriteString(strings.ToUpper(string(r)))
+			capNext = false
+		case i == 0:
+			b.WriteString(strings.ToLower(string(r)))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	switch out := b.String(); out {
+	case "toString", "oneofKind":
+		return out + "$"
+	default:
+		return out
+	}
+}
+
+// typeName returns the name protobuf-ts gives a message or enum: nested types
+// are joined to their parents with underscores.
+func typeName(e pgs.Entity) string {
+	name := strings.TrimPrefix(e.FullyQualifiedName(), ".")
+	if pkg := e.Package().ProtoName().String(); pkg != "" {
+		name = strings.TrimPrefix(name, pkg+".")
+	}
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// modulePath returns the import path of the module generated for f with the
+// given suffix, relative to the validators of from.
+func modulePath(from, f pgs.File, suffix string) string {
+	target := strings.TrimSuffix(f.InputPath().String(), ".proto") + suffix
+	rel := target
+	if dir := path.Dir(from.InputPath().String()); dir != "." {
+		rel = relPath(dir, target)
+	}
+	if !strings.HasPrefix(rel, ".") {
+		rel = "./" + rel
+	}
+	return rel
+}
+
+func relPath(dir, target string) string {
+	dirParts := strings.Split(dir, "/")
+	targetParts := strings.Split(target, "/")
+	i := 0
+	for i < len(dirParts) && i < len(targetParts)-1 && dirParts[i] == targetParts[i] {
+		i++
+	}
+	return strings.Repeat("../", len(dirParts)-i) + strings.Join(targetParts[i:], "/")
+}
+
+func (fns tsFuncs) msgTyp(msg pgs.Message) string {
+	return typeName(msg)
+}
+
+func (fns tsFuncs) checkName(msg pgs.Message) string {
+	return "check" + typeName(msg)
+}
+
+func (fns tsFuncs) enumName(ctx shared.RuleContext) string {
+	t := ctx.Field.Type()
+	if t.IsRepeated() || t.IsMap() {
+		return typeName(t.Element().Enum())
+	}
+	return typeName(t.Enum())
+}
+
+// embedded returns the message type of ctx, resolving repeated items and map
+// values.
+func (fns tsFuncs) embedded(ctx shared.RuleContext) pgs.Message {
+	if t := ctx.Field.Type(); t.IsRepeated() || t.IsMap() {
+		return t.Element().Embed()
+	}
+	return ctx.Field.Type().Embed()
+}
+
+// validated reports if a check function exists for msg: well-known types and
+// messages of files without rules have none.
+func (fns tsFuncs) validated(msg pgs.Message) bool {
+	if msg == nil || msg.IsWellKnown() || !importsPgv(msg.File()) {
+		return false
+	}
+	ignored, err := shared.Ignored(msg)
+	return err == nil && !ignored
+}
+
+func (fns tsFuncs) accessor(ctx shared.RuleContext) string {
+	if ctx.AccessorOverride != "" {
+		return ctx.AccessorOverride
+	}
+
+	f := ctx.Field
+	if f.InRealOneOf() {
+		return fmt.Sprintf("m.%s.%s", localName(f.OneOf().Name().String()), localName(f.Name().String()))
+	}
+	name := "m." + localName(f.Name().String())
+	if t := f.Type(); t.IsEmbed() || t.IsRepeated() || t.IsMap() || !f.HasPresence() || f.Required() || f.InOneOf() {
+		return name
+	}
+	// proto2 optional scalars are left undefined by protobuf-ts when unset,
+	// proto3 optional ones are only validated once set
+	return fmt.Sprintf("(%s ?? %s)", name, fns.zero(f.Type().ProtoType()))
+}
+
+// validatedFields returns the fields of msg outside of a real oneof, proto3
+// optional fields included.
+func (fns tsFuncs) validatedFields(msg pgs.Message) (out []pgs.Field) {
+	for _, f := range msg.Fields() {
+		if !f.InRealOneOf() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// optionalProp returns the property of the proto3 optional scalar field of
+// ctx, left undefined by protobuf-ts when unset, empty for other fields.
+func (fns tsFuncs) optionalProp(ctx shared.RuleContext) string {
+	f := ctx.Field
+	if ctx.Typ == "none" || !f.InOneOf() || f.InRealOneOf() || f.Type().IsEmbed() {
+		return ""
+	}
+	return "m." + localName(f.Name().String())
+}
+
+// failRequired renders the report of the required rule of the proto3
+// optional scalar field of ctx, empty if it has none.
+func (fns tsFuncs) failRequired(ctx shared.RuleContext) (string, error) {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil || !shared.ScalarRequired(&rules) {
+		return "", err
+	}
+	return fns.fail(ctx, "required", shared.RequiredError(ctx.Rules))
+}
+
+func (fns tsFuncs) oneofKind(oo pgs.OneOf) string {
+	return fmt.Sprintf("m.%s.oneofKind", localName(oo.Name().String()))
+}
+
+func (fns tsFuncs) oneofCase(f pgs.Field) string {
+	return strconv.Quote(localName(f.Name().String()))
+}
+
+func (fns tsFuncs) zero(t pgs.ProtoType) string {
+	switch {
+	case t == pgs.StringT:
+		return `""`
+	case t == pgs.BoolT:
+		return "false"
+	case t == pgs.BytesT:
+		return "new Uint8Array(0)"
+	case is64(t.String()):
+		return fns.numLit64("0")
+	default:
+		return "0"
+	}
+}
+
+// fieldPath returns the name violations of ctx are reported under, empty for
+// repeated items and map entries which are reported under their index or key.
+func (fns tsFuncs) fieldPath(ctx shared.RuleContext) string {
+	switch strings.SplitN(ctx.AccessorOverride, ".", 2)[0] {
+	case "item", "key", "val":
+		return ""
+	}
+	return ctx.Field.Name().String()
+}
+
+// constantName returns the name of a module level constant backing the rule
+// at index of ctx.
+func (fns tsFuncs) constantName(ctx shared.RuleContext, index int, rule string) string {
+	return fmt.Sprintf("_%s_%s%s_%d_%s", typeName(ctx.Field.Message()), ctx.Field.Name(), ctx.Index, index, rule)
+}
+
+func is64(typ string) bool {
+	switch strings.ToLower(strings.TrimPrefix(typ, "TYPE_")) {
+	case "int64", "uint64", "sint64", "fixed64", "sfixed64":
+		return true
+	}
+	return false
+}
+
+func (fns tsFuncs) numLit64(v string) string {
+	switch fns.longType {
+	case "number":
+		return v
+	case "string":
+		if v == "0" {
+			return `"0"`
+		}
+	}
+	return v + "n"
+}
+
+// numVal returns the expression comparing the number value of ctx: 64-bit
+// integers generated as strings are compared as bigints.
+func (fns tsFuncs) numVal(ctx shared.RuleContext, value string) string {
+	if is64(ctx.Typ) && fns.longType == "string" {
+		return fmt.Sprintf("BigInt(%s)", value)
+	}
+	return value
+}
+
+// numLit renders v as a literal comparable with numVal.
+func (fns tsFuncs) numLit(ctx shared.RuleContext, v interface{}) string {
+	switch n := v.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(n), 'g', -1, 64)
+	case float64:
+		if ctx.Typ == "float" {
+			// float fields hold float32 values widened to a number
+			return strconv.FormatFloat(float64(float32(n)), 'g', -1, 64)
+		}
+		return strconv.FormatFloat(n, 'g', -1, 64)
+	}
+	if is64(ctx.Typ) && fns.longType != "number" {
+		return fmt.Sprintf("%vn", v)
+	}
+	return fmt.Sprint(v)
+}
+
+// setType returns the element type of lookup sets of ctx.
+func (fns tsFuncs) setType(ctx shared.RuleContext) string {
+	if is64(ctx.Typ) && fns.longType != "number" {
+		return "bigint"
+	}
+	return "number"
+}
+
+func (fns tsFuncs) lit(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func (fns tsFuncs) bytesLit(b []byte) string {
+	parts := make([]string, len(b))
+	for i, c := range b {
+		parts[i] = strconv.Itoa(int(c))
+	}
+	return fmt.Sprintf("new Uint8Array([%s])", strings.Join(parts, ", "))
+}
+
+func (fns tsFuncs) nanosLit(v interface{}) string {
+	switch t := v.(type) {
+	case *durationpb.Duration:
+		return fmt.Sprintf("%dn", t.AsDuration().Nanoseconds())
+	case *timestamppb.Timestamp:
+		return fmt.Sprintf("%dn", t.GetSeconds()*1e9+int64(t.GetNanos()))
+	}
+	return fmt.Sprint(v)
+}
+
+// emptyCond renders the condition under which the value of ctx is set, for
+// ignore_empty.
+func (fns tsFuncs) emptyCond(ctx shared.RuleContext) string {
+	value := fns.accessor(ctx)
+	switch ctx.Typ {
+	case "string":
+		return value + ` !== ""`
+	case "bytes", "repeated":
+		return value + ".length > 0"
+	case "map":
+		return fmt.Sprintf("Object.keys(%s).length > 0", value)
+	}
+	return fmt.Sprintf("%s !== %s", fns.numVal(ctx, value), fns.numLit(ctx, 0))
+}
+
+// mapKey converts the property key k of a map entry back to the key type of
+// the map field.
+func (fns tsFuncs) mapKey(ctx shared.RuleContext) string {
+	switch t := ctx.Field.Type().Key().ProtoType(); {
+	case t == pgs.StringT:
+		return "k"
+	case t == pgs.BoolT:
+		return `k === "true"`
+	case is64(t.String()):
+		switch fns.longType {
+		case "string":
+			return "k"
+		case "number":
+			return "Number(k)"
+		}
+		return "BigInt(k)"
+	default:
+		return "Number(k)"
+	}
+}
+
+// rangeCond renders the condition under which value violates the lt/lte/gt/gte
+// bounds of a rule. Like the Java runtime, a lower bound above the upper bound
+// inverts the range into an exclusive one.
+func (fns tsFuncs) rangeCond(ctx shared.RuleContext, value string, lt, lte, gt, gte interface{}) (string, error) {
+	ltLit, ltVal, hasLt, err := fns.bound(ctx, lt)
+	if err != nil {
+		return "", err
+	}
+	lteLit, lteVal, hasLte, err := fns.bound(ctx, lte)
+	if err != nil {
+		return "", err
+	}
+	gtLit, gtVal, hasGt, err := fns.bound(ctx, gt)
+	if err != nil {
+		return "", err
+	}
+	gteLit, gteVal, hasGte, err := fns.bound(ctx, gte)
+	if err != nil {
+		return "", err
+	}
+
+	var upper, lower string
+	var upperVal, lowerVal float64
+	switch {
+	case hasLt:
+		upper, upperVal = fmt.Sprintf("%s >= %s", value, ltLit), ltVal
+	case hasLte:
+		upper, upperVal = fmt.Sprintf("%s > %s", value, lteLit), lteVal
+	}
+	switch {
+	case hasGt:
+		lower, lowerVal = fmt.Sprintf("%s <= %s", value, gtLit), gtVal
+	case hasGte:
+		lower, lowerVal = fmt.Sprintf("%s < %s", value, gteLit), gteVal
+	}
+
+	switch {
+	case upper == "":
+		return lower, nil
+	case lower == "":
+		return upper, nil
+	case lowerVal <= upperVal:
+		return fmt.Sprintf("%s || %s", lower, upper), nil
+	}
+
+	// exclusive range: the value must fall outside of [upper, lower]
+	inUpper := fmt.Sprintf("%s > %s", value, lteLit)
+	if hasLt {
+		inUpper = fmt.Sprintf("%s >= %s", value, ltLit)
+	}
+	inLower := fmt.Sprintf("%s < %s", value, gteLit)
+	if hasGt {
+		inLower = fmt.Sprintf("%s <= %s", value, gtLit)
+	}
+	return fmt.Sprintf("%s && %s", inUpper, inLower), nil
+}
+
+// bound resolves an optional rule bound into its literal and a numeric value
+// used to order the bounds of a range.
+func (fns tsFuncs) bound(ctx shared.RuleContext, v interface{}) (lit string, val float64, ok bool, err error) {
+	switch b := v.(type) {
+	case *durationpb.Duration:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsDuration()), true, nil
+	case *timestamppb.Timestamp:
+		if b == nil {
+			return
+		}
+		return fns.nanosLit(b), float64(b.AsTime().UnixNano()), true, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Ptr {
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	if rv.IsNil() {
+		return
+	}
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Int32, reflect.Int64:
+		val = float64(rv.Int())
+	case reflect.Uint32, reflect.Uint64:
+		val = float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		val = rv.Float()
+	default:
+		return "", 0, false, fmt.Errorf("unexpected bound type %T", v)
+	}
+	return fns.numLit(ctx, rv.Interface()), val, true, nil
+}
+
+// unwrap returns the context of the value of a wrapper. Repeated items and map
+// values keep their name, which reports violations under their index or key.
+func (fns tsFuncs) unwrap(ctx shared.RuleContext) (shared.RuleContext, error) {
+	name := "wrapper"
+	if fns.fieldPath(ctx) == "" {
+		name = ctx.AccessorOverride
+	}
+	return ctx.Unwrap(name + ".value")
+}
+
+func (fns tsFuncs) renderConstants(tpl *template.Template) func(ctx shared.RuleContext) (string, error) {
+	return func(ctx shared.RuleContext) (string, error) {
+		var b bytes.Buffer
+		var err error
+
+		if t := tpl.Lookup(ctx.Typ + "Const"); t != nil {
+			err = t.Execute(&b, ctx)
+		}
+
+		return b.String(), err
+	}
+}
+
+// fail renders the report of the named rule of ctx failing with e. Nested item,
+// key and value rules without their own Error fall back to the Error of the
+// enclosing repeated or map rule at ctx.ErrIndex.
+func (fns tsFuncs) fail(ctx shared.RuleContext, rule string, e *validate.Error) (string, error) {
+	if e == nil && ctx.AccessorOverride != "" {
+		e = parentError(ctx)
+	}
+	args, err := shared.FieldErrorArgs(ctx.Field, e)
+	if err != nil {
+		return "", err
+	}
+	value := fns.accessor(ctx)
+	if rule == "required" {
+		value = "undefined"
+	}
+	spec, err := fns.errorSpec(ctx.ErrBase, e, args, value)
+	if err != nil {
+		return "", err
+	}
+	typ := ctx.Typ
+	if typ == "wrapper" {
+		typ = "message"
+	}
+	return fmt.Sprintf("v.fail(%q, %q, %s, %s);", fns.fieldPath(ctx), typ+"."+rule, value, spec), nil
+}
+
+func (fns tsFuncs) failOneOf(msg pgs.Message, oo pgs.OneOf) (string, error) {
+	base, err := errorBase(msg)
+	if err != nil {
+		return "", err
+	}
+	rule, err := shared.OneOfRule(oo)
+	if err != nil {
+		return "", err
+	}
+	args, err := shared.ErrorArgs(oo.Name().String(), rule.ProtoReflect(), rule.GetError())
+	if err != nil {
+		return "", err
+	}
+	spec, err := fns.errorSpec(base, rule.GetError(), args, "undefined")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("v.fail(%q, \"oneof.required\", undefined, %s);", oo.Name(), spec), nil
+}
+
+// errorSpec renders the pgv.ErrorSpec literal of e; value is the expression of
+// the validated value.
+func (fns tsFuncs) errorSpec(base *validate.ErrorBase, e *validate.Error, args []shared.ErrorArg, value string) (string, error) {
+	pkg, class := resolveErrorTarget(base, e)
+
+	params := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg.Kind {
+		case shared.IntArg:
+			params = append(params, intLit(strconv.FormatInt(arg.Int, 10), arg.Int > maxSafeInteger || arg.Int < -maxSafeInteger))
+		case shared.UintArg:
+			params = append(params, intLit(strconv.FormatUint(arg.Uint, 10), arg.Uint > maxSafeInteger))
+		case shared.FloatArg:
+			params = append(params, strconv.FormatFloat(arg.Float, 'g', -1, 64))
+		case shared.BoolArg:
+			params = append(params, strconv.FormatBool(arg.Bool))
+		case shared.RefArg:
+			params = append(params, fmt.Sprintf("pgv.ref(%s)", fns.lit(arg.Str)))
+		case shared.ValueArg:
+			if value == "undefined" {
+				return "", fmt.Errorf("error %q: $value is not available here", e.GetMethod())
+			}
+			params = append(params, value)
+		default:
+			params = append(params, fns.lit(arg.Str))
+		}
+	}
+	return fmt.Sprintf("{ pkg: %s, class: %s, method: %s, params: [%s] }",
+		fns.lit(pkg), fns.lit(class), fns.lit(e.GetMethod()), strings.Join(params, ", ")), nil
+}
+
+// maxSafeInteger is Number.MAX_SAFE_INTEGER, larger integers become bigints.
+const maxSafeInteger = 1<<53 - 1
+
+func intLit(v string, big bool) string {
+	if big {
+		return v + "n"
+	}
+	return v
+}
+
+// options renders the pgv.ValidateOptions passed on by validate functions,
+// defaulting to the error factory named by ts_error_factory.
+func (fns tsFuncs) options() string {
+	if fns.errorFactory != "" {
+		return "{ errorFactory, ...opts }"
+	}
+	return "opts"
+}
+
+type tsImport struct {
+	Path   string
+	Clause string
+}
+
+// imports returns the imports of the validators of f: the runtime, the
+// message types, the enums checked by defined_only and the check functions
+// of messages of other files.
+func (fns tsFuncs) imports(f pgs.File) []tsImport {
+	types := map[string]map[string]bool{}
+	values := map[string]map[string]bool{}
+	add := func(table map[string]map[string]bool, path, name string) {
+		if table[path] == nil {
+			table[path] = map[string]bool{}
+		}
+		table[path][name] = true
+	}
+
+	self := modulePath(f, f, "")
+	for _, msg := range f.AllMessages() {
+		ignored, _ := shared.Ignored(msg)
+		if ignored {
+			continue
+		}
+		add(types, self, typeName(msg))
+
+		for _, fld := range msg.Fields() {
+			t := fld.Type()
+			switch {
+			case t.IsEnum():
+				var rules validate.FieldRules
+				if _, err := fld.Extension(validate.E_Rules, &rules); err == nil && rules.GetEnum().GetDefinedOnly() {
+					add(values, modulePath(f, t.Enum().File(), ""), typeName(t.Enum()))
+				}
+			case (t.IsRepeated() || t.IsMap()) && t.Element().IsEnum():
+				for _, en := range definedOnlyElems(fld) {
+					add(values, modulePath(f, en.File(), ""), typeName(en))
+				}
+			}
+
+			var embed pgs.Message
+			switch {
+			case t.IsEmbed():
+				embed = t.Embed()
+			case (t.IsRepeated() || t.IsMap()) && t.Element().IsEmbed():
+				embed = t.Element().Embed()
+			}
+			if embed != nil && embed.File().Name() != f.Name() && fns.validated(embed) {
+				add(values, modulePath(f, embed.File(), ".validate"), fns.checkName(embed))
+			}
+		}
+	}
+
+	out := []tsImport{{Path: fns.runtime, Clause: "* as pgv"}}
+	if fns.errorFactory != "" {
+		out = append(out, tsImport{Path: fns.errorFactory, Clause: "{ errorFactory }"})
+	}
+	out = append(out, importsOf(types, true)...)
+	return append(out, importsOf(values, false)...)
+}
+
+func importsOf(table map[string]map[string]bool, typeOnly bool) []tsImport {
+	paths := make([]string, 0, len(table))
+	for p := range table {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	out := make([]tsImport, 0, len(paths))
+	for _, p := range paths {
+		names := make([]string, 0, len(table[p]))
+		for name := range table[p] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		clause := "{ " + strings.Join(names, ", ") + " }"
+		if typeOnly {
+			clause = "type " + clause
+		}
+		out = append(out, tsImport{Path: p, Clause: clause})
+	}
+	return out
+}
+
+// definedOnlyElems returns the enum of the repeated items or map values of f,
+// if their rules include defined_only.
+func definedOnlyElems(f pgs.Field) []pgs.Enum {
+	var rules validate.FieldRules
+	if _, err := f.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	var elem *validate.FieldRules
+	for _, r := range rules.GetRepeated().GetRules() {
+		if r.GetItems() != nil {
+			elem = r.GetItems()
+		}
+	}
+	for _, r := range rules.GetMap().GetRules() {
+		if r.GetValues() != nil {
+			elem = r.GetValues()
+		}
+	}
+	if elem.GetEnum().GetDefinedOnly() {
+		return []pgs.Enum{f.Type().Element().Enum()}
+	}
+	return nil
+}
+
+func hasItems(rules *validate.RepeatedRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetItems() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasValues(rules *validate.MapRules) bool {
+	for _, r := range rules.GetRules() {
+		if r.GetValues() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveErrorTarget(base *validate.ErrorBase, e *validate.Error) (pkg, class string) {
+	if base != nil {
+		pkg = base.GetPkg()
+		class = base.GetClass()
+	}
+	if len(e.GetPkg()) > 0 {
+		pkg = e.GetPkg()
+	}
+	if len(e.GetClass()) > 0 {
+		class = e.GetClass()
+	}
+	return
+}
+
+func errorBase(msg pgs.Message) (base *validate.ErrorBase, err error) {
+	_, err = msg.Extension(validate.E_ErrorBase, &base)
+	return
+}
+
+func parentError(ctx shared.RuleContext) *validate.Error {
+	var rules validate.FieldRules
+	if _, err := ctx.Field.Extension(validate.E_Rules, &rules); err != nil {
+		return nil
+	}
+	switch {
+	case rules.GetRepeated() != nil:
+		if rs := rules.GetRepeated().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	case rules.GetMap() != nil:
+		if rs := rules.GetMap().GetRules(); ctx.ErrIndex < len(rs) {
+			return rs[ctx.ErrIndex].GetError()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package ts
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLocalName(t *testing.T) {
+	tests := map[string]string{
+		"name":       "name",
+		"user_id":    "userId",
+		"Field_Name": "fieldName",
+		"field2x":    "field2X",
+		"to_string":  "toString$",
+		"oneof_kind": "oneofKind$",
+	}
+	for in, want := range tests {
+		if got := localName(in); got != want {
+			t.Errorf("localName(%q) = %q; want %q", in, got, want)
+		}
+	}
+}
+
+func TestCodeFormat(t *testing.T) {
+	in := `export function check(m: M, v: pgv.Violations): void {
+switch (true) {
+
+
+case m.s === "{":
+v.fail("s", "string.const", m.s, { pkg: "a", class: "B", method: "c", params: [] });
+break;
+}
+}
+`
+	want := `export function check(m: M, v: pgv.Violations): void {
+    switch (true) {
+        case m.s === "{":
+            v.fail("s", "string.const", m.s, { pkg: "a", class: "B", method: "c", params: [] });
+            break;
+    }
+}
+`
+	var out bytes.Buffer
+	if err := CodeFormat(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("CodeFormat:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
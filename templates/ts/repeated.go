@@ -0,0 +1,38 @@
+package ts
+
+const repeatedConstTpl = `{{ renderConstants (.Elem "" "") }}`
+
+const repeatedTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	switch (true) {
+	{{- if $r.MinItems }}
+	case {{ $val }}.length < {{ $r.GetMinItems }}:
+		{{ fail $ctx "min_items" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MaxItems }}
+	case {{ $val }}.length > {{ $r.GetMaxItems }}:
+		{{ fail $ctx "max_items" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetUnique }}
+	case !pgv.unique({{ $val }}):
+		{{ fail $ctx "unique" $r.GetError }}
+		break;
+	{{- end }}
+	}
+	{{- if $r.GetItems }}
+	v.each({{ lit (fieldPath $ctx) }}, {{ $val }}, (item) => {
+		{{- render ($ctx.ElemWithErrIndex "item" "" $index) }}
+	});
+	{{- end }}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{ end -}}
+{{- if and (not (hasItems .Rules)) (eq (.Elem "" "").Typ "message") (validated (embedded .)) }}
+	v.each({{ lit (fieldPath .) }}, {{ $val }}, (item) => {{ checkName (embedded .) }}(item, v));
+{{- end }}
+`
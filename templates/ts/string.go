@@ -0,0 +1,156 @@
+package ts
+
+const stringConstTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if $r.In }}
+const {{ constantName $ctx $index "InLookup" }} = new Set<string>([
+	{{- range $i, $v := $r.In }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+]);
+{{- end }}
+{{- if $r.NotIn }}
+const {{ constantName $ctx $index "NotInLookup" }} = new Set<string>([
+	{{- range $i, $v := $r.NotIn }}{{ if $i }}, {{ end }}{{ lit $v }}{{ end -}}
+]);
+{{- end }}
+{{- if $r.Pattern }}
+const {{ constantName $ctx $index "Pattern" }} = new RegExp({{ lit $r.GetPattern }});
+{{- end }}
+{{- end }}
+`
+
+const stringTpl = `{{ $ctx := . }}{{ $val := accessor . }}{{ range $index, $r := .Rules.Rules -}}
+{{- if constrained $r }}
+{{- if $r.GetIgnoreEmpty }}
+	if ({{ emptyCond $ctx }}) {
+{{- end }}
+	switch (true) {
+	{{- if $r.Const }}
+	case {{ $val }} !== {{ lit $r.GetConst }}:
+		{{ fail $ctx "const" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.In }}
+	case !{{ constantName $ctx $index "InLookup" }}.has({{ $val }}):
+		{{ fail $ctx "in" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.NotIn }}
+	case {{ constantName $ctx $index "NotInLookup" }}.has({{ $val }}):
+		{{ fail $ctx "not_in" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Len }}
+	case pgv.runeCount({{ $val }}) !== {{ $r.GetLen }}:
+		{{ fail $ctx "len" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MinLen }}
+	case pgv.runeCount({{ $val }}) < {{ $r.GetMinLen }}:
+		{{ fail $ctx "min_len" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MaxLen }}
+	case pgv.runeCount({{ $val }}) > {{ $r.GetMaxLen }}:
+		{{ fail $ctx "max_len" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.LenBytes }}
+	case pgv.byteLength({{ $val }}) !== {{ $r.GetLenBytes }}:
+		{{ fail $ctx "len_bytes" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MinBytes }}
+	case pgv.byteLength({{ $val }}) < {{ $r.GetMinBytes }}:
+		{{ fail $ctx "min_bytes" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.MaxBytes }}
+	case pgv.byteLength({{ $val }}) > {{ $r.GetMaxBytes }}:
+		{{ fail $ctx "max_bytes" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Pattern }}
+	case !{{ constantName $ctx $index "Pattern" }}.test({{ $val }}):
+		{{ fail $ctx "pattern" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Prefix }}
+	case !{{ $val }}.startsWith({{ lit $r.GetPrefix }}):
+		{{ fail $ctx "prefix" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Suffix }}
+	case !{{ $val }}.endsWith({{ lit $r.GetSuffix }}):
+		{{ fail $ctx "suffix" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.Contains }}
+	case !{{ $val }}.includes({{ lit $r.GetContains }}):
+		{{ fail $ctx "contains" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.NotContains }}
+	case {{ $val }}.includes({{ lit $r.GetNotContains }}):
+		{{ fail $ctx "not_contains" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetEmail }}
+	case !pgv.isEmail({{ $val }}):
+		{{ fail $ctx "email" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetAddress }}
+	case !pgv.isAddress({{ $val }}):
+		{{ fail $ctx "address" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetHostname }}
+	case !pgv.isHostname({{ $val }}):
+		{{ fail $ctx "hostname" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetIp }}
+	case !pgv.isIp({{ $val }}):
+		{{ fail $ctx "ip" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetIpv4 }}
+	case !pgv.isIpv4({{ $val }}):
+		{{ fail $ctx "ipv4" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetIpv6 }}
+	case !pgv.isIpv6({{ $val }}):
+		{{ fail $ctx "ipv6" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetUri }}
+	case !pgv.isUri({{ $val }}):
+		{{ fail $ctx "uri" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetUriRef }}
+	case !pgv.isUriRef({{ $val }}):
+		{{ fail $ctx "uri_ref" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if $r.GetUuid }}
+	case !pgv.isUuid({{ $val }}):
+		{{ fail $ctx "uuid" $r.GetError }}
+		break;
+	{{- end }}
+	{{- if eq $r.GetWellKnownRegex 1 }}
+	case !pgv.isHttpHeaderName({{ $val }}, {{ $r.GetStrict }}):
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+		break;
+	{{- else if eq $r.GetWellKnownRegex 2 }}
+	case !pgv.isHttpHeaderValue({{ $val }}, {{ $r.GetStrict }}):
+		{{ fail $ctx "well_known_regex" $r.GetError }}
+		break;
+	{{- end }}
+	}
+{{- if $r.GetIgnoreEmpty }}
+	}
+{{- end }}
+{{- end }}
+{{ end -}}
+`
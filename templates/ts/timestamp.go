@@ -0,0 +1,44 @@
+package ts
+
+const timestampTpl = `{{ $ctx := . }}{{ range $index, $r := .Rules.Rules }}
+	{{- if $r.GetRequired }}
+	if ({{ accessor $ctx }} === undefined) {
+		{{ fail $ctx "required" $r.GetError }}
+	} else {
+	{{- else }}
+	if ({{ accessor $ctx }} !== undefined) {
+	{{- end }}
+		const ts = {{ accessor $ctx }};
+		switch (true) {
+		case !pgv.isValidTimestamp(ts):
+			{{ fail $ctx "valid" $r.GetError }}
+			break;
+		{{- if $r.Const }}
+		case pgv.nanos(ts) !== {{ nanosLit $r.GetConst }}:
+			{{ fail $ctx "const" $r.GetError }}
+			break;
+		{{- end }}
+		{{- with rangeCond $ctx "pgv.nanos(ts)" $r.Lt $r.Lte $r.Gt $r.Gte }}
+		case {{ . }}:
+			{{ fail $ctx (boundsRule $r) $r.GetError }}
+			break;
+		{{- end }}
+		{{- if $r.GetLtNow }}
+		case pgv.nanos(ts) >= pgv.nowNanos():
+			{{ fail $ctx "lt_now" $r.GetError }}
+			break;
+		{{- end }}
+		{{- if $r.GetGtNow }}
+		case pgv.nanos(ts) <= pgv.nowNanos():
+			{{ fail $ctx "gt_now" $r.GetError }}
+			break;
+		{{- end }}
+		{{- if $r.Within }}
+		case !pgv.isWithinNow(ts, {{ nanosLit $r.GetWithin }}):
+			{{ fail $ctx "within" $r.GetError }}
+			break;
+		{{- end }}
+		}
+	}
+{{ end -}}
+`
@@ -0,0 +1,321 @@
+// <auto-generated>
+//     Generated by protoc-gen-validate. DO NOT EDIT!
+//     source: csharp.proto
+// </auto-generated>
+#pragma warning disable 1591, 0612, 3021, 8981
+#region Designer generated code
+
+using pb = global::Google.Protobuf;
+using scg = global::System.Collections.Generic;
+using pgv = global::Spaceli.Pgv;
+
+namespace Acme.Billing.V1 {
+  partial class Invoice : pgv::IValidatable {
+    private static readonly scg::HashSet<string> _pgv_id_1_NotInLookup = new scg::HashSet<string> { "00000000-0000-0000-0000-000000000000" };
+
+    private static readonly global::System.Text.RegularExpressions.Regex _pgv_origin_0_Pattern = new global::System.Text.RegularExpressions.Regex("^[^\u0000\n\r]*$");
+
+    private static readonly scg::HashSet<ulong> _pgv_checksum_0_InLookup = new scg::HashSet<ulong> { 1UL, 18446744073709551615UL };
+
+    private static readonly scg::HashSet<int> _pgv_status_0_NotInLookup = new scg::HashSet<int> { 0 };
+
+    private static readonly scg::HashSet<decimal> _pgv_grace_0_InLookup = new scg::HashSet<decimal> { 30000000000M, 60000000500M };
+
+    private static readonly scg::HashSet<string> _pgv_payload_0_NotInLookup = new scg::HashSet<string> { "type.googleapis.com/google.protobuf.Empty" };
+
+    private static readonly scg::HashSet<int> _pgv_priority_0_InLookup = new scg::HashSet<int> { 1, 2, 3 };
+
+    /// <summary>
+    /// Validates Invoice, throwing the error of the first failed rule.
+    /// </summary>
+    public void Validate() {
+      pgv::Violations.Validate(this);
+    }
+
+    /// <summary>
+    /// Validates Invoice, returning every failed rule.
+    /// </summary>
+    public scg::IReadOnlyList<pgv::Violation> ValidateAll() {
+      return pgv::Violations.ValidateAll(this);
+    }
+
+    /// <summary>
+    /// Reports the violations of the rules of Invoice to v.
+    /// </summary>
+    public void Check(pgv::Violations v) {
+      if (!pgv::Strings.IsUuid(Id)) {
+        v.Fail("id", "string.uuid", () => global::Acme.Errors.Errors.BadId());
+      }
+      if (_pgv_id_1_NotInLookup.Contains(Id)) {
+        v.Fail("id", "string.not_in", () => global::Acme.Errors.Errors.NilId(Acme.Errors.Ids.Nil));
+      }
+      if (Memo.Length != 0) {
+        if (pgv::Strings.RuneCount(Memo) > 140) {
+          v.Fail("memo", "string.max_len", () => global::Acme.Errors.Errors.BadMemo());
+        } else if (!Memo.StartsWith("\"", global::System.StringComparison.Ordinal)) {
+          v.Fail("memo", "string.prefix", () => global::Acme.Errors.Errors.BadMemo());
+        } else if (Memo.Contains("<script>")) {
+          v.Fail("memo", "string.not_contains", () => global::Acme.Errors.Errors.BadMemo());
+        }
+      }
+      if (!_pgv_origin_0_Pattern.IsMatch(Origin)) {
+        v.Fail("origin", "string.pattern", () => global::Acme.Errors.Errors.BadOrigin());
+      } else if (!pgv::Strings.IsHttpHeaderValue(Origin, false)) {
+        v.Fail("origin", "string.well_known_regex", () => global::Acme.Errors.Errors.BadOrigin());
+      }
+      if (Total < 0L || Total >= 1000000000000L) {
+        v.Fail("total", "int64.range", () => global::Acme.Errors.Errors.BadTotal("total", 4001, true));
+      }
+      if (Delta >= -10 && Delta <= 10) {
+        v.Fail("delta", "sint32.range", () => global::Acme.Errors.Errors.BadDelta());
+      }
+      if (Discount != 0) {
+        if (Discount < 0F || Discount > 1F) {
+          v.Fail("discount", "float.range", () => global::Acme.Errors.Errors.BadDiscount());
+        }
+      }
+      if (!_pgv_checksum_0_InLookup.Contains(Checksum)) {
+        v.Fail("checksum", "fixed64.in", () => global::Acme.Errors.Errors.BadChecksum());
+      }
+      if (Paid != true) {
+        v.Fail("paid", "bool.const", () => global::Acme.Errors.Errors.Unpaid());
+      }
+      if (Signature.Length != 64) {
+        v.Fail("signature", "bytes.len", () => global::Acme.Errors.Errors.BadSignature());
+      } else if (!pgv::Bytes.HasPrefix(Signature, new byte[] { 0x00, 0xFF })) {
+        v.Fail("signature", "bytes.prefix", () => global::Acme.Errors.Errors.BadSignature());
+      }
+      if (Ip.Length != 0) {
+        if (Ip.Length != 4 && Ip.Length != 16) {
+          v.Fail("ip", "bytes.ip", () => global::Acme.Errors.Errors.BadIp());
+        }
+      }
+      if (!global::System.Enum.IsDefined(typeof(global::Acme.Billing.V1.Invoice.Types.Status), Status)) {
+        v.Fail("status", "enum.defined_only", () => global::Acme.Errors.Errors.BadStatus());
+      } else if (_pgv_status_0_NotInLookup.Contains((int) Status)) {
+        v.Fail("status", "enum.not_in", () => global::Acme.Errors.Errors.BadStatus());
+      }
+      if (Lines.Count < 1) {
+        v.Fail("lines", "repeated.min_items", () => global::Acme.Errors.Errors.BadLines());
+      } else if (Lines.Count > 50) {
+        v.Fail("lines", "repeated.max_items", () => global::Acme.Errors.Errors.BadLines());
+      }
+      v.Each("lines", Lines, item => v.Nested("", item));
+      if (!pgv::Collections.Unique(Tags)) {
+        v.Fail("tags", "repeated.unique", () => global::Acme.Errors.Errors.DuplicateTag());
+      }
+      v.Each("tags", Tags, item => {
+        if (pgv::Strings.RuneCount(item) < 2) {
+          v.Fail("", "string.min_len", () => global::Acme.Errors.Errors.BadTag());
+        }
+      });
+      if (History.Count > 10) {
+        v.Fail("history", "repeated.max_items", () => global::Acme.Errors.Errors.BadHistory());
+      }
+      v.Each("history", History, item => {
+        if (!global::System.Enum.IsDefined(typeof(global::Acme.Billing.V1.Invoice.Types.Status), item)) {
+          v.Fail("", "enum.defined_only", () => global::Acme.Errors.Errors.BadHistory());
+        }
+      });
+      if (Extras.Count > 8) {
+        v.Fail("extras", "map.max_pairs", () => global::Acme.Errors.Errors.BadExtras());
+      }
+      // no_sparse always holds: the values of a MapField cannot be null
+      v.Entries("extras", Extras, (key, val) => {
+        if (pgv::Strings.RuneCount(key) < 1) {
+          v.Fail("", "string.min_len", () => global::Acme.Errors.Errors.BadExtraKey());
+        }
+      });
+      v.Entries("extras", Extras, (key, val) => v.Nested("", val));
+      v.Entries("labels", Labels, (key, val) => {
+        if (key <= 0) {
+          v.Fail("", "int32.gt", () => global::Acme.Errors.Errors.BadLabelKey());
+        }
+        if (!pgv::Strings.IsHostname(val)) {
+          v.Fail("", "string.hostname", () => global::Acme.Errors.Errors.BadLabel());
+        }
+      });
+      if (Primary == null) {
+        v.Fail("primary", "message.required", () => global::Acme.Errors.Errors.PrimaryRequired());
+      }
+      v.Nested("primary", Primary);
+      if (Terms == null) {
+        v.Fail("terms", "duration.required", () => global::Acme.Errors.Errors.BadTerms());
+      }
+      if (Terms != null) {
+        var d = Terms;
+        if (!pgv::Times.IsValid(d)) {
+          v.Fail("terms", "duration.valid", () => global::Acme.Errors.Errors.BadTerms());
+        } else if (pgv::Times.Nanos(d) < 86400000000000M || pgv::Times.Nanos(d) > 7776000000000000M) {
+          v.Fail("terms", "duration.range", () => global::Acme.Errors.Errors.BadTerms());
+        }
+      }
+      if (Grace != null) {
+        var d = Grace;
+        if (!pgv::Times.IsValid(d)) {
+          v.Fail("grace", "duration.valid", () => global::Acme.Errors.Errors.BadGrace());
+        } else if (!_pgv_grace_0_InLookup.Contains(pgv::Times.Nanos(d))) {
+          v.Fail("grace", "duration.in", () => global::Acme.Errors.Errors.BadGrace());
+        }
+      }
+      if (Issued != null) {
+        var ts = Issued;
+        if (!pgv::Times.IsValid(ts)) {
+          v.Fail("issued", "timestamp.valid", () => global::Acme.Errors.Errors.FutureInvoice());
+        } else if (pgv::Times.Nanos(ts) >= pgv::Times.NowNanos()) {
+          v.Fail("issued", "timestamp.lt_now", () => global::Acme.Errors.Errors.FutureInvoice());
+        }
+      }
+      if (Due != null) {
+        var ts = Due;
+        if (!pgv::Times.IsValid(ts)) {
+          v.Fail("due", "timestamp.valid", () => global::Acme.Errors.Errors.BadDue());
+        } else if (pgv::Times.Nanos(ts) <= pgv::Times.NowNanos()) {
+          v.Fail("due", "timestamp.gt_now", () => global::Acme.Errors.Errors.BadDue());
+        } else if (!pgv::Times.IsWithinNow(ts, 2592000000000000M)) {
+          v.Fail("due", "timestamp.within", () => global::Acme.Errors.Errors.BadDue());
+        }
+      }
+      if (Payload == null) {
+        v.Fail("payload", "any.required", () => global::Acme.Errors.Errors.BadPayload());
+      }
+      if (Payload != null) {
+        var a = Payload;
+        if (_pgv_payload_0_NotInLookup.Contains(a.TypeUrl)) {
+          v.Fail("payload", "any.not_in", () => global::Acme.Errors.Errors.BadPayload());
+        }
+      }
+      if (ContactEmail != null) {
+        if (!pgv::Strings.IsEmail(ContactEmail)) {
+          v.Fail("contact_email", "string.email", () => global::Acme.Errors.Errors.BadContactEmail());
+        }
+      }
+      if (Priority != null) {
+        if (!_pgv_priority_0_InLookup.Contains(Priority.Value)) {
+          v.Fail("priority", "int32.in", () => global::Acme.Errors.Errors.BadPriority());
+        }
+      } else {
+        v.Fail("priority", "message.required", () => global::Acme.Errors.Errors.PriorityRequired());
+      }
+      // skipping validation for skipped
+      // no validation rules for weight
+      if (HasNetDays) {
+        if (NetDays < 0 || NetDays > 120) {
+          v.Fail("net_days", "int32.range", () => global::Acme.Errors.Errors.BadNetDays());
+        }
+      } else {
+        v.Fail("net_days", "int32.required", () => global::Acme.Errors.Errors.NetDaysRequired());
+      }
+      if (HasPoNumber) {
+        if (pgv::Strings.RuneCount(PoNumber) < 4) {
+          v.Fail("po_number", "string.min_len", () => global::Acme.Errors.Errors.BadPoNumber());
+        }
+      }
+      switch (RecipientCase) {
+        case RecipientOneofCase.Email:
+          if (!pgv::Strings.IsEmail(Email)) {
+            v.Fail("email", "string.email", () => global::Acme.Errors.Errors.BadEmail());
+          }
+          break;
+        case RecipientOneofCase.Pickup:
+          v.Nested("pickup", Pickup);
+          break;
+        default:
+          v.Fail("recipient", "oneof.required", () => global::Acme.Errors.Errors.RecipientRequired("recipient", "oneof.required"));
+          break;
+      }
+    }
+
+    #region Nested types
+    /// <summary>Container for nested types declared in the Invoice message type.</summary>
+    static partial class Types {
+      partial class Line : pgv::IValidatable {
+        private static readonly global::System.Text.RegularExpressions.Regex _pgv_sku_0_Pattern = new global::System.Text.RegularExpressions.Regex("^[A-Z]{3}-[0-9]+$");
+
+        /// <summary>
+        /// Validates Line, throwing the error of the first failed rule.
+        /// </summary>
+        public void Validate() {
+          pgv::Violations.Validate(this);
+        }
+
+        /// <summary>
+        /// Validates Line, returning every failed rule.
+        /// </summary>
+        public scg::IReadOnlyList<pgv::Violation> ValidateAll() {
+          return pgv::Violations.ValidateAll(this);
+        }
+
+        /// <summary>
+        /// Reports the violations of the rules of Line to v.
+        /// </summary>
+        public void Check(pgv::Violations v) {
+          if (!_pgv_sku_0_Pattern.IsMatch(Sku)) {
+            v.Fail("sku", "string.pattern", () => global::Acme.Errors.Errors.BadSku());
+          }
+          if (Quantity <= 0U || Quantity > 100U) {
+            v.Fail("quantity", "uint32.range", () => global::Acme.Errors.Errors.BadQuantity(Quantity, 100));
+          }
+        }
+      }
+    }
+    #endregion
+  }
+
+  partial class Archive {
+    #region Nested types
+    /// <summary>Container for nested types declared in the Archive message type.</summary>
+    static partial class Types {
+      partial class Entry : pgv::IValidatable {
+        /// <summary>
+        /// Validates Entry, throwing the error of the first failed rule.
+        /// </summary>
+        public void Validate() {
+          pgv::Violations.Validate(this);
+        }
+
+        /// <summary>
+        /// Validates Entry, returning every failed rule.
+        /// </summary>
+        public scg::IReadOnlyList<pgv::Violation> ValidateAll() {
+          return pgv::Violations.ValidateAll(this);
+        }
+
+        /// <summary>
+        /// Reports the violations of the rules of Entry to v.
+        /// </summary>
+        public void Check(pgv::Violations v) {
+          if (pgv::Strings.RuneCount(InvoiceId) < 1) {
+            v.Fail("invoice_id", "string.min_len", () => global::Acme.Errors.Errors.BadInvoiceId());
+          }
+        }
+      }
+    }
+    #endregion
+  }
+
+  partial class Disabled : pgv::IValidatable {
+    /// <summary>
+    /// Validates Disabled, throwing the error of the first failed rule.
+    /// </summary>
+    public void Validate() {
+      pgv::Violations.Validate(this);
+    }
+
+    /// <summary>
+    /// Validates Disabled, returning every failed rule.
+    /// </summary>
+    public scg::IReadOnlyList<pgv::Violation> ValidateAll() {
+      return pgv::Violations.ValidateAll(this);
+    }
+
+    /// <summary>
+    /// Reports the violations of the rules of Disabled to v.
+    /// </summary>
+    public void Check(pgv::Violations v) {
+      // validation is disabled for Disabled
+    }
+  }
+}
+
+#endregion Designer generated code
@@ -0,0 +1,421 @@
+/**
+ * Runtime support for the validators protoc-gen-validate generates with `lang=ts`. Generated `*.validate.ts` files
+ * export a `check<Message>` function per message, which reports failed rules to a {@link Violations} instance, plus
+ * `validate<Message>` and `validate<Message>All` wrappers built on {@link validate} and {@link validateAll}.
+ */
+
+/**
+ * The error a rule was declared with, after `error_base` inheritance. Params are resolved by the generator: string,
+ * number, bigint and boolean literals, {@link Ref}s to named constants and, for the `$value` placeholder, the
+ * offending value itself.
+ */
+export interface ErrorSpec {
+    readonly pkg: string;
+    readonly class: string;
+    readonly method: string;
+    readonly params: readonly unknown[];
+}
+
+/**
+ * An {@link ErrorSpec} together with where and why validation failed.
+ */
+export interface ErrorInfo extends ErrorSpec {
+    /** The path of the offending field, e.g. `address.lines[2]` or `labels[env]`. */
+    readonly field: string;
+    /** The name of the failed rule, e.g. `string.min_len`. */
+    readonly rule: string;
+    /** The offending value. */
+    readonly value: unknown;
+}
+
+/**
+ * Builds the error reported for a failed rule. The Java-style factories named by `validate.Error` don't exist in
+ * TypeScript, so this is where applications map them onto their own error types.
+ */
+export type ErrorFactory = (info: ErrorInfo) => Error;
+
+/**
+ * The error built by {@link defaultErrorFactory}.
+ */
+export class ValidationError extends Error {
+    constructor(readonly info: ErrorInfo) {
+        super(`${info.field ? info.field + ": " : ""}${info.rule}: ${errorName(info)}`);
+        this.name = "ValidationError";
+    }
+}
+
+/**
+ * Builds a {@link ValidationError} carrying the {@link ErrorInfo}.
+ */
+export const defaultErrorFactory: ErrorFactory = (info) => new ValidationError(info);
+
+/**
+ * Returns the qualified name of the factory method of an error, e.g. `com.acme.errors.Errors.nameEmpty`.
+ */
+export function errorName(spec: ErrorSpec): string {
+    return [spec.pkg, spec.class, spec.method].filter((part) => part !== "").join(".");
+}
+
+/**
+ * A reference to a named constant, the `ref` kind of `validate.Param`.
+ */
+export class Ref {
+    constructor(readonly name: string) {}
+
+    toString(): string {
+        return this.name;
+    }
+}
+
+export function ref(name: string): Ref {
+    return new Ref(name);
+}
+
+/**
+ * A single failed rule: the error built for it, the path of the offending field and the name of the rule.
+ */
+export interface Violation {
+    readonly fieldPath: string;
+    readonly rule: string;
+    readonly error: Error;
+}
+
+export interface ValidateOptions {
+    /** Builds the error of each failed rule, defaults to {@link defaultErrorFactory}. */
+    errorFactory?: ErrorFactory;
+}
+
+/**
+ * The signature of generated `check<Message>` functions.
+ */
+export type Check<T> = (m: T, v: Violations) => void;
+
+/**
+ * Gathers the violations found while validating a message. A fail-fast instance throws the error of the first
+ * failed rule, any other instance records it and carries on with the next rule.
+ */
+export class Violations {
+    private readonly path: string[] = [];
+    private readonly found: Violation[] = [];
+
+    constructor(readonly failFast: boolean, private readonly errorFactory: ErrorFactory = defaultErrorFactory) {}
+
+    /**
+     * Reports a failed rule of `field`, an empty field standing for the current path itself.
+     */
+    fail(field: string, rule: string, value: unknown, spec: ErrorSpec): void {
+        const fieldPath = this.pathTo(field);
+        const error = this.errorFactory({ ...spec, field: fieldPath, rule, value });
+        if (this.failFast) {
+            throw error;
+        }
+        this.found.push({ fieldPath, rule, error });
+    }
+
+    /**
+     * Runs `body` with `field` appended to the current path, used when descending into embedded messages, repeated
+     * items and map entries.
+     */
+    within(field: string, body: () => void): void {
+        if (field === "") {
+            body();
+            return;
+        }
+        this.path.push(this.pathTo(field));
+        try {
+            body();
+        } finally {
+            this.path.pop();
+        }
+    }
+
+    /**
+     * Runs `body` for every item of the repeated `field`.
+     */
+    each<T>(field: string, items: readonly T[], body: (item: T, idx: number) => void): void {
+        this.within(field, () => items.forEach((item, idx) => this.within(`[${idx}]`, () => body(item, idx))));
+    }
+
+    /**
+     * Runs `body` for every entry of the map `field`. Keys are passed as they appear on the object, callers convert
+     * them back to their proto type.
+     */
+    entries<V>(field: string, map: { readonly [key: string]: V }, body: (key: string, val: V) => void): void {
+        this.within(field, () => {
+            for (const [key, val] of Object.entries(map)) {
+                this.within(`[${key}]`, () => body(key, val));
+            }
+        });
+    }
+
+    /**
+     * Validates the embedded message `field` with `check`, if it is set.
+     */
+    nested<T>(field: string, value: T | undefined, check: Check<T>): void {
+        if (value !== undefined) {
+            this.within(field, () => check(value, this));
+        }
+    }
+
+    /**
+     * Returns the violations recorded so far.
+     */
+    get violations(): readonly Violation[] {
+        return this.found;
+    }
+
+    private pathTo(field: string): string {
+        const parent = this.path.length === 0 ? "" : this.path[this.path.length - 1];
+        if (field === "") {
+            return parent;
+        }
+        if (parent === "" || field.startsWith("[")) {
+            return parent + field;
+        }
+        return parent + "." + field;
+    }
+}
+
+/**
+ * Validates `m` with `check`, throwing the error of the first failed rule.
+ */
+export function validate<T>(check: Check<T>, m: T, opts?: ValidateOptions): void {
+    check(m, new Violations(true, opts?.errorFactory));
+}
+
+/**
+ * Validates `m` with `check`, returning every failed rule.
+ */
+export function validateAll<T>(check: Check<T>, m: T, opts?: ValidateOptions): Violation[] {
+    const v = new Violations(false, opts?.errorFactory);
+    check(m, v);
+    return [...v.violations];
+}
+
+// strings
+
+const encoder = new TextEncoder();
+const decoder = new TextDecoder();
+
+/**
+ * Returns the number of code points of `s`, which is what the `len` rules of strings count.
+ */
+export function runeCount(s: string): number {
+    return Array.from(s).length;
+}
+
+/**
+ * Returns the length of `s` encoded as UTF-8.
+ */
+export function byteLength(s: string): number {
+    return encoder.encode(s).length;
+}
+
+export function isHostname(host: string): boolean {
+    if (host.length > 253) {
+        return false;
+    }
+    const s = (host.endsWith(".") ? host.slice(0, -1) : host).toLowerCase();
+    return s.split(".").every((part) => part.length > 0 && part.length <= 63 && /^[a-z0-9]([a-z0-9-]*[a-z0-9])?$/.test(part));
+}
+
+const emailLocalPart = /^[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+(\.[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+)*$/;
+
+/**
+ * Validates an RFC 5322 address, optionally with a display name, e.g. `Jane <jane@example.com>`.
+ */
+export function isEmail(addr: string): boolean {
+    const angled = /^[^<]*<([^>]*)>$/.exec(addr);
+    const s = angled ? angled[1] : addr.trim();
+    if (s.length > 254) {
+        return false;
+    }
+    const at = s.lastIndexOf("@");
+    if (at < 0) {
+        return false;
+    }
+    const local = s.slice(0, at);
+    return local.length <= 64 && emailLocalPart.test(local) && isHostname(s.slice(at + 1));
+}
+
+const ipv4Pattern = /^(25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(\.(25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}$/;
+
+export function isIpv4(s: string): boolean {
+    return ipv4Pattern.test(s);
+}
+
+export function isIpv6(s: string): boolean {
+    const halves = s.split("::");
+    if (halves.length > 2) {
+        return false;
+    }
+    const groups = (h: string) => (h === "" ? [] : h.split(":"));
+    const all = halves.length === 2 ? groups(halves[0]).concat(groups(halves[1])) : groups(halves[0]);
+
+    let count = 0;
+    for (let i = 0; i < all.length; i++) {
+        if (i === all.length - 1 && all[i].includes(".")) {
+            if (!isIpv4(all[i])) {
+                return false;
+            }
+            count += 2;
+        } else if (/^[0-9a-fA-F]{1,4}$/.test(all[i])) {
+            count++;
+        } else {
+            return false;
+        }
+    }
+    return halves.length === 2 ? count < 8 : count === 8;
+}
+
+export function isIp(s: string): boolean {
+    return isIpv4(s) || isIpv6(s);
+}
+
+/**
+ * Validates either an IP address or a hostname.
+ */
+export function isAddress(s: string): boolean {
+    return isIp(s) || isHostname(s);
+}
+
+const uriRefPattern = /^([^\s%]|%[0-9a-fA-F]{2})*$/;
+
+/**
+ * Validates an absolute URI as defined by RFC 3986.
+ */
+export function isUri(s: string): boolean {
+    return /^[a-zA-Z][a-zA-Z0-9+.-]*:/.test(s) && uriRefPattern.test(s);
+}
+
+/**
+ * Validates an absolute or relative URI reference as defined by RFC 3986.
+ */
+export function isUriRef(s: string): boolean {
+    return uriRefPattern.test(s);
+}
+
+export function isUuid(s: string): boolean {
+    return /^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$/.test(s);
+}
+
+/**
+ * Validates an HTTP header name as defined by RFC 7230, or with `strict` unset, only disallows `\r\n\0`.
+ */
+export function isHttpHeaderName(s: string, strict: boolean): boolean {
+    return strict ? /^:?[0-9a-zA-Z!#$%&'*+\-.^_|~`]+$/.test(s) : /^[^\u0000\u000A\u000D]+$/.test(s);
+}
+
+/**
+ * Validates an HTTP header value as defined by RFC 7230, or with `strict` unset, only disallows `\r\n\0`.
+ */
+export function isHttpHeaderValue(s: string, strict: boolean): boolean {
+    return strict ? /^[^\u0000-\u0008\u000A-\u001F\u007F]*$/.test(s) : /^[^\u0000\u000A\u000D]*$/.test(s);
+}
+
+// bytes
+
+/**
+ * Decodes `b` as UTF-8, for matching bytes against patterns.
+ */
+export function utf8(b: Uint8Array): string {
+    return decoder.decode(b);
+}
+
+export function bytesEqual(a: Uint8Array, b: Uint8Array): boolean {
+    return a.length === b.length && bytesAt(a, b, 0);
+}
+
+export function bytesIn(b: Uint8Array, list: readonly Uint8Array[]): boolean {
+    return list.some((item) => bytesEqual(b, item));
+}
+
+export function hasPrefix(b: Uint8Array, prefix: Uint8Array): boolean {
+    return b.length >= prefix.length && bytesAt(b, prefix, 0);
+}
+
+export function hasSuffix(b: Uint8Array, suffix: Uint8Array): boolean {
+    return b.length >= suffix.length && bytesAt(b, suffix, b.length - suffix.length);
+}
+
+export function bytesContain(b: Uint8Array, sub: Uint8Array): boolean {
+    for (let i = 0; i + sub.length <= b.length; i++) {
+        if (bytesAt(b, sub, i)) {
+            return true;
+        }
+    }
+    return false;
+}
+
+function bytesAt(b: Uint8Array, sub: Uint8Array, offset: number): boolean {
+    for (let i = 0; i < sub.length; i++) {
+        if (b[offset + i] !== sub[i]) {
+            return false;
+        }
+    }
+    return true;
+}
+
+// repeated
+
+/**
+ * Returns true if no two items are equal, comparing bytes by content.
+ */
+export function unique(items: readonly unknown[]): boolean {
+    const seen = new Set<unknown>();
+    for (const item of items) {
+        const key = item instanceof Uint8Array ? Array.from(item).join(",") : item;
+        if (seen.has(key)) {
+            return false;
+        }
+        seen.add(key);
+    }
+    return true;
+}
+
+// google.protobuf.Duration and google.protobuf.Timestamp, with seconds in any of the protobuf-ts long types
+
+export interface Seconds {
+    seconds: bigint | string | number;
+    nanos: number;
+}
+
+const nanosPerSecond = 1000000000n;
+
+/**
+ * Returns `d` in nanoseconds, also used for timestamps as nanoseconds since the epoch.
+ */
+export function nanos(d: Seconds): bigint {
+    return BigInt(d.seconds) * nanosPerSecond + BigInt(d.nanos);
+}
+
+export function nowNanos(): bigint {
+    return BigInt(Date.now()) * 1000000n;
+}
+
+/**
+ * Returns true if `d` is within the range google.protobuf.Duration documents.
+ */
+export function isValidDuration(d: Seconds): boolean {
+    const seconds = BigInt(d.seconds);
+    if (seconds < -315576000000n || seconds > 315576000000n || d.nanos <= -1e9 || d.nanos >= 1e9) {
+        return false;
+    }
+    return !((seconds > 0n && d.nanos < 0) || (seconds < 0n && d.nanos > 0));
+}
+
+/**
+ * Returns true if `ts` is within the range google.protobuf.Timestamp documents, years 1 to 9999.
+ */
+export function isValidTimestamp(ts: Seconds): boolean {
+    const seconds = BigInt(ts.seconds);
+    return seconds >= -62135596800n && seconds <= 253402300799n && ts.nanos >= 0 && ts.nanos < 1e9;
+}
+
+/**
+ * Returns true if `ts` is at most `within` nanoseconds away from now.
+ */
+export function isWithinNow(ts: Seconds, within: bigint): boolean {
+    const delta = nanos(ts) - nowNanos();
+    return (delta < 0n ? -delta : delta) <= within;
+}
@@ -0,0 +1,77 @@
+import { strict as assert } from "node:assert";
+import { test } from "node:test";
+
+import * as pgv from "../src";
+
+const spec: pgv.ErrorSpec = { pkg: "com.acme", class: "Errors", method: "bad", params: [] };
+
+test("fail fast throws the first error", () => {
+    const v = new pgv.Violations(true);
+    assert.throws(() => v.within("address", () => v.fail("city", "string.in", "c", spec)), (err: unknown) => {
+        assert.ok(err instanceof pgv.ValidationError);
+        assert.equal(err.info.field, "address.city");
+        assert.equal(err.message, "address.city: string.in: com.acme.Errors.bad");
+        return true;
+    });
+});
+
+test("collects violations with their paths", () => {
+    const v = new pgv.Violations(false);
+    v.fail("name", "string.min_len", "", spec);
+    v.each("tags", ["a", "b"], (item) => v.fail("", "string.min_len", item, spec));
+    v.entries("attrs", { env: 0 }, (key, val) => v.fail("", "int32.range", val, spec));
+    v.nested("address", { city: "c" }, (m, nv) => nv.fail("city", "string.in", m.city, spec));
+
+    assert.deepEqual(
+        v.violations.map((it) => it.fieldPath),
+        ["name", "tags[0]", "tags[1]", "attrs[env]", "address.city"],
+    );
+});
+
+test("custom error factory", () => {
+    const errors = pgv.validateAll<string>((m, v) => v.fail("", "string.const", m, spec), "x", {
+        errorFactory: (info) => new RangeError(`${info.method}(${String(info.value)})`),
+    });
+    assert.equal(errors.length, 1);
+    assert.equal(errors[0].error.message, "bad(x)");
+});
+
+test("well-known string formats", () => {
+    assert.ok(pgv.isEmail("jane@example.com"));
+    assert.ok(pgv.isEmail("Jane <jane@example.com>"));
+    assert.ok(!pgv.isEmail("jane.example.com"));
+    assert.ok(pgv.isHostname("example.com."));
+    assert.ok(!pgv.isHostname("-example.com"));
+    assert.ok(pgv.isIpv4("192.168.0.1"));
+    assert.ok(!pgv.isIpv4("192.168.0.01"));
+    assert.ok(pgv.isIpv6("::1"));
+    assert.ok(pgv.isIpv6("2001:db8::ff00:42:8329"));
+    assert.ok(pgv.isIpv6("::ffff:192.168.0.1"));
+    assert.ok(!pgv.isIpv6("1:2:3:4:5:6:7:8:9"));
+    assert.ok(pgv.isUri("https://example.com/a?b=%20"));
+    assert.ok(!pgv.isUri("/relative"));
+    assert.ok(pgv.isUriRef("/relative"));
+    assert.ok(pgv.isUuid("123e4567-e89b-12d3-a456-426614174000"));
+    assert.ok(pgv.isHttpHeaderName(":authority", true));
+    assert.ok(!pgv.isHttpHeaderValue("a\r\nb", false));
+    assert.equal(pgv.runeCount("héllo😀"), 6);
+    assert.equal(pgv.byteLength("héllo😀"), 10);
+});
+
+test("durations and timestamps", () => {
+    assert.equal(pgv.nanos({ seconds: 1n, nanos: 5 }), 1000000005n);
+    assert.equal(pgv.nanos({ seconds: "-1", nanos: 0 }), -1000000000n);
+    assert.ok(!pgv.isValidDuration({ seconds: 1, nanos: -1 }));
+    assert.ok(!pgv.isValidTimestamp({ seconds: 0n, nanos: -1 }));
+    assert.ok(pgv.isWithinNow({ seconds: BigInt(Math.floor(Date.now() / 1000)), nanos: 0 }, 5000000000n));
+});
+
+test("bytes and unique", () => {
+    const b = new Uint8Array([1, 2, 3]);
+    assert.ok(pgv.hasPrefix(b, new Uint8Array([1, 2])));
+    assert.ok(pgv.hasSuffix(b, new Uint8Array([3])));
+    assert.ok(pgv.bytesContain(b, new Uint8Array([2, 3])));
+    assert.ok(pgv.bytesIn(b, [new Uint8Array([1, 2, 3])]));
+    assert.ok(!pgv.unique([new Uint8Array([1]), new Uint8Array([1])]));
+    assert.ok(pgv.unique([1n, 2n]));
+});
@@ -0,0 +1,580 @@
+// Runtime support for the validators protoc-gen-validate generates with
+// lang=cc. Generated *.pb.validate.h files declare, for every message, a Check
+// function reporting the failed rules of the message to a pgv::Violations,
+// plus Validate and ValidateAll wrappers built on pgv::Validate and
+// pgv::ValidateAll.
+#pragma once
+
+#include <algorithm>
+#include <cctype>
+#include <chrono>
+#include <cstdint>
+#include <functional>
+#include <limits>
+#include <sstream>
+#include <string>
+#include <type_traits>
+#include <typeindex>
+#include <unordered_map>
+#include <utility>
+#include <variant>
+#include <vector>
+
+#include "google/protobuf/message.h"
+
+namespace pgv {
+
+// ValidationMsg is the error of a failed rule, as built by an ErrorFactory.
+using ValidationMsg = std::string;
+
+// Ref is a reference to a named constant, the ref kind of validate.Param.
+struct Ref {
+  std::string name;
+};
+
+// Param is an argument of an error: a literal resolved by the generator, a Ref
+// or, for the $value placeholder, the offending value. Messages are held in
+// their text format.
+class Param {
+ public:
+  using Value = std::variant<std::monostate, std::string, int64_t, uint64_t, double, bool, Ref>;
+
+  Param() = default;
+  Param(Ref ref) : value_(std::in_place_type<Ref>, std::move(ref)) {}
+  template <typename T>
+  Param(const T& v) : value_(Convert(v)) {}
+
+  const Value& value() const { return value_; }
+
+  // ToString renders the param, a Ref by its name.
+  std::string ToString() const {
+    if (const auto* s = std::get_if<std::string>(&value_)) {
+      return *s;
+    }
+    if (const auto* i = std::get_if<int64_t>(&value_)) {
+      return std::to_string(*i);
+    }
+    if (const auto* u = std::get_if<uint64_t>(&value_)) {
+      return std::to_string(*u);
+    }
+    if (const auto* d = std::get_if<double>(&value_)) {
+      std::ostringstream out;
+      out << *d;
+      return out.str();
+    }
+    if (const auto* b = std::get_if<bool>(&value_)) {
+      return *b ? "true" : "false";
+    }
+    if (const auto* r = std::get_if<Ref>(&value_)) {
+      return r->name;
+    }
+    return "";
+  }
+
+ private:
+  template <typename T>
+  static Value Convert(const T& v) {
+    if constexpr (std::is_same_v<T, bool>) {
+      return Value(std::in_place_type<bool>, v);
+    } else if constexpr (std::is_enum_v<T> || (std::is_integral_v<T> && std::is_signed_v<T>)) {
+      return Value(std::in_place_type<int64_t>, static_cast<int64_t>(v));
+    } else if constexpr (std::is_integral_v<T>) {
+      return Value(std::in_place_type<uint64_t>, static_cast<uint64_t>(v));
+    } else if constexpr (std::is_floating_point_v<T>) {
+      return Value(std::in_place_type<double>, static_cast<double>(v));
+    } else if constexpr (std::is_base_of_v<google::protobuf::Message, T>) {
+      return Value(std::in_place_type<std::string>, v.ShortDebugString());
+    } else {
+      return Value(std::in_place_type<std::string>, std::string(v));
+    }
+  }
+
+  Value value_;
+};
+
+// ErrorSpec is the error a rule was declared with, after error_base
+// inheritance.
+struct ErrorSpec {
+  std::string pkg;
+  std::string cls;
+  std::string method;
+  std::vector<Param> params;
+};
+
+// ErrorInfo is an ErrorSpec together with where and why validation failed.
+struct ErrorInfo {
+  ErrorSpec spec;
+  // field is the path of the offending field, e.g. address.lines[2] or
+  // labels[env].
+  std::string field;
+  // rule is the name of the failed rule, e.g. string.min_len.
+  std::string rule;
+  // value is the offending value, empty for unset fields.
+  Param value;
+};
+
+// ErrorFactory builds the error of a failed rule. The factories named by
+// validate.Error don't exist in C++, so this is where applications map them
+// onto their own errors. The generator option cc_error_factory names the one
+// the generated Validate and ValidateAll use.
+using ErrorFactory = std::function<ValidationMsg(const ErrorInfo&)>;
+
+// ErrorName returns the qualified name of the factory method of an error,
+// e.g. com.acme.errors.Errors.nameEmpty.
+inline std::string ErrorName(const ErrorSpec& spec) {
+  std::string out;
+  for (const std::string* part : {&spec.pkg, &spec.cls, &spec.method}) {
+    if (part->empty()) {
+      continue;
+    }
+    if (!out.empty()) {
+      out += ".";
+    }
+    out += *part;
+  }
+  return out;
+}
+
+// DefaultErrorFactory describes the failed rule, e.g.
+// "name: string.min_len: com.acme.errors.Errors.nameEmpty".
+inline ValidationMsg DefaultErrorFactory(const ErrorInfo& info) {
+  std::string out = info.field.empty() ? "" : info.field + ": ";
+  return out + info.rule + ": " + ErrorName(info.spec);
+}
+
+// Violation is a single failed rule: the path of the offending field, the name
+// of the rule and the error built for it.
+struct Violation {
+  std::string field;
+  std::string rule;
+  ValidationMsg error;
+};
+
+class Violations;
+
+namespace internal {
+
+using Check = std::function<bool(const void*, Violations*)>;
+
+inline std::unordered_map<std::type_index, Check>& Checks() {
+  static auto* checks = new std::unordered_map<std::type_index, Check>();
+  return *checks;
+}
+
+}  // namespace internal
+
+// Violations gathers the violations found while validating a message. A
+// fail-fast instance stops validation at the first failed rule, any other
+// instance records it and carries on with the next rule.
+class Violations {
+ public:
+  explicit Violations(bool fail_fast, ErrorFactory factory = DefaultErrorFactory)
+      : fail_fast_(fail_fast), factory_(std::move(factory)) {}
+
+  Violations(const Violations&) = delete;
+  Violations& operator=(const Violations&) = delete;
+
+  // Fail reports a failed rule of field, an empty field standing for the
+  // current path itself. It returns false if validation stops.
+  bool Fail(const std::string& field, const std::string& rule, Param value, ErrorSpec spec) {
+    std::string path = PathTo(field);
+    ValidationMsg error = factory_(ErrorInfo{std::move(spec), path, rule, std::move(value)});
+    found_.push_back(Violation{std::move(path), rule, std::move(error)});
+    return !fail_fast_;
+  }
+
+  // CheckMessage validates the embedded message field with the Check
+  // registered for its type, if any. It returns false if validation stops.
+  template <typename T>
+  bool CheckMessage(const std::string& field, const T& m);
+
+  // violations returns the violations recorded so far.
+  const std::vector<Violation>& violations() const { return found_; }
+
+  // Scope appends field, and the index or key of a repeated item or map
+  // entry, to the path violations are reported under for its lifetime.
+  class Scope {
+   public:
+    Scope(Violations* v, const std::string& field) : v_(v) { v_->path_.push_back(v_->PathTo(field)); }
+    Scope(Violations* v, const std::string& field, const Param& key) : v_(v) {
+      v_->path_.push_back(v_->PathTo(field) + "[" + key.ToString() + "]");
+    }
+    ~Scope() { v_->path_.pop_back(); }
+
+    Scope(const Scope&) = delete;
+    Scope& operator=(const Scope&) = delete;
+
+   private:
+    Violations* v_;
+  };
+
+ private:
+  std::string PathTo(const std::string& field) const {
+    std::string parent = path_.empty() ? "" : path_.back();
+    if (field.empty()) {
+      return parent;
+    }
+    if (parent.empty()) {
+      return field;
+    }
+    return parent + "." + field;
+  }
+
+  bool fail_fast_;
+  ErrorFactory factory_;
+  std::vector<std::string> path_;
+  std::vector<Violation> found_;
+};
+
+template <typename T>
+bool Violations::CheckMessage(const std::string& field, const T& m) {
+  const auto& checks = internal::Checks();
+  auto it = checks.find(std::type_index(typeid(T)));
+  if (it == checks.end()) {
+    return true;
+  }
+  Scope scope(this, field);
+  return it->second(&m, this);
+}
+
+// Validator registers the Check of the messages of type T, the one
+// Violations::CheckMessage runs on embedded messages. Generated files define
+// one per message.
+template <typename T>
+class Validator {
+ public:
+  explicit Validator(bool (*check)(const T&, Violations*)) {
+    internal::Checks()[std::type_index(typeid(T))] = [check](const void* m, Violations* v) {
+      return check(*static_cast<const T*>(m), v);
+    };
+  }
+};
+
+// Validate validates m with check, storing the error of the first failed rule
+// in err.
+template <typename T>
+bool Validate(bool (*check)(const T&, Violations*), const T& m, ValidationMsg* err,
+              const ErrorFactory& factory = DefaultErrorFactory) {
+  Violations v(true, factory);
+  check(m, &v);
+  if (v.violations().empty()) {
+    return true;
+  }
+  if (err != nullptr) {
+    *err = v.violations().front().error;
+  }
+  return false;
+}
+
+// ValidateAll validates m with check, storing every failed rule in violations.
+template <typename T>
+bool ValidateAll(bool (*check)(const T&, Violations*), const T& m, std::vector<Violation>* violations,
+                 const ErrorFactory& factory = DefaultErrorFactory) {
+  Violations v(false, factory);
+  check(m, &v);
+  if (violations != nullptr) {
+    *violations = v.violations();
+  }
+  return v.violations().empty();
+}
+
+// strings
+
+// Utf8Len returns the number of code points of s, which is what the len rules
+// of strings count.
+inline size_t Utf8Len(const std::string& s) {
+  return std::count_if(s.begin(), s.end(), [](char c) { return (static_cast<unsigned char>(c) & 0xC0) != 0x80; });
+}
+
+inline bool HasPrefix(const std::string& s, const std::string& prefix) {
+  return s.size() >= prefix.size() && s.compare(0, prefix.size(), prefix) == 0;
+}
+
+inline bool HasSuffix(const std::string& s, const std::string& suffix) {
+  return s.size() >= suffix.size() && s.compare(s.size() - suffix.size(), suffix.size(), suffix) == 0;
+}
+
+inline bool Contains(const std::string& s, const std::string& sub) { return s.find(sub) != std::string::npos; }
+
+inline bool IsValidHostname(const std::string& host) {
+  if (host.size() > 253) {
+    return false;
+  }
+  std::string s = !host.empty() && host.back() == '.' ? host.substr(0, host.size() - 1) : host;
+  size_t start = 0;
+  while (true) {
+    size_t end = s.find('.', start);
+    std::string part = s.substr(start, end == std::string::npos ? std::string::npos : end - start);
+    if (part.empty() || part.size() > 63 || part.front() == '-' || part.back() == '-') {
+      return false;
+    }
+    for (char c : part) {
+      if (!std::isalnum(static_cast<unsigned char>(c)) && c != '-') {
+        return false;
+      }
+    }
+    if (end == std::string::npos) {
+      return true;
+    }
+    start = end + 1;
+  }
+}
+
+// IsValidEmail validates an RFC 5322 address, optionally with a display name,
+// e.g. "Jane <jane@example.com>".
+inline bool IsValidEmail(const std::string& addr) {
+  std::string s = addr;
+  size_t open = addr.find('<');
+  if (open != std::string::npos && !addr.empty() && addr.back() == '>') {
+    s = addr.substr(open + 1, addr.size() - open - 2);
+  } else {
+    size_t first = addr.find_first_not_of(" \t");
+    size_t last = addr.find_last_not_of(" \t");
+    s = first == std::string::npos ? "" : addr.substr(first, last - first + 1);
+  }
+  if (s.size() > 254) {
+    return false;
+  }
+  size_t at = s.rfind('@');
+  if (at == std::string::npos || at == 0 || at > 64) {
+    return false;
+  }
+  static const std::string specials = "!#$%&'*+/=?^_`{|}~-";
+  bool dot = true;
+  for (size_t i = 0; i < at; i++) {
+    char c = s[i];
+    if (c == '.') {
+      if (dot) {
+        return false;
+      }
+      dot = true;
+    } else if (std::isalnum(static_cast<unsigned char>(c)) || specials.find(c) != std::string::npos) {
+      dot = false;
+    } else {
+      return false;
+    }
+  }
+  return !dot && IsValidHostname(s.substr(at + 1));
+}
+
+inline bool IsValidIpv4(const std::string& s) {
+  int parts = 0;
+  size_t start = 0;
+  while (true) {
+    size_t end = s.find('.', start);
+    std::string part = s.substr(start, end == std::string::npos ? std::string::npos : end - start);
+    if (part.empty() || part.size() > 3 || (part.size() > 1 && part[0] == '0')) {
+      return false;
+    }
+    for (char c : part) {
+      if (!std::isdigit(static_cast<unsigned char>(c))) {
+        return false;
+      }
+    }
+    if (std::stoi(part) > 255) {
+      return false;
+    }
+    parts++;
+    if (end == std::string::npos) {
+      return parts == 4;
+    }
+    start = end + 1;
+  }
+}
+
+inline bool IsValidIpv6(const std::string& s) {
+  auto groups = [](const std::string& h) {
+    std::vector<std::string> out;
+    if (h.empty()) {
+      return out;
+    }
+    size_t start = 0;
+    while (true) {
+      size_t end = h.find(':', start);
+      out.push_back(h.substr(start, end == std::string::npos ? std::string::npos : end - start));
+      if (end == std::string::npos) {
+        return out;
+      }
+      start = end + 1;
+    }
+  };
+
+  size_t gap = s.find("::");
+  bool compressed = gap != std::string::npos;
+  if (compressed && s.find("::", gap + 1) != std::string::npos) {
+    return false;
+  }
+  std::vector<std::string> all = groups(compressed ? s.substr(0, gap) : s);
+  if (compressed) {
+    std::vector<std::string> tail = groups(s.substr(gap + 2));
+    all.insert(all.end(), tail.begin(), tail.end());
+  }
+
+  size_t count = 0;
+  for (size_t i = 0; i < all.size(); i++) {
+    const std::string& group = all[i];
+    if (i == all.size() - 1 && group.find('.') != std::string::npos) {
+      if (!IsValidIpv4(group)) {
+        return false;
+      }
+      count += 2;
+    } else if (!group.empty() && group.size() <= 4 &&
+               std::all_of(group.begin(), group.end(), [](char c) { return std::isxdigit(static_cast<unsigned char>(c)); })) {
+      count++;
+    } else {
+      return false;
+    }
+  }
+  return compressed ? count < 8 : count == 8;
+}
+
+inline bool IsValidIp(const std::string& s) { return IsValidIpv4(s) || IsValidIpv6(s); }
+
+// IsValidAddress validates either an IP address or a hostname.
+inline bool IsValidAddress(const std::string& s) { return IsValidIp(s) || IsValidHostname(s); }
+
+// IsValidUriRef validates an absolute or relative URI reference as defined by
+// RFC 3986.
+inline bool IsValidUriRef(const std::string& s) {
+  for (size_t i = 0; i < s.size(); i++) {
+    char c = s[i];
+    if (c == '%') {
+      if (i + 2 >= s.size() || !std::isxdigit(static_cast<unsigned char>(s[i + 1])) ||
+          !std::isxdigit(static_cast<unsigned char>(s[i + 2]))) {
+        return false;
+      }
+      i += 2;
+    } else if (std::isspace(static_cast<unsigned char>(c))) {
+      return false;
+    }
+  }
+  return true;
+}
+
+// IsValidUri validates an absolute URI as defined by RFC 3986.
+inline bool IsValidUri(const std::string& s) {
+  size_t colon = s.find(':');
+  if (colon == std::string::npos || colon == 0 || !std::isalpha(static_cast<unsigned char>(s[0]))) {
+    return false;
+  }
+  for (size_t i = 1; i < colon; i++) {
+    char c = s[i];
+    if (!std::isalnum(static_cast<unsigned char>(c)) && c != '+' && c != '.' && c != '-') {
+      return false;
+    }
+  }
+  return IsValidUriRef(s);
+}
+
+inline bool IsValidUuid(const std::string& s) {
+  if (s.size() != 36) {
+    return false;
+  }
+  for (size_t i = 0; i < s.size(); i++) {
+    if (i == 8 || i == 13 || i == 18 || i == 23) {
+      if (s[i] != '-') {
+        return false;
+      }
+    } else if (!std::isxdigit(static_cast<unsigned char>(s[i]))) {
+      return false;
+    }
+  }
+  return true;
+}
+
+// IsValidHttpHeaderName validates an HTTP header name as defined by RFC 7230,
+// or with strict unset, only disallows \r\n\0.
+inline bool IsValidHttpHeaderName(const std::string& s, bool strict) {
+  if (s.empty()) {
+    return false;
+  }
+  if (!strict) {
+    return s.find_first_of(std::string("\0\n\r", 3)) == std::string::npos;
+  }
+  static const std::string tchars = "!#$%&'*+-.^_|~`";
+  size_t start = s[0] == ':' ? 1 : 0;
+  if (start == s.size()) {
+    return false;
+  }
+  for (size_t i = start; i < s.size(); i++) {
+    if (!std::isalnum(static_cast<unsigned char>(s[i])) && tchars.find(s[i]) == std::string::npos) {
+      return false;
+    }
+  }
+  return true;
+}
+
+// IsValidHttpHeaderValue validates an HTTP header value as defined by RFC
+// 7230, or with strict unset, only disallows \r\n\0.
+inline bool IsValidHttpHeaderValue(const std::string& s, bool strict) {
+  if (!strict) {
+    return s.find_first_of(std::string("\0\n\r", 3)) == std::string::npos;
+  }
+  for (char c : s) {
+    auto u = static_cast<unsigned char>(c);
+    if ((u < 0x20 && u != '\t') || u == 0x7F) {
+      return false;
+    }
+  }
+  return true;
+}
+
+// repeated
+
+// Unique returns true if no two items are equal.
+template <typename Items>
+bool Unique(const Items& items) {
+  std::vector<typename Items::value_type> sorted(items.begin(), items.end());
+  std::sort(sorted.begin(), sorted.end());
+  return std::adjacent_find(sorted.begin(), sorted.end()) == sorted.end();
+}
+
+// google.protobuf.Duration and google.protobuf.Timestamp
+
+// Nanos returns d in nanoseconds, also used for timestamps as nanoseconds
+// since the epoch. Values beyond the range of int64 saturate.
+template <typename D>
+int64_t Nanos(const D& d) {
+  constexpr int64_t kNanosPerSecond = 1000000000;
+  int64_t seconds = d.seconds();
+  if (seconds > std::numeric_limits<int64_t>::max() / kNanosPerSecond) {
+    return std::numeric_limits<int64_t>::max();
+  }
+  if (seconds < std::numeric_limits<int64_t>::min() / kNanosPerSecond) {
+    return std::numeric_limits<int64_t>::min();
+  }
+  return seconds * kNanosPerSecond + d.nanos();
+}
+
+inline int64_t NowNanos() {
+  return std::chrono::duration_cast<std::chrono::nanoseconds>(std::chrono::system_clock::now().time_since_epoch())
+      .count();
+}
+
+// IsValidDuration returns true if d is within the range
+// google.protobuf.Duration documents.
+template <typename D>
+bool IsValidDuration(const D& d) {
+  int64_t seconds = d.seconds();
+  int32_t nanos = d.nanos();
+  if (seconds < -315576000000 || seconds > 315576000000 || nanos <= -1000000000 || nanos >= 1000000000) {
+    return false;
+  }
+  return !((seconds > 0 && nanos < 0) || (seconds < 0 && nanos > 0));
+}
+
+// IsValidTimestamp returns true if ts is within the range
+// google.protobuf.Timestamp documents, years 1 to 9999.
+template <typename T>
+bool IsValidTimestamp(const T& ts) {
+  return ts.seconds() >= -62135596800 && ts.seconds() <= 253402300799 && ts.nanos() >= 0 && ts.nanos() < 1000000000;
+}
+
+// IsWithinNow returns true if ts is at most within nanoseconds away from now.
+template <typename T>
+bool IsWithinNow(const T& ts, int64_t within) {
+  int64_t delta = Nanos(ts) - NowNanos();
+  return (delta < 0 ? -delta : delta) <= within;
+}
+
+}  // namespace pgv
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.28.1
 // 	protoc        v3.19.1
 // source: validate/validate.proto
 
@@ -86,6 +86,464 @@ func (KnownRegex) EnumDescriptor() ([]byte, []int) {
 	return file_validate_validate_proto_rawDescGZIP(), []int{0}
 }
 
+type When_Op int32
+
+const (
+	When_EQ  When_Op = 1
+	When_NE  When_Op = 2
+	When_LT  When_Op = 3
+	When_LTE When_Op = 4
+	When_GT  When_Op = 5
+	When_GTE When_Op = 6
+	// the field is set, compared to no value. Fields without presence
+	// are set if not the default value, repeated and map fields if not
+	// empty.
+	When_SET   When_Op = 7
+	When_UNSET When_Op = 8
+)
+
+// Enum value maps for When_Op.
+var (
+	When_Op_name = map[int32]string{
+		1: "EQ",
+		2: "NE",
+		3: "LT",
+		4: "LTE",
+		5: "GT",
+		6: "GTE",
+		7: "SET",
+		8: "UNSET",
+	}
+	When_Op_value = map[string]int32{
+		"EQ":    1,
+		"NE":    2,
+		"LT":    3,
+		"LTE":   4,
+		"GT":    5,
+		"GTE":   6,
+		"SET":   7,
+		"UNSET": 8,
+	}
+)
+
+func (x When_Op) Enum() *When_Op {
+	p := new(When_Op)
+	*p = x
+	return p
+}
+
+func (x When_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (When_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_validate_validate_proto_enumTypes[1].Descriptor()
+}
+
+func (When_Op) Type() protoreflect.EnumType {
+	return &file_validate_validate_proto_enumTypes[1]
+}
+
+func (x When_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *When_Op) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = When_Op(num)
+	return nil
+}
+
+// Deprecated: Use When_Op.Descriptor instead.
+func (When_Op) EnumDescriptor() ([]byte, []int) {
+	return file_validate_validate_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// ExprRule is a message-level rule written as a CEL-style boolean expression
+// over the fields of the message, e.g. `end_time > start_time`. Supported are
+// the literals and operators of CEL (`!`, `-`, `* / %`, `+ -`,
+// `< <= > >= == != in`, `&&`, `||`, `?:`), list literals, `has(x)`,
+// `size(x)`, the string functions `startsWith`, `endsWith`, `contains` and
+// `matches`, the conversions `int`, `uint`, `double` and `string`, the macros
+// `all`, `exists` and `exists_one`, `duration("1h")`, `timestamp("...")` and
+// `now`. Enum values are referenced by name, e.g. `type == Type.TYPE_USER`.
+type ExprRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expression *string `protobuf:"bytes,1,req,name=expression" json:"expression,omitempty"`
+	// the field the violation is reported for, the message itself if unset.
+	Field *string `protobuf:"bytes,2,opt,name=field" json:"field,omitempty"`
+	Error *Error  `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	// the validation groups the rule belongs to, it always applies if none.
+	Groups []string `protobuf:"bytes,4,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (x *ExprRule) Reset() {
+	*x = ExprRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validate_validate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprRule) ProtoMessage() {}
+
+func (x *ExprRule) ProtoReflect() protoreflect.Message {
+	mi := &file_validate_validate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprRule.ProtoReflect.Descriptor instead.
+func (*ExprRule) Descriptor() ([]byte, []int) {
+	return file_validate_validate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExprRule) GetExpression() string {
+	if x != nil && x.Expression != nil {
+		return *x.Expression
+	}
+	return ""
+}
+
+func (x *ExprRule) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+func (x *ExprRule) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ExprRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// FieldMaskRule names the google.protobuf.FieldMask field of a message and
+// the target field its paths are relative to, e.g.
+// `{field: "update_mask", target: "book"}`. Only the rules of the fields of
+// the target the mask covers apply: the fields of its paths, their fields and
+// the fields leading to them. An empty mask covers every field.
+type FieldMaskRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  *string `protobuf:"bytes,1,req,name=field" json:"field,omitempty"`
+	Target *string `protobuf:"bytes,2,req,name=target" json:"target,omitempty"`
+}
+
+func (x *FieldMaskRule) Reset() {
+	*x = FieldMaskRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validate_validate_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldMaskRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldMaskRule) ProtoMessage() {}
+
+func (x *FieldMaskRule) ProtoReflect() protoreflect.Message {
+	mi := &file_validate_validate_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldMaskRule.ProtoReflect.Descriptor instead.
+func (*FieldMaskRule) Descriptor() ([]byte, []int) {
+	return file_validate_validate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldMaskRule) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+func (x *FieldMaskRule) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return ""
+}
+
+// When is the condition of a rule: a sibling of the validated field, or a
+// field of an embedded sibling, e.g. `address.country`, compared to a value
+// of its type, e.g. `{field: "country", string: "US"}`.
+type When struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field *string  `protobuf:"bytes,1,req,name=field" json:"field,omitempty"`
+	Op    *When_Op `protobuf:"varint,2,opt,name=op,enum=validate.When_Op,def=1" json:"op,omitempty"`
+	// Types that are assignable to Value:
+	//	*When_Int
+	//	*When_Uint
+	//	*When_Double
+	//	*When_Bool
+	//	*When_String_
+	//	*When_Enum
+	Value isWhen_Value `protobuf_oneof:"value"`
+}
+
+// Default values for When fields.
+const (
+	Default_When_Op = When_EQ
+)
+
+func (x *When) Reset() {
+	*x = When{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validate_validate_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *When) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*When) ProtoMessage() {}
+
+func (x *When) ProtoReflect() protoreflect.Message {
+	mi := &file_validate_validate_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use When.ProtoReflect.Descriptor instead.
+func (*When) Descriptor() ([]byte, []int) {
+	return file_validate_validate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *When) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+func (x *When) GetOp() When_Op {
+	if x != nil && x.Op != nil {
+		return *x.Op
+	}
+	return Default_When_Op
+}
+
+func (m *When) GetValue() isWhen_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *When) GetInt() int64 {
+	if x, ok := x.GetValue().(*When_Int); ok {
+		return x.Int
+	}
+	return 0
+}
+
+func (x *When) GetUint() uint64 {
+	if x, ok := x.GetValue().(*When_Uint); ok {
+		return x.Uint
+	}
+	return 0
+}
+
+func (x *When) GetDouble() float64 {
+	if x, ok := x.GetValue().(*When_Double); ok {
+		return x.Double
+	}
+	return 0
+}
+
+func (x *When) GetBool() bool {
+	if x, ok := x.GetValue().(*When_Bool); ok {
+		return x.Bool
+	}
+	return false
+}
+
+func (x *When) GetString_() string {
+	if x, ok := x.GetValue().(*When_String_); ok {
+		return x.String_
+	}
+	return ""
+}
+
+func (x *When) GetEnum() string {
+	if x, ok := x.GetValue().(*When_Enum); ok {
+		return x.Enum
+	}
+	return ""
+}
+
+type isWhen_Value interface {
+	isWhen_Value()
+}
+
+type When_Int struct {
+	Int int64 `protobuf:"varint,3,opt,name=int,oneof"`
+}
+
+type When_Uint struct {
+	Uint uint64 `protobuf:"varint,4,opt,name=uint,oneof"`
+}
+
+type When_Double struct {
+	Double float64 `protobuf:"fixed64,5,opt,name=double,oneof"`
+}
+
+type When_Bool struct {
+	Bool bool `protobuf:"varint,6,opt,name=bool,oneof"`
+}
+
+type When_String_ struct {
+	String_ string `protobuf:"bytes,7,opt,name=string,oneof"`
+}
+
+type When_Enum struct {
+	// the name of a value of the enum of the field, e.g. `TYPE_USER`.
+	Enum string `protobuf:"bytes,8,opt,name=enum,oneof"`
+}
+
+func (*When_Int) isWhen_Value() {}
+
+func (*When_Uint) isWhen_Value() {}
+
+func (*When_Double) isWhen_Value() {}
+
+func (*When_Bool) isWhen_Value() {}
+
+func (*When_String_) isWhen_Value() {}
+
+func (*When_Enum) isWhen_Value() {}
+
+// MethodRules control how generated service interceptors validate the
+// messages of a method.
+type MethodRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Skip does not validate the requests of the method.
+	Skip *bool `protobuf:"varint,1,opt,name=skip" json:"skip,omitempty"`
+	// ValidateResponse validates the responses of the method as well. When
+	// unset, responses are validated if the interceptor is built to.
+	ValidateResponse *bool `protobuf:"varint,2,opt,name=validate_response,json=validateResponse" json:"validate_response,omitempty"`
+	// Strict requires the validated messages to have rules, instead of
+	// accepting the messages of files that do not import PGV as they are.
+	Strict *bool `protobuf:"varint,3,opt,name=strict" json:"strict,omitempty"`
+	// Groups restrict validation to the rules of these groups.
+	Groups []string `protobuf:"bytes,4,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (x *MethodRules) Reset() {
+	*x = MethodRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validate_validate_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodRules) ProtoMessage() {}
+
+func (x *MethodRules) ProtoReflect() protoreflect.Message {
+	mi := &file_validate_validate_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodRules.ProtoReflect.Descriptor instead.
+func (*MethodRules) Descriptor() ([]byte, []int) {
+	return file_validate_validate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MethodRules) GetSkip() bool {
+	if x != nil && x.Skip != nil {
+		return *x.Skip
+	}
+	return false
+}
+
+func (x *MethodRules) GetValidateResponse() bool {
+	if x != nil && x.ValidateResponse != nil {
+		return *x.ValidateResponse
+	}
+	return false
+}
+
+func (x *MethodRules) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+func (x *MethodRules) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type OneOf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -93,12 +551,14 @@ type OneOf struct {
 
 	Required *bool  `protobuf:"varint,1,opt,name=required" json:"required,omitempty"`
 	Error    *Error `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	// the validation groups the rule belongs to, it always applies if none.
+	Groups []string `protobuf:"bytes,3,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *OneOf) Reset() {
 	*x = OneOf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[0]
+		mi := &file_validate_validate_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -111,7 +571,7 @@ func (x *OneOf) String() string {
 func (*OneOf) ProtoMessage() {}
 
 func (x *OneOf) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[0]
+	mi := &file_validate_validate_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -124,7 +584,7 @@ func (x *OneOf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OneOf.ProtoReflect.Descriptor instead.
 func (*OneOf) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{0}
+	return file_validate_validate_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *OneOf) GetRequired() bool {
@@ -141,6 +601,13 @@ func (x *OneOf) GetError() *Error {
 	return nil
 }
 
+func (x *OneOf) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // Error define an error info.
 type Error struct {
 	state         protoimpl.MessageState
@@ -151,12 +618,16 @@ type Error struct {
 	Class  *string  `protobuf:"bytes,2,opt,name=class" json:"class,omitempty"`
 	Method *string  `protobuf:"bytes,3,req,name=method" json:"method,omitempty"`
 	Params []string `protobuf:"bytes,4,rep,name=params" json:"params,omitempty"`
+	// typed arguments for the error method, used instead of params.
+	Args []*Param `protobuf:"bytes,5,rep,name=args" json:"args,omitempty"`
+	// groups of the rule reporting this error, in addition to its own.
+	Groups []string `protobuf:"bytes,6,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *Error) Reset() {
 	*x = Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[1]
+		mi := &file_validate_validate_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -169,7 +640,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[1]
+	mi := &file_validate_validate_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -182,7 +653,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{1}
+	return file_validate_validate_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Error) GetPkg() string {
@@ -213,6 +684,150 @@ func (x *Error) GetParams() []string {
 	return nil
 }
 
+func (x *Error) GetArgs() []*Param {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Error) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Param is a typed argument of an error method.
+type Param struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*Param_Int
+	//	*Param_Bool
+	//	*Param_String_
+	//	*Param_Ref
+	//	*Param_Placeholder
+	Kind isParam_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *Param) Reset() {
+	*x = Param{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validate_validate_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Param) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Param) ProtoMessage() {}
+
+func (x *Param) ProtoReflect() protoreflect.Message {
+	mi := &file_validate_validate_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Param.ProtoReflect.Descriptor instead.
+func (*Param) Descriptor() ([]byte, []int) {
+	return file_validate_validate_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *Param) GetKind() isParam_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Param) GetInt() int64 {
+	if x, ok := x.GetKind().(*Param_Int); ok {
+		return x.Int
+	}
+	return 0
+}
+
+func (x *Param) GetBool() bool {
+	if x, ok := x.GetKind().(*Param_Bool); ok {
+		return x.Bool
+	}
+	return false
+}
+
+func (x *Param) GetString_() string {
+	if x, ok := x.GetKind().(*Param_String_); ok {
+		return x.String_
+	}
+	return ""
+}
+
+func (x *Param) GetRef() string {
+	if x, ok := x.GetKind().(*Param_Ref); ok {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Param) GetPlaceholder() string {
+	if x, ok := x.GetKind().(*Param_Placeholder); ok {
+		return x.Placeholder
+	}
+	return ""
+}
+
+type isParam_Kind interface {
+	isParam_Kind()
+}
+
+type Param_Int struct {
+	// an integer literal, e.g. an error code.
+	Int int64 `protobuf:"varint,1,opt,name=int,oneof"`
+}
+
+type Param_Bool struct {
+	Bool bool `protobuf:"varint,2,opt,name=bool,oneof"`
+}
+
+type Param_String_ struct {
+	String_ string `protobuf:"bytes,3,opt,name=string,oneof"`
+}
+
+type Param_Ref struct {
+	// a reference to a constant, emitted as is, e.g. `com.acme.Codes.NOT_FOUND`.
+	Ref string `protobuf:"bytes,4,opt,name=ref,oneof"`
+}
+
+type Param_Placeholder struct {
+	// a value known when generating or validating:
+	//   $field - the name of the validated field
+	//   $value - the validated value
+	//   $rule  - the name of the rule, e.g. `string.max_len`
+	//   $<name> - the bound <name> of the rule itself, e.g. `$max_len`
+	Placeholder string `protobuf:"bytes,5,opt,name=placeholder,oneof"`
+}
+
+func (*Param_Int) isParam_Kind() {}
+
+func (*Param_Bool) isParam_Kind() {}
+
+func (*Param_String_) isParam_Kind() {}
+
+func (*Param_Ref) isParam_Kind() {}
+
+func (*Param_Placeholder) isParam_Kind() {}
+
 // error base info, include pkg, class
 type ErrorBase struct {
 	state         protoimpl.MessageState
@@ -226,7 +841,7 @@ type ErrorBase struct {
 func (x *ErrorBase) Reset() {
 	*x = ErrorBase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[2]
+		mi := &file_validate_validate_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -239,7 +854,7 @@ func (x *ErrorBase) String() string {
 func (*ErrorBase) ProtoMessage() {}
 
 func (x *ErrorBase) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[2]
+	mi := &file_validate_validate_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +867,7 @@ func (x *ErrorBase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorBase.ProtoReflect.Descriptor instead.
 func (*ErrorBase) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{2}
+	return file_validate_validate_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ErrorBase) GetPkg() string {
@@ -305,7 +920,7 @@ type FieldRules struct {
 func (x *FieldRules) Reset() {
 	*x = FieldRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[3]
+		mi := &file_validate_validate_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -318,7 +933,7 @@ func (x *FieldRules) String() string {
 func (*FieldRules) ProtoMessage() {}
 
 func (x *FieldRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[3]
+	mi := &file_validate_validate_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,7 +946,7 @@ func (x *FieldRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
 func (*FieldRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{3}
+	return file_validate_validate_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FieldRules) GetMessage() *MessageRules {
@@ -640,7 +1255,7 @@ type FloatRules struct {
 func (x *FloatRules) Reset() {
 	*x = FloatRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[4]
+		mi := &file_validate_validate_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -653,7 +1268,7 @@ func (x *FloatRules) String() string {
 func (*FloatRules) ProtoMessage() {}
 
 func (x *FloatRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[4]
+	mi := &file_validate_validate_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +1281,7 @@ func (x *FloatRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatRules.ProtoReflect.Descriptor instead.
 func (*FloatRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{4}
+	return file_validate_validate_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FloatRules) GetRules() []*FloatRule {
@@ -709,12 +1324,20 @@ type FloatRule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *FloatRule) Reset() {
 	*x = FloatRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[5]
+		mi := &file_validate_validate_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -727,7 +1350,7 @@ func (x *FloatRule) String() string {
 func (*FloatRule) ProtoMessage() {}
 
 func (x *FloatRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[5]
+	mi := &file_validate_validate_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +1363,7 @@ func (x *FloatRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatRule.ProtoReflect.Descriptor instead.
 func (*FloatRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{5}
+	return file_validate_validate_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FloatRule) GetConst() float32 {
@@ -806,6 +1429,27 @@ func (x *FloatRule) GetError() *Error {
 	return nil
 }
 
+func (x *FloatRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *FloatRule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *FloatRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // DoubleRules describes multi rules on `double` field
 type DoubleRules struct {
 	state         protoimpl.MessageState
@@ -818,7 +1462,7 @@ type DoubleRules struct {
 func (x *DoubleRules) Reset() {
 	*x = DoubleRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[6]
+		mi := &file_validate_validate_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -831,7 +1475,7 @@ func (x *DoubleRules) String() string {
 func (*DoubleRules) ProtoMessage() {}
 
 func (x *DoubleRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[6]
+	mi := &file_validate_validate_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1488,7 @@ func (x *DoubleRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoubleRules.ProtoReflect.Descriptor instead.
 func (*DoubleRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{6}
+	return file_validate_validate_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DoubleRules) GetRules() []*DoubleRule {
@@ -887,12 +1531,20 @@ type DoubleRule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *DoubleRule) Reset() {
 	*x = DoubleRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[7]
+		mi := &file_validate_validate_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -905,7 +1557,7 @@ func (x *DoubleRule) String() string {
 func (*DoubleRule) ProtoMessage() {}
 
 func (x *DoubleRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[7]
+	mi := &file_validate_validate_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1570,7 @@ func (x *DoubleRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoubleRule.ProtoReflect.Descriptor instead.
 func (*DoubleRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{7}
+	return file_validate_validate_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DoubleRule) GetConst() float64 {
@@ -984,6 +1636,27 @@ func (x *DoubleRule) GetError() *Error {
 	return nil
 }
 
+func (x *DoubleRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *DoubleRule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *DoubleRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // Int32Rules describes multi rules on `int32` field
 type Int32Rules struct {
 	state         protoimpl.MessageState
@@ -996,7 +1669,7 @@ type Int32Rules struct {
 func (x *Int32Rules) Reset() {
 	*x = Int32Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[8]
+		mi := &file_validate_validate_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1009,7 +1682,7 @@ func (x *Int32Rules) String() string {
 func (*Int32Rules) ProtoMessage() {}
 
 func (x *Int32Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[8]
+	mi := &file_validate_validate_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1695,7 @@ func (x *Int32Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Int32Rules.ProtoReflect.Descriptor instead.
 func (*Int32Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{8}
+	return file_validate_validate_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Int32Rules) GetRules() []*Int32Rule {
@@ -1065,12 +1738,20 @@ type Int32Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *Int32Rule) Reset() {
 	*x = Int32Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[9]
+		mi := &file_validate_validate_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1083,7 +1764,7 @@ func (x *Int32Rule) String() string {
 func (*Int32Rule) ProtoMessage() {}
 
 func (x *Int32Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[9]
+	mi := &file_validate_validate_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1777,7 @@ func (x *Int32Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Int32Rule.ProtoReflect.Descriptor instead.
 func (*Int32Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{9}
+	return file_validate_validate_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Int32Rule) GetConst() int32 {
@@ -1162,6 +1843,27 @@ func (x *Int32Rule) GetError() *Error {
 	return nil
 }
 
+func (x *Int32Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Int32Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *Int32Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // Int64Rules describes multi rules on `int64` field
 type Int64Rules struct {
 	state         protoimpl.MessageState
@@ -1174,7 +1876,7 @@ type Int64Rules struct {
 func (x *Int64Rules) Reset() {
 	*x = Int64Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[10]
+		mi := &file_validate_validate_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1187,7 +1889,7 @@ func (x *Int64Rules) String() string {
 func (*Int64Rules) ProtoMessage() {}
 
 func (x *Int64Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[10]
+	mi := &file_validate_validate_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,7 +1902,7 @@ func (x *Int64Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Int64Rules.ProtoReflect.Descriptor instead.
 func (*Int64Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{10}
+	return file_validate_validate_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Int64Rules) GetRules() []*Int64Rule {
@@ -1243,12 +1945,20 @@ type Int64Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *Int64Rule) Reset() {
 	*x = Int64Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[11]
+		mi := &file_validate_validate_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1261,7 +1971,7 @@ func (x *Int64Rule) String() string {
 func (*Int64Rule) ProtoMessage() {}
 
 func (x *Int64Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[11]
+	mi := &file_validate_validate_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1984,7 @@ func (x *Int64Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Int64Rule.ProtoReflect.Descriptor instead.
 func (*Int64Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{11}
+	return file_validate_validate_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Int64Rule) GetConst() int64 {
@@ -1340,6 +2050,27 @@ func (x *Int64Rule) GetError() *Error {
 	return nil
 }
 
+func (x *Int64Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Int64Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *Int64Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // UInt32Rules describes multi rules on `uint32` field
 type UInt32Rules struct {
 	state         protoimpl.MessageState
@@ -1352,7 +2083,7 @@ type UInt32Rules struct {
 func (x *UInt32Rules) Reset() {
 	*x = UInt32Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[12]
+		mi := &file_validate_validate_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1365,7 +2096,7 @@ func (x *UInt32Rules) String() string {
 func (*UInt32Rules) ProtoMessage() {}
 
 func (x *UInt32Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[12]
+	mi := &file_validate_validate_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +2109,7 @@ func (x *UInt32Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UInt32Rules.ProtoReflect.Descriptor instead.
 func (*UInt32Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{12}
+	return file_validate_validate_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UInt32Rules) GetRules() []*UInt32Rule {
@@ -1421,12 +2152,20 @@ type UInt32Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *UInt32Rule) Reset() {
 	*x = UInt32Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[13]
+		mi := &file_validate_validate_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1439,7 +2178,7 @@ func (x *UInt32Rule) String() string {
 func (*UInt32Rule) ProtoMessage() {}
 
 func (x *UInt32Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[13]
+	mi := &file_validate_validate_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1452,7 +2191,7 @@ func (x *UInt32Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UInt32Rule.ProtoReflect.Descriptor instead.
 func (*UInt32Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{13}
+	return file_validate_validate_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UInt32Rule) GetConst() uint32 {
@@ -1518,6 +2257,27 @@ func (x *UInt32Rule) GetError() *Error {
 	return nil
 }
 
+func (x *UInt32Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *UInt32Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *UInt32Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // UInt64Rule describes multi rules on `uint64` field
 type UInt64Rules struct {
 	state         protoimpl.MessageState
@@ -1530,7 +2290,7 @@ type UInt64Rules struct {
 func (x *UInt64Rules) Reset() {
 	*x = UInt64Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[14]
+		mi := &file_validate_validate_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1543,7 +2303,7 @@ func (x *UInt64Rules) String() string {
 func (*UInt64Rules) ProtoMessage() {}
 
 func (x *UInt64Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[14]
+	mi := &file_validate_validate_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +2316,7 @@ func (x *UInt64Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UInt64Rules.ProtoReflect.Descriptor instead.
 func (*UInt64Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{14}
+	return file_validate_validate_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UInt64Rules) GetRules() []*UInt64Rule {
@@ -1599,12 +2359,20 @@ type UInt64Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *UInt64Rule) Reset() {
 	*x = UInt64Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[15]
+		mi := &file_validate_validate_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1617,7 +2385,7 @@ func (x *UInt64Rule) String() string {
 func (*UInt64Rule) ProtoMessage() {}
 
 func (x *UInt64Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[15]
+	mi := &file_validate_validate_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1630,7 +2398,7 @@ func (x *UInt64Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UInt64Rule.ProtoReflect.Descriptor instead.
 func (*UInt64Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{15}
+	return file_validate_validate_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UInt64Rule) GetConst() uint64 {
@@ -1696,6 +2464,27 @@ func (x *UInt64Rule) GetError() *Error {
 	return nil
 }
 
+func (x *UInt64Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *UInt64Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *UInt64Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // SInt32Rules describes multi rules on `sint32` field
 type SInt32Rules struct {
 	state         protoimpl.MessageState
@@ -1708,7 +2497,7 @@ type SInt32Rules struct {
 func (x *SInt32Rules) Reset() {
 	*x = SInt32Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[16]
+		mi := &file_validate_validate_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1721,7 +2510,7 @@ func (x *SInt32Rules) String() string {
 func (*SInt32Rules) ProtoMessage() {}
 
 func (x *SInt32Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[16]
+	mi := &file_validate_validate_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1734,7 +2523,7 @@ func (x *SInt32Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SInt32Rules.ProtoReflect.Descriptor instead.
 func (*SInt32Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{16}
+	return file_validate_validate_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SInt32Rules) GetRules() []*SInt32Rule {
@@ -1777,12 +2566,20 @@ type SInt32Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *SInt32Rule) Reset() {
 	*x = SInt32Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[17]
+		mi := &file_validate_validate_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1795,7 +2592,7 @@ func (x *SInt32Rule) String() string {
 func (*SInt32Rule) ProtoMessage() {}
 
 func (x *SInt32Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[17]
+	mi := &file_validate_validate_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +2605,7 @@ func (x *SInt32Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SInt32Rule.ProtoReflect.Descriptor instead.
 func (*SInt32Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{17}
+	return file_validate_validate_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SInt32Rule) GetConst() int32 {
@@ -1874,6 +2671,27 @@ func (x *SInt32Rule) GetError() *Error {
 	return nil
 }
 
+func (x *SInt32Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *SInt32Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *SInt32Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // SInt64Rules describes multi rules on `sint64` field
 type SInt64Rules struct {
 	state         protoimpl.MessageState
@@ -1886,7 +2704,7 @@ type SInt64Rules struct {
 func (x *SInt64Rules) Reset() {
 	*x = SInt64Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[18]
+		mi := &file_validate_validate_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1899,7 +2717,7 @@ func (x *SInt64Rules) String() string {
 func (*SInt64Rules) ProtoMessage() {}
 
 func (x *SInt64Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[18]
+	mi := &file_validate_validate_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2730,7 @@ func (x *SInt64Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SInt64Rules.ProtoReflect.Descriptor instead.
 func (*SInt64Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{18}
+	return file_validate_validate_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SInt64Rules) GetRules() []*SInt64Rule {
@@ -1955,12 +2773,20 @@ type SInt64Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *SInt64Rule) Reset() {
 	*x = SInt64Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[19]
+		mi := &file_validate_validate_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1973,7 +2799,7 @@ func (x *SInt64Rule) String() string {
 func (*SInt64Rule) ProtoMessage() {}
 
 func (x *SInt64Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[19]
+	mi := &file_validate_validate_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1986,7 +2812,7 @@ func (x *SInt64Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SInt64Rule.ProtoReflect.Descriptor instead.
 func (*SInt64Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{19}
+	return file_validate_validate_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SInt64Rule) GetConst() int64 {
@@ -2052,6 +2878,27 @@ func (x *SInt64Rule) GetError() *Error {
 	return nil
 }
 
+func (x *SInt64Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *SInt64Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *SInt64Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // Fixed32Rules describes multi rules on `fixed32` field
 type Fixed32Rules struct {
 	state         protoimpl.MessageState
@@ -2064,7 +2911,7 @@ type Fixed32Rules struct {
 func (x *Fixed32Rules) Reset() {
 	*x = Fixed32Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[20]
+		mi := &file_validate_validate_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2077,7 +2924,7 @@ func (x *Fixed32Rules) String() string {
 func (*Fixed32Rules) ProtoMessage() {}
 
 func (x *Fixed32Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[20]
+	mi := &file_validate_validate_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2937,7 @@ func (x *Fixed32Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fixed32Rules.ProtoReflect.Descriptor instead.
 func (*Fixed32Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{20}
+	return file_validate_validate_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Fixed32Rules) GetRules() []*Fixed32Rule {
@@ -2133,12 +2980,20 @@ type Fixed32Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *Fixed32Rule) Reset() {
 	*x = Fixed32Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[21]
+		mi := &file_validate_validate_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2151,7 +3006,7 @@ func (x *Fixed32Rule) String() string {
 func (*Fixed32Rule) ProtoMessage() {}
 
 func (x *Fixed32Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[21]
+	mi := &file_validate_validate_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,7 +3019,7 @@ func (x *Fixed32Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fixed32Rule.ProtoReflect.Descriptor instead.
 func (*Fixed32Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{21}
+	return file_validate_validate_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Fixed32Rule) GetConst() uint32 {
@@ -2230,6 +3085,27 @@ func (x *Fixed32Rule) GetError() *Error {
 	return nil
 }
 
+func (x *Fixed32Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Fixed32Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *Fixed32Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // Fixed64Rules describes multi rules on `fixed64` field
 type Fixed64Rules struct {
 	state         protoimpl.MessageState
@@ -2242,7 +3118,7 @@ type Fixed64Rules struct {
 func (x *Fixed64Rules) Reset() {
 	*x = Fixed64Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[22]
+		mi := &file_validate_validate_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2255,7 +3131,7 @@ func (x *Fixed64Rules) String() string {
 func (*Fixed64Rules) ProtoMessage() {}
 
 func (x *Fixed64Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[22]
+	mi := &file_validate_validate_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +3144,7 @@ func (x *Fixed64Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fixed64Rules.ProtoReflect.Descriptor instead.
 func (*Fixed64Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{22}
+	return file_validate_validate_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Fixed64Rules) GetRules() []*Fixed64Rule {
@@ -2311,12 +3187,20 @@ type Fixed64Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *Fixed64Rule) Reset() {
 	*x = Fixed64Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[23]
+		mi := &file_validate_validate_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2329,7 +3213,7 @@ func (x *Fixed64Rule) String() string {
 func (*Fixed64Rule) ProtoMessage() {}
 
 func (x *Fixed64Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[23]
+	mi := &file_validate_validate_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +3226,7 @@ func (x *Fixed64Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fixed64Rule.ProtoReflect.Descriptor instead.
 func (*Fixed64Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{23}
+	return file_validate_validate_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Fixed64Rule) GetConst() uint64 {
@@ -2408,6 +3292,27 @@ func (x *Fixed64Rule) GetError() *Error {
 	return nil
 }
 
+func (x *Fixed64Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Fixed64Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *Fixed64Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // SFixed32Rules describes multi rules on `sfixed32` field
 type SFixed32Rules struct {
 	state         protoimpl.MessageState
@@ -2420,7 +3325,7 @@ type SFixed32Rules struct {
 func (x *SFixed32Rules) Reset() {
 	*x = SFixed32Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[24]
+		mi := &file_validate_validate_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2433,7 +3338,7 @@ func (x *SFixed32Rules) String() string {
 func (*SFixed32Rules) ProtoMessage() {}
 
 func (x *SFixed32Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[24]
+	mi := &file_validate_validate_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2446,7 +3351,7 @@ func (x *SFixed32Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SFixed32Rules.ProtoReflect.Descriptor instead.
 func (*SFixed32Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{24}
+	return file_validate_validate_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SFixed32Rules) GetRules() []*SFixed32Rule {
@@ -2489,12 +3394,20 @@ type SFixed32Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *SFixed32Rule) Reset() {
 	*x = SFixed32Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[25]
+		mi := &file_validate_validate_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2507,7 +3420,7 @@ func (x *SFixed32Rule) String() string {
 func (*SFixed32Rule) ProtoMessage() {}
 
 func (x *SFixed32Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[25]
+	mi := &file_validate_validate_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +3433,7 @@ func (x *SFixed32Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SFixed32Rule.ProtoReflect.Descriptor instead.
 func (*SFixed32Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{25}
+	return file_validate_validate_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SFixed32Rule) GetConst() int32 {
@@ -2586,6 +3499,27 @@ func (x *SFixed32Rule) GetError() *Error {
 	return nil
 }
 
+func (x *SFixed32Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *SFixed32Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *SFixed32Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // SFixed64Rules describes multi rules on `sfixed64` field
 type SFixed64Rules struct {
 	state         protoimpl.MessageState
@@ -2598,7 +3532,7 @@ type SFixed64Rules struct {
 func (x *SFixed64Rules) Reset() {
 	*x = SFixed64Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[26]
+		mi := &file_validate_validate_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2611,7 +3545,7 @@ func (x *SFixed64Rules) String() string {
 func (*SFixed64Rules) ProtoMessage() {}
 
 func (x *SFixed64Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[26]
+	mi := &file_validate_validate_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2624,7 +3558,7 @@ func (x *SFixed64Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SFixed64Rules.ProtoReflect.Descriptor instead.
 func (*SFixed64Rules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{26}
+	return file_validate_validate_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SFixed64Rules) GetRules() []*SFixed64Rule {
@@ -2667,12 +3601,20 @@ type SFixed64Rule struct {
 	IgnoreEmpty *bool `protobuf:"varint,8,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,11,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *SFixed64Rule) Reset() {
 	*x = SFixed64Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[27]
+		mi := &file_validate_validate_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2685,7 +3627,7 @@ func (x *SFixed64Rule) String() string {
 func (*SFixed64Rule) ProtoMessage() {}
 
 func (x *SFixed64Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[27]
+	mi := &file_validate_validate_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,7 +3640,7 @@ func (x *SFixed64Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SFixed64Rule.ProtoReflect.Descriptor instead.
 func (*SFixed64Rule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{27}
+	return file_validate_validate_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SFixed64Rule) GetConst() int64 {
@@ -2764,6 +3706,27 @@ func (x *SFixed64Rule) GetError() *Error {
 	return nil
 }
 
+func (x *SFixed64Rule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *SFixed64Rule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *SFixed64Rule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // BoolRules describes the constraints applied to `bool` values
 type BoolRules struct {
 	state         protoimpl.MessageState
@@ -2774,12 +3737,20 @@ type BoolRules struct {
 	Const *bool `protobuf:"varint,1,opt,name=const" json:"const,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,3,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,4,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *BoolRules) Reset() {
 	*x = BoolRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[28]
+		mi := &file_validate_validate_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2792,7 +3763,7 @@ func (x *BoolRules) String() string {
 func (*BoolRules) ProtoMessage() {}
 
 func (x *BoolRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[28]
+	mi := &file_validate_validate_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,7 +3776,7 @@ func (x *BoolRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolRules.ProtoReflect.Descriptor instead.
 func (*BoolRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{28}
+	return file_validate_validate_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *BoolRules) GetConst() bool {
@@ -2822,6 +3793,27 @@ func (x *BoolRules) GetError() *Error {
 	return nil
 }
 
+func (x *BoolRules) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *BoolRules) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *BoolRules) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // StringRules describes multi rules on `string` field
 type StringRules struct {
 	state         protoimpl.MessageState
@@ -2834,7 +3826,7 @@ type StringRules struct {
 func (x *StringRules) Reset() {
 	*x = StringRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[29]
+		mi := &file_validate_validate_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2847,7 +3839,7 @@ func (x *StringRules) String() string {
 func (*StringRules) ProtoMessage() {}
 
 func (x *StringRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[29]
+	mi := &file_validate_validate_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2860,7 +3852,7 @@ func (x *StringRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringRules.ProtoReflect.Descriptor instead.
 func (*StringRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{29}
+	return file_validate_validate_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *StringRules) GetRules() []*StringRule {
@@ -2946,6 +3938,14 @@ type StringRule struct {
 	IgnoreEmpty *bool `protobuf:"varint,26,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,27,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,28,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,29,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,30,rep,name=groups" json:"groups,omitempty"`
 }
 
 // Default values for StringRule fields.
@@ -2956,7 +3956,7 @@ const (
 func (x *StringRule) Reset() {
 	*x = StringRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[30]
+		mi := &file_validate_validate_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2969,7 +3969,7 @@ func (x *StringRule) String() string {
 func (*StringRule) ProtoMessage() {}
 
 func (x *StringRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[30]
+	mi := &file_validate_validate_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,7 +3982,7 @@ func (x *StringRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringRule.ProtoReflect.Descriptor instead.
 func (*StringRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{30}
+	return file_validate_validate_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *StringRule) GetConst() string {
@@ -3181,6 +4181,27 @@ func (x *StringRule) GetError() *Error {
 	return nil
 }
 
+func (x *StringRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *StringRule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *StringRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type isStringRule_WellKnown interface {
 	isStringRule_WellKnown()
 }
@@ -3277,7 +4298,7 @@ type BytesRules struct {
 func (x *BytesRules) Reset() {
 	*x = BytesRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[31]
+		mi := &file_validate_validate_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3290,7 +4311,7 @@ func (x *BytesRules) String() string {
 func (*BytesRules) ProtoMessage() {}
 
 func (x *BytesRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[31]
+	mi := &file_validate_validate_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,7 +4324,7 @@ func (x *BytesRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BytesRules.ProtoReflect.Descriptor instead.
 func (*BytesRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{31}
+	return file_validate_validate_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *BytesRules) GetRules() []*BytesRule {
@@ -3361,12 +4382,20 @@ type BytesRule struct {
 	IgnoreEmpty *bool `protobuf:"varint,14,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,15,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,16,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,17,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,18,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *BytesRule) Reset() {
 	*x = BytesRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[32]
+		mi := &file_validate_validate_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3379,7 +4408,7 @@ func (x *BytesRule) String() string {
 func (*BytesRule) ProtoMessage() {}
 
 func (x *BytesRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[32]
+	mi := &file_validate_validate_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3392,7 +4421,7 @@ func (x *BytesRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BytesRule.ProtoReflect.Descriptor instead.
 func (*BytesRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{32}
+	return file_validate_validate_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *BytesRule) GetConst() []byte {
@@ -3507,6 +4536,27 @@ func (x *BytesRule) GetError() *Error {
 	return nil
 }
 
+func (x *BytesRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *BytesRule) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *BytesRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type isBytesRule_WellKnown interface {
 	isBytesRule_WellKnown()
 }
@@ -3554,12 +4604,20 @@ type EnumRules struct {
 	NotIn []int32 `protobuf:"varint,4,rep,name=not_in,json=notIn" json:"not_in,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,6,opt,name=when" json:"when,omitempty"`
+	// Required specifies that this field must be set, it is only
+	// applicable to fields with explicit presence
+	Required *bool `protobuf:"varint,7,opt,name=required" json:"required,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,8,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *EnumRules) Reset() {
 	*x = EnumRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[33]
+		mi := &file_validate_validate_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3572,7 +4630,7 @@ func (x *EnumRules) String() string {
 func (*EnumRules) ProtoMessage() {}
 
 func (x *EnumRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[33]
+	mi := &file_validate_validate_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3585,7 +4643,7 @@ func (x *EnumRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumRules.ProtoReflect.Descriptor instead.
 func (*EnumRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{33}
+	return file_validate_validate_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *EnumRules) GetConst() int32 {
@@ -3623,6 +4681,27 @@ func (x *EnumRules) GetError() *Error {
 	return nil
 }
 
+func (x *EnumRules) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *EnumRules) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *EnumRules) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // MessageRules describe the constraints applied to embedded message values.
 // For message-type fields, validation is performed recursively.
 type MessageRules struct {
@@ -3637,12 +4716,17 @@ type MessageRules struct {
 	Required *bool `protobuf:"varint,2,opt,name=required" json:"required,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,4,opt,name=when" json:"when,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *MessageRules) Reset() {
 	*x = MessageRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[34]
+		mi := &file_validate_validate_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3655,7 +4739,7 @@ func (x *MessageRules) String() string {
 func (*MessageRules) ProtoMessage() {}
 
 func (x *MessageRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[34]
+	mi := &file_validate_validate_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3668,7 +4752,7 @@ func (x *MessageRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageRules.ProtoReflect.Descriptor instead.
 func (*MessageRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{34}
+	return file_validate_validate_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *MessageRules) GetSkip() bool {
@@ -3692,6 +4776,20 @@ func (x *MessageRules) GetError() *Error {
 	return nil
 }
 
+func (x *MessageRules) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MessageRules) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // RepeatedRules describes multi rules on `repeated` field
 type RepeatedRules struct {
 	state         protoimpl.MessageState
@@ -3704,7 +4802,7 @@ type RepeatedRules struct {
 func (x *RepeatedRules) Reset() {
 	*x = RepeatedRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[35]
+		mi := &file_validate_validate_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3717,7 +4815,7 @@ func (x *RepeatedRules) String() string {
 func (*RepeatedRules) ProtoMessage() {}
 
 func (x *RepeatedRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[35]
+	mi := &file_validate_validate_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3730,7 +4828,7 @@ func (x *RepeatedRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepeatedRules.ProtoReflect.Descriptor instead.
 func (*RepeatedRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{35}
+	return file_validate_validate_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *RepeatedRules) GetRules() []*RepeatedRule {
@@ -3765,12 +4863,17 @@ type RepeatedRule struct {
 	IgnoreEmpty *bool `protobuf:"varint,5,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,6,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,7,opt,name=when" json:"when,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,8,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *RepeatedRule) Reset() {
 	*x = RepeatedRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[36]
+		mi := &file_validate_validate_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3783,7 +4886,7 @@ func (x *RepeatedRule) String() string {
 func (*RepeatedRule) ProtoMessage() {}
 
 func (x *RepeatedRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[36]
+	mi := &file_validate_validate_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3796,7 +4899,7 @@ func (x *RepeatedRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepeatedRule.ProtoReflect.Descriptor instead.
 func (*RepeatedRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{36}
+	return file_validate_validate_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *RepeatedRule) GetMinItems() uint64 {
@@ -3841,6 +4944,20 @@ func (x *RepeatedRule) GetError() *Error {
 	return nil
 }
 
+func (x *RepeatedRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *RepeatedRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // MapRules describes multi rules on `map` field
 type MapRules struct {
 	state         protoimpl.MessageState
@@ -3853,7 +4970,7 @@ type MapRules struct {
 func (x *MapRules) Reset() {
 	*x = MapRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[37]
+		mi := &file_validate_validate_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3866,7 +4983,7 @@ func (x *MapRules) String() string {
 func (*MapRules) ProtoMessage() {}
 
 func (x *MapRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[37]
+	mi := &file_validate_validate_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3879,7 +4996,7 @@ func (x *MapRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapRules.ProtoReflect.Descriptor instead.
 func (*MapRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{37}
+	return file_validate_validate_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *MapRules) GetRules() []*MapRule {
@@ -3915,12 +5032,17 @@ type MapRule struct {
 	IgnoreEmpty *bool `protobuf:"varint,6,opt,name=ignore_empty,json=ignoreEmpty" json:"ignore_empty,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,7,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,8,opt,name=when" json:"when,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,9,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *MapRule) Reset() {
 	*x = MapRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[38]
+		mi := &file_validate_validate_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3933,7 +5055,7 @@ func (x *MapRule) String() string {
 func (*MapRule) ProtoMessage() {}
 
 func (x *MapRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[38]
+	mi := &file_validate_validate_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3946,7 +5068,7 @@ func (x *MapRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapRule.ProtoReflect.Descriptor instead.
 func (*MapRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{38}
+	return file_validate_validate_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *MapRule) GetMinPairs() uint64 {
@@ -3998,6 +5120,20 @@ func (x *MapRule) GetError() *Error {
 	return nil
 }
 
+func (x *MapRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MapRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // AnyRules describes multi rules on `google.protobuf.Any` field
 type AnyRules struct {
 	state         protoimpl.MessageState
@@ -4010,7 +5146,7 @@ type AnyRules struct {
 func (x *AnyRules) Reset() {
 	*x = AnyRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[39]
+		mi := &file_validate_validate_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4023,7 +5159,7 @@ func (x *AnyRules) String() string {
 func (*AnyRules) ProtoMessage() {}
 
 func (x *AnyRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[39]
+	mi := &file_validate_validate_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4036,7 +5172,7 @@ func (x *AnyRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnyRules.ProtoReflect.Descriptor instead.
 func (*AnyRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{39}
+	return file_validate_validate_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *AnyRules) GetRules() []*AnyRule {
@@ -4063,12 +5199,17 @@ type AnyRule struct {
 	NotIn []string `protobuf:"bytes,3,rep,name=not_in,json=notIn" json:"not_in,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,5,opt,name=when" json:"when,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,6,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *AnyRule) Reset() {
 	*x = AnyRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[40]
+		mi := &file_validate_validate_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4081,7 +5222,7 @@ func (x *AnyRule) String() string {
 func (*AnyRule) ProtoMessage() {}
 
 func (x *AnyRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[40]
+	mi := &file_validate_validate_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4094,7 +5235,7 @@ func (x *AnyRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnyRule.ProtoReflect.Descriptor instead.
 func (*AnyRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{40}
+	return file_validate_validate_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *AnyRule) GetRequired() bool {
@@ -4111,16 +5252,30 @@ func (x *AnyRule) GetIn() []string {
 	return nil
 }
 
-func (x *AnyRule) GetNotIn() []string {
+func (x *AnyRule) GetNotIn() []string {
+	if x != nil {
+		return x.NotIn
+	}
+	return nil
+}
+
+func (x *AnyRule) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *AnyRule) GetWhen() *When {
 	if x != nil {
-		return x.NotIn
+		return x.When
 	}
 	return nil
 }
 
-func (x *AnyRule) GetError() *Error {
+func (x *AnyRule) GetGroups() []string {
 	if x != nil {
-		return x.Error
+		return x.Groups
 	}
 	return nil
 }
@@ -4137,7 +5292,7 @@ type DurationRules struct {
 func (x *DurationRules) Reset() {
 	*x = DurationRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[41]
+		mi := &file_validate_validate_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4150,7 +5305,7 @@ func (x *DurationRules) String() string {
 func (*DurationRules) ProtoMessage() {}
 
 func (x *DurationRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[41]
+	mi := &file_validate_validate_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4163,7 +5318,7 @@ func (x *DurationRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurationRules.ProtoReflect.Descriptor instead.
 func (*DurationRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{41}
+	return file_validate_validate_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DurationRules) GetRules() []*DurationRule {
@@ -4204,12 +5359,17 @@ type DurationRule struct {
 	NotIn []*durationpb.Duration `protobuf:"bytes,8,rep,name=not_in,json=notIn" json:"not_in,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,10,opt,name=when" json:"when,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,11,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *DurationRule) Reset() {
 	*x = DurationRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[42]
+		mi := &file_validate_validate_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4222,7 +5382,7 @@ func (x *DurationRule) String() string {
 func (*DurationRule) ProtoMessage() {}
 
 func (x *DurationRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[42]
+	mi := &file_validate_validate_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,7 +5395,7 @@ func (x *DurationRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurationRule.ProtoReflect.Descriptor instead.
 func (*DurationRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{42}
+	return file_validate_validate_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DurationRule) GetRequired() bool {
@@ -4301,6 +5461,20 @@ func (x *DurationRule) GetError() *Error {
 	return nil
 }
 
+func (x *DurationRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *DurationRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // TimestampRules describes multi rules on `google.protobuf.Timestamp` field
 type TimestampRules struct {
 	state         protoimpl.MessageState
@@ -4313,7 +5487,7 @@ type TimestampRules struct {
 func (x *TimestampRules) Reset() {
 	*x = TimestampRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[43]
+		mi := &file_validate_validate_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4326,7 +5500,7 @@ func (x *TimestampRules) String() string {
 func (*TimestampRules) ProtoMessage() {}
 
 func (x *TimestampRules) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[43]
+	mi := &file_validate_validate_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4339,7 +5513,7 @@ func (x *TimestampRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampRules.ProtoReflect.Descriptor instead.
 func (*TimestampRules) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{43}
+	return file_validate_validate_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *TimestampRules) GetRules() []*TimestampRule {
@@ -4384,12 +5558,17 @@ type TimestampRule struct {
 	Within *durationpb.Duration `protobuf:"bytes,9,opt,name=within" json:"within,omitempty"`
 	// Error descriptor
 	Error *Error `protobuf:"bytes,10,opt,name=error" json:"error,omitempty"`
+	// When applies the rule only if the condition holds
+	When *When `protobuf:"bytes,11,opt,name=when" json:"when,omitempty"`
+	// Groups are the validation groups the rule belongs to. A rule in groups
+	// applies only when validating one of them, a rule in none always applies
+	Groups []string `protobuf:"bytes,12,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (x *TimestampRule) Reset() {
 	*x = TimestampRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validate_validate_proto_msgTypes[44]
+		mi := &file_validate_validate_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4402,7 +5581,7 @@ func (x *TimestampRule) String() string {
 func (*TimestampRule) ProtoMessage() {}
 
 func (x *TimestampRule) ProtoReflect() protoreflect.Message {
-	mi := &file_validate_validate_proto_msgTypes[44]
+	mi := &file_validate_validate_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,7 +5594,7 @@ func (x *TimestampRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampRule.ProtoReflect.Descriptor instead.
 func (*TimestampRule) Descriptor() ([]byte, []int) {
-	return file_validate_validate_proto_rawDescGZIP(), []int{44}
+	return file_validate_validate_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *TimestampRule) GetRequired() bool {
@@ -4488,7 +5667,29 @@ func (x *TimestampRule) GetError() *Error {
 	return nil
 }
 
+func (x *TimestampRule) GetWhen() *When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *TimestampRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 var file_validate_validate_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         1071,
+		Name:          "validate.groups",
+		Tag:           "bytes,1071,rep,name=groups",
+		Filename:      "validate/validate.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
 		ExtensionType: (*bool)(nil),
@@ -4513,6 +5714,22 @@ var file_validate_validate_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,1073,opt,name=error_base",
 		Filename:      "validate/validate.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: ([]*ExprRule)(nil),
+		Field:         1074,
+		Name:          "validate.expr",
+		Tag:           "bytes,1074,rep,name=expr",
+		Filename:      "validate/validate.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*FieldMaskRule)(nil),
+		Field:         1075,
+		Name:          "validate.field_mask",
+		Tag:           "bytes,1075,opt,name=field_mask",
+		Filename:      "validate/validate.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
 		ExtensionType: (*OneOf)(nil),
@@ -4529,23 +5746,59 @@ var file_validate_validate_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,1071,opt,name=rules",
 		Filename:      "validate/validate.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*MethodRules)(nil),
+		Field:         1071,
+		Name:          "validate.methods",
+		Tag:           "bytes,1071,opt,name=methods",
+		Filename:      "validate/validate.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*MethodRules)(nil),
+		Field:         1071,
+		Name:          "validate.method",
+		Tag:           "bytes,1071,opt,name=method",
+		Filename:      "validate/validate.proto",
+	},
 }
 
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// Groups declares the validation groups the rules of this file can belong
+	// to and its methods can select, e.g. `create` and `update`.
+	//
+	// repeated string groups = 1071;
+	E_Groups = &file_validate_validate_proto_extTypes[0]
+)
+
 // Extension fields to descriptorpb.MessageOptions.
 var (
 	// Disabled nullifies any validation rules for this message, including any
 	// message fields associated with it that do support validation.
 	//
 	// optional bool disabled = 1071;
-	E_Disabled = &file_validate_validate_proto_extTypes[0]
+	E_Disabled = &file_validate_validate_proto_extTypes[1]
 	// Ignore skips generation of validation methods for this message.
 	//
 	// optional bool ignored = 1072;
-	E_Ignored = &file_validate_validate_proto_extTypes[1]
+	E_Ignored = &file_validate_validate_proto_extTypes[2]
 	// error pkg and class info for all field in this message.
 	//
 	// optional validate.ErrorBase error_base = 1073;
-	E_ErrorBase = &file_validate_validate_proto_extTypes[2]
+	E_ErrorBase = &file_validate_validate_proto_extTypes[3]
+	// Expression rules relating the fields of this message to each other,
+	// checked after the rules of the fields themselves.
+	//
+	// repeated validate.ExprRule expr = 1074;
+	E_Expr = &file_validate_validate_proto_extTypes[4]
+	// FieldMask validates a field of this message partially, as covered by
+	// the google.protobuf.FieldMask of another field, e.g. the resource of an
+	// update request.
+	//
+	// optional validate.FieldMaskRule field_mask = 1075;
+	E_FieldMask = &file_validate_validate_proto_extTypes[5]
 )
 
 // Extension fields to descriptorpb.OneofOptions.
@@ -4554,7 +5807,7 @@ var (
 	// validation fails if no fields in the oneof are set.
 	//
 	// optional validate.OneOf oneof = 1071;
-	E_Oneof = &file_validate_validate_proto_extTypes[3]
+	E_Oneof = &file_validate_validate_proto_extTypes[6]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
@@ -4563,7 +5816,19 @@ var (
 	// no validation is performed against a field.
 	//
 	// optional validate.FieldRules rules = 1071;
-	E_Rules = &file_validate_validate_proto_extTypes[4]
+	E_Rules = &file_validate_validate_proto_extTypes[7]
+)
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// optional validate.MethodRules methods = 1071;
+	E_Methods = &file_validate_validate_proto_extTypes[8]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional validate.MethodRules method = 1071;
+	E_Method = &file_validate_validate_proto_extTypes[9]
 )
 
 var File_validate_validate_proto protoreflect.FileDescriptor
@@ -4577,536 +5842,723 @@ var file_validate_validate_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4a, 0x0a, 0x05, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x5f, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x70,
-	0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x22, 0x33, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x61, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x70,
-	0x6b, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x02, 0x28,
-	0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0xc8, 0x08, 0x0a, 0x0a, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52,
-	0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x12, 0x2f, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06,
-	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52,
-	0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x32, 0x0a, 0x07,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x12, 0x35, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7f, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x72, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xa2, 0x02, 0x0a, 0x04, 0x57, 0x68, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65,
+	0x6e, 0x2e, 0x4f, 0x70, 0x3a, 0x02, 0x45, 0x51, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x03,
+	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x4a, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x06, 0x0a, 0x02,
+	0x45, 0x51, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02,
+	0x4c, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x54, 0x45, 0x10, 0x04, 0x12, 0x06, 0x0a,
+	0x02, 0x47, 0x54, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x45, 0x10, 0x06, 0x12, 0x07,
+	0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54,
+	0x10, 0x08, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7e, 0x0a, 0x0b, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b,
+	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x2b,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x62, 0x0a, 0x05, 0x4f,
+	0x6e, 0x65, 0x4f, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x9c, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x23, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x8b,
+	0x01, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x03,
+	0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x12, 0x22, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x33, 0x0a, 0x09,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b, 0x67,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x22, 0xc8, 0x08, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x12, 0x2f, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x2c, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2f, 0x0a,
+	0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2f,
+	0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00,
+	0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x12, 0x35, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x29,
-	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48,
-	0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x04, 0x65,
-	0x6e, 0x75, 0x6d, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x61,
-	0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x03, 0x6d,
-	0x61, 0x70, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0x37, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6c, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x35, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x03, 0x61,
+	0x6e, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x03,
+	0x61, 0x6e, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x37, 0x0a, 0x0a,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x09, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x02, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a,
+	0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x02, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0xaf, 0x02, 0x0a, 0x0a, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f,
+	0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77,
+	0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65,
 	0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd6, 0x01, 0x0a,
-	0x09, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x6c, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6c,
-	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02,
-	0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x02,
+	0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xae, 0x02, 0x0a,
+	0x09, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6c, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c,
+	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05,
 	0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x02, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69,
 	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25,
 	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
 	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39, 0x0a, 0x0b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x44,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74,
-	0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x0a, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57,
+	0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x37, 0x0a,
+	0x0a, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15,
+	0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77,
+	0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x0a, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e,
-	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x74,
 	0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70,
 	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
 	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x0a,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52,
-	0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67,
-	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a,
-	0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x6e,
-	0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39,
-	0x0a, 0x0b, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0xaf, 0x02, 0x0a, 0x0a, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xaf, 0x02, 0x0a,
+	0x0a, 0x53, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x02, 0x6c,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03,
+	0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x11, 0x52,
+	0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11,
+	0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x11, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x11, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x39,
+	0x0a, 0x0b, 0x53, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a,
 	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75,
-	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x55, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6c, 0x74, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x67, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x67,
-	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x02,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x0a, 0x53, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6c, 0x74, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x12, 0x52, 0x02, 0x67, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x67,
+	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x12, 0x52, 0x02,
 	0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e,
+	0x28, 0x12, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e,
 	0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x39, 0x0a, 0x0b, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd7,
-	0x01, 0x0a, 0x0a, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x49, 0x6e, 0x74,
-	0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x53, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x75,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x11, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x11, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x11, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x11, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06,
-	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x11, 0x52, 0x05, 0x6e, 0x6f,
-	0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72,
-	0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39, 0x0a,
-	0x0b, 0x53, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x53, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x12, 0x52, 0x02, 0x67, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x67, 0x74,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x12, 0x52, 0x02, 0x69,
-	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x12, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x0c, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22,
-	0xd8, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x05,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x07, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x07, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x07, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x07, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74,
-	0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x07, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x0c, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65,
-	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x67, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x67, 0x74,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x06, 0x52, 0x02, 0x69,
-	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x06, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x0d, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x22, 0xd9, 0x01, 0x0a, 0x0c, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0f, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0f, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06,
-	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x05, 0x6e, 0x6f,
-	0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72,
-	0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a,
-	0x0d, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c,
-	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd9, 0x01, 0x0a,
-	0x0c, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x05, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x10, 0x52,
-	0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x10,
-	0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x10, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x10, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x10, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x10, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xfa, 0x05,
-	0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x6c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x12, 0x17, 0x0a,
-	0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x6e, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x65, 0x6e, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
-	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x16, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x14, 0x0a, 0x04, 0x69,
-	0x70, 0x76, 0x36, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x76,
-	0x36, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x75, 0x72, 0x69, 0x5f, 0x72, 0x65, 0x66,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x75, 0x72, 0x69, 0x52, 0x65, 0x66,
-	0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x40, 0x0a, 0x10, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x67,
-	0x65, 0x78, 0x48, 0x00, 0x52, 0x0e, 0x77, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x19,
-	0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69,
-	0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x1b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a,
-	0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x37, 0x0a, 0x0a, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x22, 0x88, 0x03, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x75, 0x6c,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
-	0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4c,
-	0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x02, 0x69,
-	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x70,
-	0x76, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34,
-	0x12, 0x14, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67,
-	0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x42, 0x0c, 0x0a, 0x0a, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x92,
-	0x01, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x6f, 0x6e,
-	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
-	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x25, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61,
+	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65,
+	0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x3b, 0x0a, 0x0c, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xb0, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6c, 0x74, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x07, 0x52, 0x02, 0x67, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x67,
+	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x07, 0x52, 0x02,
+	0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x07, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65,
+	0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x3b, 0x0a, 0x0c, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xb0, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6c, 0x74, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x67, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x67,
+	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x06, 0x52, 0x02,
+	0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x06, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65,
+	0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x53,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x0c, 0x53,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x02, 0x6c,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x03,
+	0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0f, 0x52,
+	0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0f,
+	0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0f, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x3d,
+	0x0a, 0x0d, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xb1, 0x02,
+	0x0a, 0x0c, 0x53, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x05, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x10,
+	0x52, 0x02, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x10, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x10, 0x52, 0x02, 0x67, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x10, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x10, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x10, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x22, 0xa0, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0xd2, 0x06, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x6e, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x65, 0x6e,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12,
+	0x16, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x14, 0x0a,
+	0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x69,
+	0x70, 0x76, 0x36, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x75, 0x72, 0x69, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x75, 0x72, 0x69, 0x52,
+	0x65, 0x66, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x10, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x48, 0x00, 0x52, 0x0e, 0x77, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x37, 0x0a, 0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xe0, 0x03,
+	0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x6c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d,
+	0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69,
+	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x49, 0x6e, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x70,
+	0x76, 0x36, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x0d, 0x52, 0x65,
-	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75,
-	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x0c, 0x52, 0x65,
-	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
-	0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
-	0x69, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68,
+	0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x22, 0xea, 0x01, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x25,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57,
+	0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xa1, 0x01,
+	0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x6b,
+	0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57,
+	0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x92, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x33, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x07, 0x4d,
+	0x61, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61,
+	0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x33, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x27,
-	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x75, 0x6c, 0x65,
-	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x07, 0x4d, 0x61, 0x70, 0x52,
-	0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x6e, 0x6f, 0x5f, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x6e, 0x6f, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65,
-	0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x04,
-	0x6b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x08,
-	0x41, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x22, 0x73, 0x0a, 0x07, 0x41, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77,
+	0x68, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x33, 0x0a, 0x08, 0x41,
+	0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0xaf, 0x01, 0x0a, 0x07, 0x41, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
 	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
 	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f,
 	0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12,
 	0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
 	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x0d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x8f, 0x03, 0x0a, 0x0c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x2b,
-	0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0xcb, 0x03, 0x0a, 0x0c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2f,
+	0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x02, 0x67,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x6f, 0x6e, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03,
-	0x67, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02,
+	0x67, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x30,
-	0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e,
-	0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x99, 0x03, 0x0a, 0x0d, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x02, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6c,
-	0x74, 0x65, 0x12, 0x2a, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c,
-	0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06,
-	0x6c, 0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x74,
-	0x4e, 0x6f, 0x77, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x74, 0x4e, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69,
-	0x74, 0x68, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x74, 0x65, 0x12,
+	0x29, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x6e, 0x6f,
+	0x74, 0x5f, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x12, 0x25, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x2a, 0x46, 0x0a, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x67,
-	0x65, 0x78, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x14, 0x0a, 0x10, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x4e,
-	0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x48, 0x45,
-	0x41, 0x44, 0x45, 0x52, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x02, 0x3a, 0x3c, 0x0a, 0x08,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x3a, 0x0a, 0x07, 0x69, 0x67,
-	0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb0, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x3a, 0x54, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb1, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x45, 0x0a, 0x05,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x52, 0x05, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x3a, 0x4a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x42,
-	0x4a, 0x0a, 0x17, 0x63, 0x6e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6c, 0x69, 0x2e, 0x70, 0x67,
-	0x76, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x75, 0x72, 0x6c, 0x2d, 0x6c, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x25, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65,
+	0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x3f, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0xd5, 0x03, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x30,
+	0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x03,
+	0x6c, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x02, 0x67, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x03, 0x67, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x74, 0x4e, 0x6f, 0x77, 0x12, 0x15, 0x0a, 0x06, 0x67,
+	0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x74, 0x4e,
+	0x6f, 0x77, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2a, 0x46, 0x0a, 0x0a, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x48, 0x45, 0x41, 0x44,
+	0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x54, 0x54,
+	0x50, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x02,
+	0x3a, 0x35, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x3c, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x3a, 0x0a, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xb0, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x64, 0x3a, 0x54, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xb1, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x61, 0x73, 0x65, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x48, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12,
+	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xb2, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x65, 0x78, 0x70,
+	0x72, 0x3a, 0x58, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x12,
+	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xb3, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x3a, 0x45, 0x0a, 0x05, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x52, 0x05, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x3a, 0x4a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x51,
+	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x3a, 0x4e, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x42, 0x4a, 0x0a, 0x17, 0x63, 0x6e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6c, 0x69, 0x2e,
+	0x70, 0x67, 0x76, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5a, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x75, 0x72, 0x6c, 0x2d, 0x6c, 0x69,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
 }
 
 var (
@@ -5121,170 +6573,209 @@ func file_validate_validate_proto_rawDescGZIP() []byte {
 	return file_validate_validate_proto_rawDescData
 }
 
-var file_validate_validate_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-
-var file_validate_validate_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
-
+var file_validate_validate_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_validate_validate_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_validate_validate_proto_goTypes = []interface{}{
 	(KnownRegex)(0),                     // 0: validate.KnownRegex
-	(*OneOf)(nil),                       // 1: validate.OneOf
-	(*Error)(nil),                       // 2: validate.Error
-	(*ErrorBase)(nil),                   // 3: validate.ErrorBase
-	(*FieldRules)(nil),                  // 4: validate.FieldRules
-	(*FloatRules)(nil),                  // 5: validate.FloatRules
-	(*FloatRule)(nil),                   // 6: validate.FloatRule
-	(*DoubleRules)(nil),                 // 7: validate.DoubleRules
-	(*DoubleRule)(nil),                  // 8: validate.DoubleRule
-	(*Int32Rules)(nil),                  // 9: validate.Int32Rules
-	(*Int32Rule)(nil),                   // 10: validate.Int32Rule
-	(*Int64Rules)(nil),                  // 11: validate.Int64Rules
-	(*Int64Rule)(nil),                   // 12: validate.Int64Rule
-	(*UInt32Rules)(nil),                 // 13: validate.UInt32Rules
-	(*UInt32Rule)(nil),                  // 14: validate.UInt32Rule
-	(*UInt64Rules)(nil),                 // 15: validate.UInt64Rules
-	(*UInt64Rule)(nil),                  // 16: validate.UInt64Rule
-	(*SInt32Rules)(nil),                 // 17: validate.SInt32Rules
-	(*SInt32Rule)(nil),                  // 18: validate.SInt32Rule
-	(*SInt64Rules)(nil),                 // 19: validate.SInt64Rules
-	(*SInt64Rule)(nil),                  // 20: validate.SInt64Rule
-	(*Fixed32Rules)(nil),                // 21: validate.Fixed32Rules
-	(*Fixed32Rule)(nil),                 // 22: validate.Fixed32Rule
-	(*Fixed64Rules)(nil),                // 23: validate.Fixed64Rules
-	(*Fixed64Rule)(nil),                 // 24: validate.Fixed64Rule
-	(*SFixed32Rules)(nil),               // 25: validate.SFixed32Rules
-	(*SFixed32Rule)(nil),                // 26: validate.SFixed32Rule
-	(*SFixed64Rules)(nil),               // 27: validate.SFixed64Rules
-	(*SFixed64Rule)(nil),                // 28: validate.SFixed64Rule
-	(*BoolRules)(nil),                   // 29: validate.BoolRules
-	(*StringRules)(nil),                 // 30: validate.StringRules
-	(*StringRule)(nil),                  // 31: validate.StringRule
-	(*BytesRules)(nil),                  // 32: validate.BytesRules
-	(*BytesRule)(nil),                   // 33: validate.BytesRule
-	(*EnumRules)(nil),                   // 34: validate.EnumRules
-	(*MessageRules)(nil),                // 35: validate.MessageRules
-	(*RepeatedRules)(nil),               // 36: validate.RepeatedRules
-	(*RepeatedRule)(nil),                // 37: validate.RepeatedRule
-	(*MapRules)(nil),                    // 38: validate.MapRules
-	(*MapRule)(nil),                     // 39: validate.MapRule
-	(*AnyRules)(nil),                    // 40: validate.AnyRules
-	(*AnyRule)(nil),                     // 41: validate.AnyRule
-	(*DurationRules)(nil),               // 42: validate.DurationRules
-	(*DurationRule)(nil),                // 43: validate.DurationRule
-	(*TimestampRules)(nil),              // 44: validate.TimestampRules
-	(*TimestampRule)(nil),               // 45: validate.TimestampRule
-	(*durationpb.Duration)(nil),         // 46: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),       // 47: google.protobuf.Timestamp
-	(*descriptorpb.MessageOptions)(nil), // 48: google.protobuf.MessageOptions
-	(*descriptorpb.OneofOptions)(nil),   // 49: google.protobuf.OneofOptions
-	(*descriptorpb.FieldOptions)(nil),   // 50: google.protobuf.FieldOptions
+	(When_Op)(0),                        // 1: validate.When.Op
+	(*ExprRule)(nil),                    // 2: validate.ExprRule
+	(*FieldMaskRule)(nil),               // 3: validate.FieldMaskRule
+	(*When)(nil),                        // 4: validate.When
+	(*MethodRules)(nil),                 // 5: validate.MethodRules
+	(*OneOf)(nil),                       // 6: validate.OneOf
+	(*Error)(nil),                       // 7: validate.Error
+	(*Param)(nil),                       // 8: validate.Param
+	(*ErrorBase)(nil),                   // 9: validate.ErrorBase
+	(*FieldRules)(nil),                  // 10: validate.FieldRules
+	(*FloatRules)(nil),                  // 11: validate.FloatRules
+	(*FloatRule)(nil),                   // 12: validate.FloatRule
+	(*DoubleRules)(nil),                 // 13: validate.DoubleRules
+	(*DoubleRule)(nil),                  // 14: validate.DoubleRule
+	(*Int32Rules)(nil),                  // 15: validate.Int32Rules
+	(*Int32Rule)(nil),                   // 16: validate.Int32Rule
+	(*Int64Rules)(nil),                  // 17: validate.Int64Rules
+	(*Int64Rule)(nil),                   // 18: validate.Int64Rule
+	(*UInt32Rules)(nil),                 // 19: validate.UInt32Rules
+	(*UInt32Rule)(nil),                  // 20: validate.UInt32Rule
+	(*UInt64Rules)(nil),                 // 21: validate.UInt64Rules
+	(*UInt64Rule)(nil),                  // 22: validate.UInt64Rule
+	(*SInt32Rules)(nil),                 // 23: validate.SInt32Rules
+	(*SInt32Rule)(nil),                  // 24: validate.SInt32Rule
+	(*SInt64Rules)(nil),                 // 25: validate.SInt64Rules
+	(*SInt64Rule)(nil),                  // 26: validate.SInt64Rule
+	(*Fixed32Rules)(nil),                // 27: validate.Fixed32Rules
+	(*Fixed32Rule)(nil),                 // 28: validate.Fixed32Rule
+	(*Fixed64Rules)(nil),                // 29: validate.Fixed64Rules
+	(*Fixed64Rule)(nil),                 // 30: validate.Fixed64Rule
+	(*SFixed32Rules)(nil),               // 31: validate.SFixed32Rules
+	(*SFixed32Rule)(nil),                // 32: validate.SFixed32Rule
+	(*SFixed64Rules)(nil),               // 33: validate.SFixed64Rules
+	(*SFixed64Rule)(nil),                // 34: validate.SFixed64Rule
+	(*BoolRules)(nil),                   // 35: validate.BoolRules
+	(*StringRules)(nil),                 // 36: validate.StringRules
+	(*StringRule)(nil),                  // 37: validate.StringRule
+	(*BytesRules)(nil),                  // 38: validate.BytesRules
+	(*BytesRule)(nil),                   // 39: validate.BytesRule
+	(*EnumRules)(nil),                   // 40: validate.EnumRules
+	(*MessageRules)(nil),                // 41: validate.MessageRules
+	(*RepeatedRules)(nil),               // 42: validate.RepeatedRules
+	(*RepeatedRule)(nil),                // 43: validate.RepeatedRule
+	(*MapRules)(nil),                    // 44: validate.MapRules
+	(*MapRule)(nil),                     // 45: validate.MapRule
+	(*AnyRules)(nil),                    // 46: validate.AnyRules
+	(*AnyRule)(nil),                     // 47: validate.AnyRule
+	(*DurationRules)(nil),               // 48: validate.DurationRules
+	(*DurationRule)(nil),                // 49: validate.DurationRule
+	(*TimestampRules)(nil),              // 50: validate.TimestampRules
+	(*TimestampRule)(nil),               // 51: validate.TimestampRule
+	(*durationpb.Duration)(nil),         // 52: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 53: google.protobuf.Timestamp
+	(*descriptorpb.FileOptions)(nil),    // 54: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil), // 55: google.protobuf.MessageOptions
+	(*descriptorpb.OneofOptions)(nil),   // 56: google.protobuf.OneofOptions
+	(*descriptorpb.FieldOptions)(nil),   // 57: google.protobuf.FieldOptions
+	(*descriptorpb.ServiceOptions)(nil), // 58: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 59: google.protobuf.MethodOptions
 }
-
 var file_validate_validate_proto_depIdxs = []int32{
-	2,  // 0: validate.OneOf.error:type_name -> validate.Error
-	35, // 1: validate.FieldRules.message:type_name -> validate.MessageRules
-	5,  // 2: validate.FieldRules.float:type_name -> validate.FloatRules
-	7,  // 3: validate.FieldRules.double:type_name -> validate.DoubleRules
-	9,  // 4: validate.FieldRules.int32:type_name -> validate.Int32Rules
-	11, // 5: validate.FieldRules.int64:type_name -> validate.Int64Rules
-	13, // 6: validate.FieldRules.uint32:type_name -> validate.UInt32Rules
-	15, // 7: validate.FieldRules.uint64:type_name -> validate.UInt64Rules
-	17, // 8: validate.FieldRules.sint32:type_name -> validate.SInt32Rules
-	19, // 9: validate.FieldRules.sint64:type_name -> validate.SInt64Rules
-	21, // 10: validate.FieldRules.fixed32:type_name -> validate.Fixed32Rules
-	23, // 11: validate.FieldRules.fixed64:type_name -> validate.Fixed64Rules
-	25, // 12: validate.FieldRules.sfixed32:type_name -> validate.SFixed32Rules
-	27, // 13: validate.FieldRules.sfixed64:type_name -> validate.SFixed64Rules
-	29, // 14: validate.FieldRules.bool:type_name -> validate.BoolRules
-	30, // 15: validate.FieldRules.string:type_name -> validate.StringRules
-	32, // 16: validate.FieldRules.bytes:type_name -> validate.BytesRules
-	34, // 17: validate.FieldRules.enum:type_name -> validate.EnumRules
-	36, // 18: validate.FieldRules.repeated:type_name -> validate.RepeatedRules
-	38, // 19: validate.FieldRules.map:type_name -> validate.MapRules
-	40, // 20: validate.FieldRules.any:type_name -> validate.AnyRules
-	42, // 21: validate.FieldRules.duration:type_name -> validate.DurationRules
-	44, // 22: validate.FieldRules.timestamp:type_name -> validate.TimestampRules
-	6,  // 23: validate.FloatRules.rules:type_name -> validate.FloatRule
-	2,  // 24: validate.FloatRule.error:type_name -> validate.Error
-	8,  // 25: validate.DoubleRules.rules:type_name -> validate.DoubleRule
-	2,  // 26: validate.DoubleRule.error:type_name -> validate.Error
-	10, // 27: validate.Int32Rules.rules:type_name -> validate.Int32Rule
-	2,  // 28: validate.Int32Rule.error:type_name -> validate.Error
-	12, // 29: validate.Int64Rules.rules:type_name -> validate.Int64Rule
-	2,  // 30: validate.Int64Rule.error:type_name -> validate.Error
-	14, // 31: validate.UInt32Rules.rules:type_name -> validate.UInt32Rule
-	2,  // 32: validate.UInt32Rule.error:type_name -> validate.Error
-	16, // 33: validate.UInt64Rules.rules:type_name -> validate.UInt64Rule
-	2,  // 34: validate.UInt64Rule.error:type_name -> validate.Error
-	18, // 35: validate.SInt32Rules.rules:type_name -> validate.SInt32Rule
-	2,  // 36: validate.SInt32Rule.error:type_name -> validate.Error
-	20, // 37: validate.SInt64Rules.rules:type_name -> validate.SInt64Rule
-	2,  // 38: validate.SInt64Rule.error:type_name -> validate.Error
-	22, // 39: validate.Fixed32Rules.rules:type_name -> validate.Fixed32Rule
-	2,  // 40: validate.Fixed32Rule.error:type_name -> validate.Error
-	24, // 41: validate.Fixed64Rules.rules:type_name -> validate.Fixed64Rule
-	2,  // 42: validate.Fixed64Rule.error:type_name -> validate.Error
-	26, // 43: validate.SFixed32Rules.rules:type_name -> validate.SFixed32Rule
-	2,  // 44: validate.SFixed32Rule.error:type_name -> validate.Error
-	28, // 45: validate.SFixed64Rules.rules:type_name -> validate.SFixed64Rule
-	2,  // 46: validate.SFixed64Rule.error:type_name -> validate.Error
-	2,  // 47: validate.BoolRules.error:type_name -> validate.Error
-	31, // 48: validate.StringRules.rules:type_name -> validate.StringRule
-	0,  // 49: validate.StringRule.well_known_regex:type_name -> validate.KnownRegex
-	2,  // 50: validate.StringRule.error:type_name -> validate.Error
-	33, // 51: validate.BytesRules.rules:type_name -> validate.BytesRule
-	2,  // 52: validate.BytesRule.error:type_name -> validate.Error
-	2,  // 53: validate.EnumRules.error:type_name -> validate.Error
-	2,  // 54: validate.MessageRules.error:type_name -> validate.Error
-	37, // 55: validate.RepeatedRules.rules:type_name -> validate.RepeatedRule
-	4,  // 56: validate.RepeatedRule.items:type_name -> validate.FieldRules
-	2,  // 57: validate.RepeatedRule.error:type_name -> validate.Error
-	39, // 58: validate.MapRules.rules:type_name -> validate.MapRule
-	4,  // 59: validate.MapRule.keys:type_name -> validate.FieldRules
-	4,  // 60: validate.MapRule.values:type_name -> validate.FieldRules
-	2,  // 61: validate.MapRule.error:type_name -> validate.Error
-	41, // 62: validate.AnyRules.rules:type_name -> validate.AnyRule
-	2,  // 63: validate.AnyRule.error:type_name -> validate.Error
-	43, // 64: validate.DurationRules.rules:type_name -> validate.DurationRule
-	46, // 65: validate.DurationRule.const:type_name -> google.protobuf.Duration
-	46, // 66: validate.DurationRule.lt:type_name -> google.protobuf.Duration
-	46, // 67: validate.DurationRule.lte:type_name -> google.protobuf.Duration
-	46, // 68: validate.DurationRule.gt:type_name -> google.protobuf.Duration
-	46, // 69: validate.DurationRule.gte:type_name -> google.protobuf.Duration
-	46, // 70: validate.DurationRule.in:type_name -> google.protobuf.Duration
-	46, // 71: validate.DurationRule.not_in:type_name -> google.protobuf.Duration
-	2,  // 72: validate.DurationRule.error:type_name -> validate.Error
-	45, // 73: validate.TimestampRules.rules:type_name -> validate.TimestampRule
-	47, // 74: validate.TimestampRule.const:type_name -> google.protobuf.Timestamp
-	47, // 75: validate.TimestampRule.lt:type_name -> google.protobuf.Timestamp
-	47, // 76: validate.TimestampRule.lte:type_name -> google.protobuf.Timestamp
-	47, // 77: validate.TimestampRule.gt:type_name -> google.protobuf.Timestamp
-	47, // 78: validate.TimestampRule.gte:type_name -> google.protobuf.Timestamp
-	46, // 79: validate.TimestampRule.within:type_name -> google.protobuf.Duration
-	2,  // 80: validate.TimestampRule.error:type_name -> validate.Error
-	48, // 81: validate.disabled:extendee -> google.protobuf.MessageOptions
-	48, // 82: validate.ignored:extendee -> google.protobuf.MessageOptions
-	48, // 83: validate.error_base:extendee -> google.protobuf.MessageOptions
-	49, // 84: validate.oneof:extendee -> google.protobuf.OneofOptions
-	50, // 85: validate.rules:extendee -> google.protobuf.FieldOptions
-	3,  // 86: validate.error_base:type_name -> validate.ErrorBase
-	1,  // 87: validate.oneof:type_name -> validate.OneOf
-	4,  // 88: validate.rules:type_name -> validate.FieldRules
-	89, // [89:89] is the sub-list for method output_type
-	89, // [89:89] is the sub-list for method input_type
-	86, // [86:89] is the sub-list for extension type_name
-	81, // [81:86] is the sub-list for extension extendee
-	0,  // [0:81] is the sub-list for field type_name
+	7,   // 0: validate.ExprRule.error:type_name -> validate.Error
+	1,   // 1: validate.When.op:type_name -> validate.When.Op
+	7,   // 2: validate.OneOf.error:type_name -> validate.Error
+	8,   // 3: validate.Error.args:type_name -> validate.Param
+	41,  // 4: validate.FieldRules.message:type_name -> validate.MessageRules
+	11,  // 5: validate.FieldRules.float:type_name -> validate.FloatRules
+	13,  // 6: validate.FieldRules.double:type_name -> validate.DoubleRules
+	15,  // 7: validate.FieldRules.int32:type_name -> validate.Int32Rules
+	17,  // 8: validate.FieldRules.int64:type_name -> validate.Int64Rules
+	19,  // 9: validate.FieldRules.uint32:type_name -> validate.UInt32Rules
+	21,  // 10: validate.FieldRules.uint64:type_name -> validate.UInt64Rules
+	23,  // 11: validate.FieldRules.sint32:type_name -> validate.SInt32Rules
+	25,  // 12: validate.FieldRules.sint64:type_name -> validate.SInt64Rules
+	27,  // 13: validate.FieldRules.fixed32:type_name -> validate.Fixed32Rules
+	29,  // 14: validate.FieldRules.fixed64:type_name -> validate.Fixed64Rules
+	31,  // 15: validate.FieldRules.sfixed32:type_name -> validate.SFixed32Rules
+	33,  // 16: validate.FieldRules.sfixed64:type_name -> validate.SFixed64Rules
+	35,  // 17: validate.FieldRules.bool:type_name -> validate.BoolRules
+	36,  // 18: validate.FieldRules.string:type_name -> validate.StringRules
+	38,  // 19: validate.FieldRules.bytes:type_name -> validate.BytesRules
+	40,  // 20: validate.FieldRules.enum:type_name -> validate.EnumRules
+	42,  // 21: validate.FieldRules.repeated:type_name -> validate.RepeatedRules
+	44,  // 22: validate.FieldRules.map:type_name -> validate.MapRules
+	46,  // 23: validate.FieldRules.any:type_name -> validate.AnyRules
+	48,  // 24: validate.FieldRules.duration:type_name -> validate.DurationRules
+	50,  // 25: validate.FieldRules.timestamp:type_name -> validate.TimestampRules
+	12,  // 26: validate.FloatRules.rules:type_name -> validate.FloatRule
+	7,   // 27: validate.FloatRule.error:type_name -> validate.Error
+	4,   // 28: validate.FloatRule.when:type_name -> validate.When
+	14,  // 29: validate.DoubleRules.rules:type_name -> validate.DoubleRule
+	7,   // 30: validate.DoubleRule.error:type_name -> validate.Error
+	4,   // 31: validate.DoubleRule.when:type_name -> validate.When
+	16,  // 32: validate.Int32Rules.rules:type_name -> validate.Int32Rule
+	7,   // 33: validate.Int32Rule.error:type_name -> validate.Error
+	4,   // 34: validate.Int32Rule.when:type_name -> validate.When
+	18,  // 35: validate.Int64Rules.rules:type_name -> validate.Int64Rule
+	7,   // 36: validate.Int64Rule.error:type_name -> validate.Error
+	4,   // 37: validate.Int64Rule.when:type_name -> validate.When
+	20,  // 38: validate.UInt32Rules.rules:type_name -> validate.UInt32Rule
+	7,   // 39: validate.UInt32Rule.error:type_name -> validate.Error
+	4,   // 40: validate.UInt32Rule.when:type_name -> validate.When
+	22,  // 41: validate.UInt64Rules.rules:type_name -> validate.UInt64Rule
+	7,   // 42: validate.UInt64Rule.error:type_name -> validate.Error
+	4,   // 43: validate.UInt64Rule.when:type_name -> validate.When
+	24,  // 44: validate.SInt32Rules.rules:type_name -> validate.SInt32Rule
+	7,   // 45: validate.SInt32Rule.error:type_name -> validate.Error
+	4,   // 46: validate.SInt32Rule.when:type_name -> validate.When
+	26,  // 47: validate.SInt64Rules.rules:type_name -> validate.SInt64Rule
+	7,   // 48: validate.SInt64Rule.error:type_name -> validate.Error
+	4,   // 49: validate.SInt64Rule.when:type_name -> validate.When
+	28,  // 50: validate.Fixed32Rules.rules:type_name -> validate.Fixed32Rule
+	7,   // 51: validate.Fixed32Rule.error:type_name -> validate.Error
+	4,   // 52: validate.Fixed32Rule.when:type_name -> validate.When
+	30,  // 53: validate.Fixed64Rules.rules:type_name -> validate.Fixed64Rule
+	7,   // 54: validate.Fixed64Rule.error:type_name -> validate.Error
+	4,   // 55: validate.Fixed64Rule.when:type_name -> validate.When
+	32,  // 56: validate.SFixed32Rules.rules:type_name -> validate.SFixed32Rule
+	7,   // 57: validate.SFixed32Rule.error:type_name -> validate.Error
+	4,   // 58: validate.SFixed32Rule.when:type_name -> validate.When
+	34,  // 59: validate.SFixed64Rules.rules:type_name -> validate.SFixed64Rule
+	7,   // 60: validate.SFixed64Rule.error:type_name -> validate.Error
+	4,   // 61: validate.SFixed64Rule.when:type_name -> validate.When
+	7,   // 62: validate.BoolRules.error:type_name -> validate.Error
+	4,   // 63: validate.BoolRules.when:type_name -> validate.When
+	37,  // 64: validate.StringRules.rules:type_name -> validate.StringRule
+	0,   // 65: validate.StringRule.well_known_regex:type_name -> validate.KnownRegex
+	7,   // 66: validate.StringRule.error:type_name -> validate.Error
+	4,   // 67: validate.StringRule.when:type_name -> validate.When
+	39,  // 68: validate.BytesRules.rules:type_name -> validate.BytesRule
+	7,   // 69: validate.BytesRule.error:type_name -> validate.Error
+	4,   // 70: validate.BytesRule.when:type_name -> validate.When
+	7,   // 71: validate.EnumRules.error:type_name -> validate.Error
+	4,   // 72: validate.EnumRules.when:type_name -> validate.When
+	7,   // 73: validate.MessageRules.error:type_name -> validate.Error
+	4,   // 74: validate.MessageRules.when:type_name -> validate.When
+	43,  // 75: validate.RepeatedRules.rules:type_name -> validate.RepeatedRule
+	10,  // 76: validate.RepeatedRule.items:type_name -> validate.FieldRules
+	7,   // 77: validate.RepeatedRule.error:type_name -> validate.Error
+	4,   // 78: validate.RepeatedRule.when:type_name -> validate.When
+	45,  // 79: validate.MapRules.rules:type_name -> validate.MapRule
+	10,  // 80: validate.MapRule.keys:type_name -> validate.FieldRules
+	10,  // 81: validate.MapRule.values:type_name -> validate.FieldRules
+	7,   // 82: validate.MapRule.error:type_name -> validate.Error
+	4,   // 83: validate.MapRule.when:type_name -> validate.When
+	47,  // 84: validate.AnyRules.rules:type_name -> validate.AnyRule
+	7,   // 85: validate.AnyRule.error:type_name -> validate.Error
+	4,   // 86: validate.AnyRule.when:type_name -> validate.When
+	49,  // 87: validate.DurationRules.rules:type_name -> validate.DurationRule
+	52,  // 88: validate.DurationRule.const:type_name -> google.protobuf.Duration
+	52,  // 89: validate.DurationRule.lt:type_name -> google.protobuf.Duration
+	52,  // 90: validate.DurationRule.lte:type_name -> google.protobuf.Duration
+	52,  // 91: validate.DurationRule.gt:type_name -> google.protobuf.Duration
+	52,  // 92: validate.DurationRule.gte:type_name -> google.protobuf.Duration
+	52,  // 93: validate.DurationRule.in:type_name -> google.protobuf.Duration
+	52,  // 94: validate.DurationRule.not_in:type_name -> google.protobuf.Duration
+	7,   // 95: validate.DurationRule.error:type_name -> validate.Error
+	4,   // 96: validate.DurationRule.when:type_name -> validate.When
+	51,  // 97: validate.TimestampRules.rules:type_name -> validate.TimestampRule
+	53,  // 98: validate.TimestampRule.const:type_name -> google.protobuf.Timestamp
+	53,  // 99: validate.TimestampRule.lt:type_name -> google.protobuf.Timestamp
+	53,  // 100: validate.TimestampRule.lte:type_name -> google.protobuf.Timestamp
+	53,  // 101: validate.TimestampRule.gt:type_name -> google.protobuf.Timestamp
+	53,  // 102: validate.TimestampRule.gte:type_name -> google.protobuf.Timestamp
+	52,  // 103: validate.TimestampRule.within:type_name -> google.protobuf.Duration
+	7,   // 104: validate.TimestampRule.error:type_name -> validate.Error
+	4,   // 105: validate.TimestampRule.when:type_name -> validate.When
+	54,  // 106: validate.groups:extendee -> google.protobuf.FileOptions
+	55,  // 107: validate.disabled:extendee -> google.protobuf.MessageOptions
+	55,  // 108: validate.ignored:extendee -> google.protobuf.MessageOptions
+	55,  // 109: validate.error_base:extendee -> google.protobuf.MessageOptions
+	55,  // 110: validate.expr:extendee -> google.protobuf.MessageOptions
+	55,  // 111: validate.field_mask:extendee -> google.protobuf.MessageOptions
+	56,  // 112: validate.oneof:extendee -> google.protobuf.OneofOptions
+	57,  // 113: validate.rules:extendee -> google.protobuf.FieldOptions
+	58,  // 114: validate.methods:extendee -> google.protobuf.ServiceOptions
+	59,  // 115: validate.method:extendee -> google.protobuf.MethodOptions
+	9,   // 116: validate.error_base:type_name -> validate.ErrorBase
+	2,   // 117: validate.expr:type_name -> validate.ExprRule
+	3,   // 118: validate.field_mask:type_name -> validate.FieldMaskRule
+	6,   // 119: validate.oneof:type_name -> validate.OneOf
+	10,  // 120: validate.rules:type_name -> validate.FieldRules
+	5,   // 121: validate.methods:type_name -> validate.MethodRules
+	5,   // 122: validate.method:type_name -> validate.MethodRules
+	123, // [123:123] is the sub-list for method output_type
+	123, // [123:123] is the sub-list for method input_type
+	116, // [116:123] is the sub-list for extension type_name
+	106, // [106:116] is the sub-list for extension extendee
+	0,   // [0:106] is the sub-list for field type_name
 }
 
 func init() { file_validate_validate_proto_init() }
-
 func file_validate_validate_proto_init() {
 	if File_validate_validate_proto != nil {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_validate_validate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OneOf); i {
+			switch v := v.(*ExprRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5296,7 +6787,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Error); i {
+			switch v := v.(*FieldMaskRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5308,7 +6799,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrorBase); i {
+			switch v := v.(*When); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5320,7 +6811,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldRules); i {
+			switch v := v.(*MethodRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5332,7 +6823,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatRules); i {
+			switch v := v.(*OneOf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5344,7 +6835,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatRule); i {
+			switch v := v.(*Error); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5356,7 +6847,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoubleRules); i {
+			switch v := v.(*Param); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5368,7 +6859,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoubleRule); i {
+			switch v := v.(*ErrorBase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5380,7 +6871,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Int32Rules); i {
+			switch v := v.(*FieldRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5392,7 +6883,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Int32Rule); i {
+			switch v := v.(*FloatRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5404,7 +6895,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Int64Rules); i {
+			switch v := v.(*FloatRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5416,7 +6907,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Int64Rule); i {
+			switch v := v.(*DoubleRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5428,7 +6919,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UInt32Rules); i {
+			switch v := v.(*DoubleRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5440,7 +6931,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UInt32Rule); i {
+			switch v := v.(*Int32Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5452,7 +6943,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UInt64Rules); i {
+			switch v := v.(*Int32Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5464,7 +6955,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UInt64Rule); i {
+			switch v := v.(*Int64Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5476,7 +6967,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SInt32Rules); i {
+			switch v := v.(*Int64Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5488,7 +6979,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SInt32Rule); i {
+			switch v := v.(*UInt32Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5500,7 +6991,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SInt64Rules); i {
+			switch v := v.(*UInt32Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5512,7 +7003,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SInt64Rule); i {
+			switch v := v.(*UInt64Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5524,7 +7015,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fixed32Rules); i {
+			switch v := v.(*UInt64Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5536,7 +7027,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fixed32Rule); i {
+			switch v := v.(*SInt32Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5548,7 +7039,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fixed64Rules); i {
+			switch v := v.(*SInt32Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5560,7 +7051,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fixed64Rule); i {
+			switch v := v.(*SInt64Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5572,7 +7063,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SFixed32Rules); i {
+			switch v := v.(*SInt64Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5584,7 +7075,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SFixed32Rule); i {
+			switch v := v.(*Fixed32Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5596,7 +7087,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SFixed64Rules); i {
+			switch v := v.(*Fixed32Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5608,7 +7099,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SFixed64Rule); i {
+			switch v := v.(*Fixed64Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5620,7 +7111,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolRules); i {
+			switch v := v.(*Fixed64Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5632,7 +7123,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringRules); i {
+			switch v := v.(*SFixed32Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5644,7 +7135,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringRule); i {
+			switch v := v.(*SFixed32Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5656,7 +7147,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BytesRules); i {
+			switch v := v.(*SFixed64Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5668,7 +7159,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BytesRule); i {
+			switch v := v.(*SFixed64Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5680,7 +7171,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumRules); i {
+			switch v := v.(*BoolRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5692,7 +7183,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageRules); i {
+			switch v := v.(*StringRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5704,7 +7195,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedRules); i {
+			switch v := v.(*StringRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5716,7 +7207,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedRule); i {
+			switch v := v.(*BytesRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5728,7 +7219,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapRules); i {
+			switch v := v.(*BytesRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5740,7 +7231,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapRule); i {
+			switch v := v.(*EnumRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5752,7 +7243,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnyRules); i {
+			switch v := v.(*MessageRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5764,7 +7255,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnyRule); i {
+			switch v := v.(*RepeatedRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5776,7 +7267,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurationRules); i {
+			switch v := v.(*RepeatedRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5788,7 +7279,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurationRule); i {
+			switch v := v.(*MapRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5800,7 +7291,7 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampRules); i {
+			switch v := v.(*MapRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5812,6 +7303,66 @@ func file_validate_validate_proto_init() {
 			}
 		}
 		file_validate_validate_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validate_validate_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validate_validate_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validate_validate_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validate_validate_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validate_validate_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimestampRule); i {
 			case 0:
 				return &v.state
@@ -5824,7 +7375,22 @@ func file_validate_validate_proto_init() {
 			}
 		}
 	}
-	file_validate_validate_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_validate_validate_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*When_Int)(nil),
+		(*When_Uint)(nil),
+		(*When_Double)(nil),
+		(*When_Bool)(nil),
+		(*When_String_)(nil),
+		(*When_Enum)(nil),
+	}
+	file_validate_validate_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*Param_Int)(nil),
+		(*Param_Bool)(nil),
+		(*Param_String_)(nil),
+		(*Param_Ref)(nil),
+		(*Param_Placeholder)(nil),
+	}
+	file_validate_validate_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*FieldRules_Float)(nil),
 		(*FieldRules_Double)(nil),
 		(*FieldRules_Int32)(nil),
@@ -5847,7 +7413,7 @@ func file_validate_validate_proto_init() {
 		(*FieldRules_Duration)(nil),
 		(*FieldRules_Timestamp)(nil),
 	}
-	file_validate_validate_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_validate_validate_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*StringRule_Email)(nil),
 		(*StringRule_Hostname)(nil),
 		(*StringRule_Ip)(nil),
@@ -5859,7 +7425,7 @@ func file_validate_validate_proto_init() {
 		(*StringRule_Uuid)(nil),
 		(*StringRule_WellKnownRegex)(nil),
 	}
-	file_validate_validate_proto_msgTypes[32].OneofWrappers = []interface{}{
+	file_validate_validate_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*BytesRule_Ip)(nil),
 		(*BytesRule_Ipv4)(nil),
 		(*BytesRule_Ipv6)(nil),
@@ -5869,9 +7435,9 @@ func file_validate_validate_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_validate_validate_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   45,
-			NumExtensions: 5,
+			NumEnums:      2,
+			NumMessages:   50,
+			NumExtensions: 10,
 			NumServices:   0,
 		},
 		GoTypes:           file_validate_validate_proto_goTypes,